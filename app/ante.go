@@ -2,23 +2,29 @@ package app
 
 import (
 	"errors"
-	"fmt"
 
 	ibcante "github.com/cosmos/ibc-go/v10/modules/core/ante"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
+	errorsmod "cosmossdk.io/errors"
 	circuitante "cosmossdk.io/x/circuit/ante"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
-	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
 	evmtxlistener "github.com/cosmos/evm/ante"
 	evmcosmosante "github.com/cosmos/evm/ante/cosmos"
 	evmante "github.com/cosmos/evm/ante/evm"
 	evmanteinterfaces "github.com/cosmos/evm/ante/interfaces"
-	evmtypes "github.com/cosmos/evm/x/vm/types"
+	evmfeemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+
+	feeabsante "github.com/TacBuild/tacchain/x/feeabs/ante"
+	feeabskeeper "github.com/TacBuild/tacchain/x/feeabs/keeper"
+	txpolicyante "github.com/TacBuild/tacchain/x/txpolicy/ante"
+	txpolicykeeper "github.com/TacBuild/tacchain/x/txpolicy/keeper"
 )
 
 // HandlerOptions extend the SDK's AnteHandler options by requiring the IBC
@@ -32,9 +38,15 @@ type HandlerOptions struct {
 
 	CircuitKeeper *circuitkeeper.Keeper
 
+	// InterfaceRegistry unpacks the Any fields of EIP-712 signed messages.
+	InterfaceRegistry codectypes.InterfaceRegistry
+
 	// PendingTxListener is called during CheckTx for each pending EVM tx hash (used by JSON-RPC).
 	PendingTxListener evmtxlistener.PendingTxListener
 
+	TxPolicyKeeper *txpolicykeeper.Keeper
+	FeeAbsKeeper   *feeabskeeper.Keeper
+
 	// Cosmos EVM
 	FeeMarketKeeper evmanteinterfaces.FeeMarketKeeper
 	EvmKeeper       evmanteinterfaces.EVMKeeper
@@ -64,11 +76,21 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.EvmKeeper == nil {
 		return nil, errors.New("evm keeper is required for ante builder")
 	}
+	if options.InterfaceRegistry == nil {
+		return nil, errors.New("interface registry is required for ante builder")
+	}
+	if options.TxPolicyKeeper == nil {
+		return nil, errors.New("tx policy keeper is required for ante builder")
+	}
+	if options.FeeAbsKeeper == nil {
+		return nil, errors.New("fee abstraction keeper is required for ante builder")
+	}
 
 	return func(
 		ctx sdk.Context, tx sdk.Tx, sim bool,
 	) (newCtx sdk.Context, err error) {
 		var anteHandler sdk.AnteHandler
+		path := antePathCosmos
 
 		txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
 		if ok {
@@ -77,27 +99,32 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 				switch typeURL := opts[0].GetTypeUrl(); typeURL {
 				case "/cosmos.evm.vm.v1.ExtensionOptionsEthereumTx":
 					// handle as *evmtypes.MsgEthereumTx
-					evmParams := options.EvmKeeper.GetParams(ctx)
-					feemarketParams := options.FeeMarketKeeper.GetParams(ctx)
-					anteHandler = sdk.ChainAnteDecorators(
-						evmante.NewEVMMonoDecorator(
-							options.AccountKeeper,
-							options.FeeMarketKeeper,
-							options.EvmKeeper,
-							options.MaxTxGasWanted,
-							&evmParams,
-							&feemarketParams,
-						),
-						evmtxlistener.NewTxListenerDecorator(options.PendingTxListener),
-					)
+					path = antePathEVM
+					anteHandler, err = newEVMAnteHandler(ctx, options, nil)
+				case "/tacchain.feeabs.v1.ExtensionOptionFeeToken":
+					// handle as *evmtypes.MsgEthereumTx paying its fee in a fee token
+					path = antePathEVM
+					anteHandler, err = newEVMAnteHandler(ctx, options, func(feemarketParams *evmfeemarkettypes.Params) sdk.AnteDecorator {
+						return feeabsante.NewEVMFeeTokenDecorator(options.FeeAbsKeeper, feemarketParams)
+					})
+				case "/tacchain.feeabs.v1.ExtensionOptionFeeGrant":
+					// handle as *evmtypes.MsgEthereumTx whose fee is paid by a feegrant granter
+					path = antePathEVM
+					anteHandler, err = newEVMAnteHandler(ctx, options, func(feemarketParams *evmfeemarkettypes.Params) sdk.AnteDecorator {
+						return feeabsante.NewEVMFeeGrantDecorator(options.FeegrantKeeper, options.BankKeeper, options.FeeAbsKeeper, feemarketParams)
+					})
 				case "/cosmos.evm.ante.v1.ExtensionOptionDynamicFeeTx":
 					// cosmos-sdk tx with dynamic fee extension
-					anteHandler, err = newCosmosAnteHandler(ctx, options)
+					anteHandler, err = newCosmosAnteHandler(ctx, options, false)
+				case "/cosmos.evm.eip712.v1.ExtensionOptionsWeb3Tx":
+					// cosmos-sdk tx signed as EIP-712 typed data by an ethsecp256k1 account
+					anteHandler, err = newCosmosAnteHandler(ctx, options, true)
 				default:
-					return ctx, fmt.Errorf("rejecting tx with unsupported extension option: %s", typeURL)
+					err = errorsmod.Wrapf(errortypes.ErrUnknownExtensionOptions, "rejecting tx with unsupported extension option: %s", typeURL)
 				}
 
 				if err != nil {
+					incrAnteRouterRejected(ctx, sim, path, err)
 					return ctx, err
 				}
 
@@ -108,12 +135,13 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		// handle as totally normal Cosmos SDK tx
 		switch tx.(type) {
 		case sdk.Tx:
-			anteHandler, err = newCosmosAnteHandler(ctx, options)
+			anteHandler, err = newCosmosAnteHandler(ctx, options, false)
 		default:
-			return ctx, errors.New("invalid transaction type")
+			err = errorsmod.Wrap(errortypes.ErrInvalidType, "invalid transaction type")
 		}
 
 		if err != nil {
+			incrAnteRouterRejected(ctx, sim, path, err)
 			return ctx, err
 		}
 
@@ -121,31 +149,89 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	}, nil
 }
 
-func newCosmosAnteHandler(ctx sdk.Context, options HandlerOptions) (sdk.AnteHandler, error) {
+// newEVMAnteHandler returns the ante handler of txs carrying MsgEthereumTxs.
+// The decorator returned by newFeeDecorator, if any, runs before the EVM mono
+// decorator to provide the senders with the fees it deducts.
+func newEVMAnteHandler(
+	ctx sdk.Context,
+	options HandlerOptions,
+	newFeeDecorator func(feemarketParams *evmfeemarkettypes.Params) sdk.AnteDecorator,
+) (sdk.AnteHandler, error) {
+	evmParams := options.EvmKeeper.GetParams(ctx)
 	feemarketParams := options.FeeMarketKeeper.GetParams(ctx)
-	txFeeChecker := evmante.NewDynamicFeeChecker(&feemarketParams)
-	return sdk.ChainAnteDecorators(
-		evmcosmosante.NewRejectMessagesDecorator(), // reject MsgEthereumTxs
-		evmcosmosante.NewAuthzLimiterDecorator( // disable the Msg types that cannot be included on an authz.MsgExec msgs field
-			sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
-			sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+	txPolicyParams, err := options.TxPolicyKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	decorators := []sdk.AnteDecorator{
+		txpolicyante.NewBlockedAddressDecorator(&txPolicyParams),
+	}
+	if newFeeDecorator != nil {
+		decorators = append(decorators, newFeeDecorator(&feemarketParams))
+	}
+	return chainMeteredAnteDecorators(antePathEVM, append(decorators,
+		evmante.NewEVMMonoDecorator(
+			options.AccountKeeper,
+			options.FeeMarketKeeper,
+			options.EvmKeeper,
+			options.MaxTxGasWanted,
+			&evmParams,
+			&feemarketParams,
 		),
+		evmtxlistener.NewTxListenerDecorator(options.PendingTxListener),
+	)...), nil
+}
+
+// newCosmosAnteHandler returns the ante handler of cosmos txs. With eip712
+// set, the signature is verified as EIP-712 typed data carried by the
+// ExtensionOptionsWeb3Tx option.
+func newCosmosAnteHandler(ctx sdk.Context, options HandlerOptions, eip712 bool) (sdk.AnteHandler, error) {
+	extensionOptionChecker := options.ExtensionOptionChecker
+	var sigVerification sdk.AnteDecorator = authante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler)
+	if eip712 {
+		extensionOptionChecker = isWeb3TxExtensionOption
+		sigVerification = NewEIP712SigVerificationDecorator(options.AccountKeeper, options.InterfaceRegistry)
+	}
+
+	feemarketParams := options.FeeMarketKeeper.GetParams(ctx)
+	txFeeChecker := newCosmosTxFeeChecker(&feemarketParams)
+	txPolicyParams, err := options.TxPolicyKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return chainMeteredAnteDecorators(antePathCosmos,
+		evmcosmosante.NewRejectMessagesDecorator(), // reject MsgEthereumTxs
+		// disable the Msg types that cannot be included on an authz.MsgExec msgs field
+		evmcosmosante.NewAuthzLimiterDecorator(txPolicyParams.AuthzDeniedMsgTypeUrls...),
+		txpolicyante.NewBlockedAddressDecorator(&txPolicyParams),
 		authante.NewSetUpContextDecorator(),
 		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
-		authante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		authante.NewExtensionOptionsDecorator(extensionOptionChecker),
 		authante.NewValidateBasicDecorator(),
 		authante.NewTxTimeoutHeightDecorator(),
 		authante.NewValidateMemoDecorator(options.AccountKeeper),
-		evmcosmosante.NewMinGasPriceDecorator(&feemarketParams),
 		authante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		authante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, txFeeChecker),
+		// fees paid in a fee token are converted to the EVM denom before being checked and deducted
+		feeabsante.NewConvertFeeDecorator(
+			options.FeeAbsKeeper,
+			newMeteredDecorator(antePathCosmos, txpolicyante.NewMinGasPriceDecorator(&feemarketParams, &txPolicyParams)),
+			newMeteredDecorator(antePathCosmos, authante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, txFeeChecker)),
+		),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		authante.NewSetPubKeyDecorator(options.AccountKeeper),
 		authante.NewValidateSigCountDecorator(options.AccountKeeper),
 		authante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
-		authante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		sigVerification,
 		authante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
+		NewRelayWorkDecorator(options.IBCKeeper.ChannelKeeper, options.IBCKeeper.ChannelKeeperV2, options.IBCKeeper.ClientKeeper, &txPolicyParams),
 		evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper, &feemarketParams),
 	), nil
 }
+
+// newCosmosTxFeeChecker returns the fee checker cosmos tx fees are deducted
+// with. The post handler uses it as well to refund the same fee.
+func newCosmosTxFeeChecker(feemarketParams *evmfeemarkettypes.Params) authante.TxFeeChecker {
+	return evmante.NewDynamicFeeChecker(feemarketParams)
+}
@@ -0,0 +1,137 @@
+package app
+
+import (
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ante handler paths, the label splitting the metrics of EVM and cosmos txs
+const (
+	antePathEVM    = "evm"
+	antePathCosmos = "cosmos"
+)
+
+// anteRouterDecorator is the decorator label of the txs rejected before
+// reaching a decorator chain, e.g. for an unsupported extension option.
+const anteRouterDecorator = "router"
+
+// anteErrorSlotKey is the context key of the slot in which a metered
+// decorator records the error it returns, so that the metered decorator
+// calling it, either through next or as an inner decorator, doesn't count the
+// same rejection twice.
+type anteErrorSlotKey struct{}
+
+// meteredDecorator wraps an ante decorator, measuring its own duration, i.e.
+// without the decorators it calls, and counting the txs it rejects by reason.
+type meteredDecorator struct {
+	decorator sdk.AnteDecorator
+	path      string
+	name      string
+}
+
+// newMeteredDecorator wraps decorator to report its metrics under path.
+func newMeteredDecorator(path string, decorator sdk.AnteDecorator) sdk.AnteDecorator {
+	return meteredDecorator{
+		decorator: decorator,
+		path:      path,
+		name:      anteDecoratorName(decorator),
+	}
+}
+
+// chainMeteredAnteDecorators chains the decorators like sdk.ChainAnteDecorators,
+// reporting the metrics of each of them under path.
+func chainMeteredAnteDecorators(path string, decorators ...sdk.AnteDecorator) sdk.AnteHandler {
+	metered := make([]sdk.AnteDecorator, len(decorators))
+	for i, decorator := range decorators {
+		metered[i] = newMeteredDecorator(path, decorator)
+	}
+	return sdk.ChainAnteDecorators(metered...)
+}
+
+func (md meteredDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !telemetry.IsTelemetryEnabled() {
+		return md.decorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	// errors returned by the decorators called by this one, already counted
+	var calleeErr error
+	var nextDuration time.Duration
+	start := time.Now()
+	newCtx, err := md.decorator.AnteHandle(ctx.WithValue(anteErrorSlotKey{}, &calleeErr), tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		nextStart := time.Now()
+		defer func() { nextDuration += time.Since(nextStart) }()
+		return next(ctx, tx, simulate)
+	})
+	duration := time.Since(start) - nextDuration
+
+	labels := []metrics.Label{
+		telemetry.NewLabel("path", md.path),
+		telemetry.NewLabel("decorator", md.name),
+		telemetry.NewLabel("mode", anteExecMode(ctx, simulate)),
+	}
+	metrics.AddSampleWithLabels([]string{"ante", "decorator", "duration_ms"}, float32(duration.Seconds()*1000), labels)
+
+	if err != nil {
+		if calleeErr == nil || !errors.Is(err, calleeErr) {
+			telemetry.IncrCounterWithLabels([]string{"ante", "rejected"}, 1, append(labels, telemetry.NewLabel("reason", anteRejectionReason(err))))
+		}
+		if slot, ok := ctx.Value(anteErrorSlotKey{}).(*error); ok {
+			*slot = err
+		}
+	}
+	return newCtx, err
+}
+
+// incrAnteRouterRejected counts a tx rejected before reaching a decorator
+// chain.
+func incrAnteRouterRejected(ctx sdk.Context, simulate bool, path string, err error) {
+	telemetry.IncrCounterWithLabels([]string{"ante", "rejected"}, 1, []metrics.Label{
+		telemetry.NewLabel("path", path),
+		telemetry.NewLabel("decorator", anteRouterDecorator),
+		telemetry.NewLabel("mode", anteExecMode(ctx, simulate)),
+		telemetry.NewLabel("reason", anteRejectionReason(err)),
+	})
+}
+
+// anteDecoratorName returns the type name of a decorator, e.g.
+// SigVerificationDecorator.
+func anteDecoratorName(decorator sdk.AnteDecorator) string {
+	t := reflect.TypeOf(decorator)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// anteRejectionReason returns the description of the registered error an ante
+// error wraps, e.g. "insufficient fee", keeping the reason label of bounded
+// cardinality.
+func anteRejectionReason(err error) string {
+	var registered *errorsmod.Error
+	if errors.As(err, &registered) {
+		return registered.Error()
+	}
+	return "unknown"
+}
+
+// anteExecMode returns the execution mode label of an ante handler run.
+func anteExecMode(ctx sdk.Context, simulate bool) string {
+	switch {
+	case simulate:
+		return "simulate"
+	case ctx.IsReCheckTx():
+		return "recheck"
+	case ctx.IsCheckTx():
+		return "check"
+	default:
+		return "deliver"
+	}
+}
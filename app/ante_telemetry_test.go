@@ -0,0 +1,110 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type passDecorator struct{}
+
+func (passDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+type rejectDecorator struct{ err error }
+
+func (d rejectDecorator) AnteHandle(ctx sdk.Context, _ sdk.Tx, _ bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	return ctx, d.err
+}
+
+// wrapperDecorator runs inner decorators before next, like the feeabs
+// ConvertFeeDecorator.
+type wrapperDecorator struct{ inner sdk.AnteHandler }
+
+func (d wrapperDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx, err := d.inner(ctx, tx, simulate)
+	if err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// anteRejections returns the ante rejection counts by decorator and reason.
+func anteRejections(sink *metrics.InmemSink) map[[2]string]int {
+	rejections := make(map[[2]string]int)
+	for _, interval := range sink.Data() {
+		interval.RLock()
+		for _, counter := range interval.Counters {
+			if counter.Name != "test.ante.rejected" {
+				continue
+			}
+			labels := make(map[string]string, len(counter.Labels))
+			for _, label := range counter.Labels {
+				labels[label.Name] = label.Value
+			}
+			rejections[[2]string{labels["decorator"], labels["reason"]}] += counter.Count
+		}
+		interval.RUnlock()
+	}
+	return rejections
+}
+
+func TestMeteredDecorators(t *testing.T) {
+	_, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test"})
+	require.NoError(t, err)
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	_, err = metrics.NewGlobal(metrics.DefaultConfig("test"), sink)
+	require.NoError(t, err)
+
+	ctx := sdk.Context{}.WithContext(context.Background()).WithIsCheckTx(true)
+
+	// a rejection is counted once, by the decorator rejecting the tx
+	handler := chainMeteredAnteDecorators(antePathCosmos,
+		passDecorator{},
+		wrapperDecorator{inner: sdk.ChainAnteDecorators(
+			passDecorator{},
+			newMeteredDecorator(antePathCosmos, rejectDecorator{err: errorsmod.Wrap(errortypes.ErrInsufficientFee, "min gas price")}),
+		)},
+		passDecorator{},
+	)
+	_, err = handler(ctx, nil, false)
+	require.ErrorIs(t, err, errortypes.ErrInsufficientFee)
+	require.Equal(t, map[[2]string]int{
+		{"rejectDecorator", "insufficient fee"}: 1,
+	}, anteRejections(sink))
+
+	// downstream rejections are not counted by the upstream decorators
+	handler = chainMeteredAnteDecorators(antePathEVM,
+		passDecorator{},
+		wrapperDecorator{inner: sdk.ChainAnteDecorators(passDecorator{})},
+		rejectDecorator{err: errors.New("unregistered")},
+	)
+	_, err = handler(ctx, nil, false)
+	require.Error(t, err)
+	require.Equal(t, map[[2]string]int{
+		{"rejectDecorator", "insufficient fee"}: 1,
+		{"rejectDecorator", "unknown"}:          1,
+	}, anteRejections(sink))
+
+	incrAnteRouterRejected(ctx, false, antePathCosmos, errortypes.ErrUnknownExtensionOptions)
+	require.Equal(t, 1, anteRejections(sink)[[2]string{anteRouterDecorator, "unknown extension options"}])
+}
+
+func TestAnteExecMode(t *testing.T) {
+	ctx := sdk.Context{}
+	require.Equal(t, "deliver", anteExecMode(ctx, false))
+	require.Equal(t, "simulate", anteExecMode(ctx, true))
+	require.Equal(t, "check", anteExecMode(ctx.WithIsCheckTx(true), false))
+	require.Equal(t, "recheck", anteExecMode(ctx.WithIsReCheckTx(true), false))
+}
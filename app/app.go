@@ -1,22 +1,29 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"maps"
 	"os"
+	"path/filepath"
 	"sort"
+	"sync"
+	"sync/atomic"
 
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/rpc/client/local"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/evm/x/epochs"
 	epochskeeper "github.com/cosmos/evm/x/epochs/keeper"
 	epochstypes "github.com/cosmos/evm/x/epochs/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/cosmos/ibc-go/modules/capability"
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
@@ -38,8 +45,22 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	"github.com/spf13/cast"
 
+	"github.com/TacBuild/tacchain/app/broadcast"
 	appconfig "github.com/TacBuild/tacchain/app/config"
+	"github.com/TacBuild/tacchain/app/journal"
+	"github.com/TacBuild/tacchain/app/lanes"
+	tacmempool "github.com/TacBuild/tacchain/app/mempool"
+	"github.com/TacBuild/tacchain/app/ratelimit"
 	v160 "github.com/TacBuild/tacchain/app/upgrades/v1.6.0"
+	"github.com/TacBuild/tacchain/x/feeabs"
+	feeabskeeper "github.com/TacBuild/tacchain/x/feeabs/keeper"
+	feeabstypes "github.com/TacBuild/tacchain/x/feeabs/types"
+	"github.com/TacBuild/tacchain/x/inflation"
+	inflationkeeper "github.com/TacBuild/tacchain/x/inflation/keeper"
+	inflationtypes "github.com/TacBuild/tacchain/x/inflation/types"
+	"github.com/TacBuild/tacchain/x/txpolicy"
+	txpolicykeeper "github.com/TacBuild/tacchain/x/txpolicy/keeper"
+	txpolicytypes "github.com/TacBuild/tacchain/x/txpolicy/types"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
@@ -80,13 +101,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtxconfig "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
@@ -141,11 +162,13 @@ import (
 	ethcmn "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	evmcorevm "github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/event"
 
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 
 	evmconfig "github.com/cosmos/evm/config"
 	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
 	evmvmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmvmtypes "github.com/cosmos/evm/x/vm/types"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
@@ -169,6 +192,9 @@ var maccPerms = map[string][]string{
 	icatypes.ModuleName:         nil,
 	// liquidstake module
 	liquidstaketypes.ModuleName: {authtypes.Minter, authtypes.Burner},
+	// tacchain modules
+	inflationtypes.TreasuryName: nil,
+	feeabstypes.ModuleName:      nil,
 	// Cosmos EVM modules
 	evmvmtypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 	evmfeemarkettypes.ModuleName: nil,
@@ -235,6 +261,11 @@ type TacChainApp struct {
 	// liquidstake keeper
 	LiquidStakeKeeper liquidstakekeeper.Keeper
 
+	// tacchain keepers
+	InflationKeeper inflationkeeper.Keeper
+	TxPolicyKeeper  txpolicykeeper.Keeper
+	FeeAbsKeeper    feeabskeeper.Keeper
+
 	// Cosmos EVM keepers
 	FeeMarketKeeper evmfeemarketkeeper.Keeper
 	EVMKeeper       *evmvmkeeper.Keeper
@@ -242,8 +273,23 @@ type TacChainApp struct {
 
 	// EVM server support
 	clientCtx          client.Context
+	cometMempool       atomic.Pointer[CometMempool]
+	evmBroadcastMu     sync.Mutex
+	evmBroadcastQueue  *broadcast.Queue
+	openEVMBroadcast   func() (*broadcast.Queue, error)
+	evmJournal         *journal.Journal
+	evmJournalTxs      []*ethtypes.Transaction
+	evmJournalReplayed bool
+	mempoolAdmin       tacmempool.AdminServer
+	mempoolAdminCfg    tacmempool.AdminConfig
+	stopMempoolAdmin   context.CancelFunc
+	mempoolFeed        *tacmempool.Feed
+	mempoolFeedSub     event.Subscription
 	pendingTxListeners []evmante.PendingTxListener
 	EVMMempool         *evmmempool.ExperimentalEVMMempool
+
+	// node-local CheckTx rate limiter, nil if disabled
+	rateLimiter *ratelimit.Limiter
 }
 
 // NewTacChainApp returns a reference to an initialized TacChainApp.
@@ -303,11 +349,19 @@ func NewTacChainApp(
 		icahosttypes.StoreKey, epochstypes.StoreKey, icacontrollertypes.StoreKey,
 		// liquidstake module
 		liquidstaketypes.StoreKey,
+		// tacchain modules
+		inflationtypes.StoreKey, txpolicytypes.StoreKey, feeabstypes.StoreKey,
 		// Cosmos EVM store keys
 		evmvmtypes.StoreKey, evmfeemarkettypes.StoreKey, evmerc20types.StoreKey,
 	)
 
-	tkeys := storetypes.NewTransientStoreKeys(evmvmtypes.TransientKey, evmfeemarkettypes.TransientKey)
+	tkeys := storetypes.NewTransientStoreKeys(
+		evmvmtypes.TransientKey,
+		evmfeemarkettypes.TransientKey,
+		txpolicytypes.TransientStoreKey,
+		inflationtypes.TransientStoreKey,
+		feeabstypes.TransientStoreKey,
+	)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	// register streaming services
@@ -373,6 +427,10 @@ func NewTacChainApp(
 		logger,
 	)
 
+	// NOTE: modules holding burner accounts get a bank keeper that records
+	// their burns in x/inflation, which is built later and taken by reference.
+	burnTrackingBankKeeper := inflationkeeper.NewBurnTrackingBankKeeper(app.BankKeeper, &app.InflationKeeper)
+
 	// optional: enable sign mode textual by overwriting the default tx config (after setting the bank keeper)
 	enabledSignModes := append(authtx.DefaultSignModes, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
 	txConfigOpts := authtx.ConfigOptions{
@@ -392,13 +450,26 @@ func NewTacChainApp(
 		encodingConfig.Codec,
 		runtime.NewKVStoreService(keys[stakingtypes.StoreKey]),
 		app.AccountKeeper,
-		app.BankKeeper,
+		burnTrackingBankKeeper,
 		authAddr,
 		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
 		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
 	)
 	app.StakingKeeper.SetTokenizeSharesAllowedDelegators(liquidstaketypes.LiquidStakeProxyAcc)
 
+	// NOTE: the inflation keeper provides the x/mint MintFn, so it is created
+	// first and receives the mint and distribution keepers by reference.
+	app.InflationKeeper = inflationkeeper.NewKeeper(
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[inflationtypes.StoreKey]),
+		runtime.NewTransientStoreService(tkeys[inflationtypes.TransientStoreKey]),
+		&app.MintKeeper,
+		app.BankKeeper,
+		&app.DistrKeeper,
+		InflationCurves(),
+		authAddr,
+	)
+
 	app.MintKeeper = mintkeeper.NewKeeper(
 		encodingConfig.Codec,
 		runtime.NewKVStoreService(keys[minttypes.StoreKey]),
@@ -407,7 +478,7 @@ func NewTacChainApp(
 		app.BankKeeper,
 		authtypes.FeeCollectorName,
 		authAddr,
-		mintkeeper.WithMintFn(mintkeeper.DefaultMintFn(TacLinearInflationFormula)),
+		mintkeeper.WithMintFn(app.InflationKeeper.MintFn()),
 	)
 
 	app.DistrKeeper = distrkeeper.NewKeeper(
@@ -498,7 +569,7 @@ func NewTacChainApp(
 		encodingConfig.Codec,
 		runtime.NewKVStoreService(keys[govtypes.StoreKey]),
 		app.AccountKeeper,
-		app.BankKeeper,
+		burnTrackingBankKeeper,
 		app.StakingKeeper,
 		app.DistrKeeper,
 		app.MsgServiceRouter(),
@@ -538,7 +609,7 @@ func NewTacChainApp(
 		encodingConfig.Codec,
 		keys[liquidstaketypes.StoreKey],
 		app.AccountKeeper,
-		app.BankKeeper,
+		burnTrackingBankKeeper,
 		*app.StakingKeeper,
 		app.MintKeeper,
 		app.DistrKeeper,
@@ -550,6 +621,7 @@ func NewTacChainApp(
 	app.EpochsKeeper.SetHooks(
 		epochstypes.NewMultiEpochHooks(
 			app.LiquidStakeKeeper.EpochHooks(),
+			app.InflationKeeper.EpochHooks(),
 		),
 	)
 
@@ -560,6 +632,23 @@ func NewTacChainApp(
 		tkeys[evmfeemarkettypes.TransientKey],
 	)
 
+	app.TxPolicyKeeper = txpolicykeeper.NewKeeper(
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[txpolicytypes.StoreKey]),
+		runtime.NewTransientStoreService(tkeys[txpolicytypes.TransientStoreKey]),
+		app.FeeMarketKeeper,
+		authAddr,
+	)
+	app.BankKeeper.AppendSendRestriction(app.TxPolicyKeeper.SendRestrictionFn)
+
+	app.FeeAbsKeeper = feeabskeeper.NewKeeper(
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[feeabstypes.StoreKey]),
+		runtime.NewTransientStoreService(tkeys[feeabstypes.TransientStoreKey]),
+		app.BankKeeper,
+		authAddr,
+	)
+
 	// Set up EVM keeper
 	tracer := cast.ToString(appOpts.Get(evmsrvflags.EVMTracer))
 
@@ -571,7 +660,7 @@ func NewTacChainApp(
 		keys,
 		authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper,
-		app.BankKeeper,
+		burnTrackingBankKeeper,
 		app.StakingKeeper,
 		app.FeeMarketKeeper,
 		&app.ConsensusParamsKeeper,
@@ -607,7 +696,7 @@ func NewTacChainApp(
 		encodingConfig.Codec,
 		authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper,
-		app.BankKeeper,
+		burnTrackingBankKeeper,
 		app.EVMKeeper,
 		app.StakingKeeper,
 		&app.TransferKeeper,
@@ -623,7 +712,7 @@ func NewTacChainApp(
 		app.IBCKeeper.ChannelKeeper,
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
-		app.BankKeeper,
+		burnTrackingBankKeeper,
 		authAddr,
 	)
 
@@ -724,6 +813,10 @@ func NewTacChainApp(
 		ibctm.AppModule{},
 		// liquidstake module
 		liquidstake.NewAppModule(app.LiquidStakeKeeper),
+		// tacchain modules
+		inflation.NewAppModule(encodingConfig.Codec, app.InflationKeeper),
+		txpolicy.NewAppModule(encodingConfig.Codec, app.TxPolicyKeeper),
+		feeabs.NewAppModule(encodingConfig.Codec, app.FeeAbsKeeper),
 		// sdk
 		// Cosmos EVM modules
 		vm.NewAppModule(app.EVMKeeper, app.AccountKeeper, app.BankKeeper, encodingConfig.Codec.InterfaceRegistry().SigningContext().AddressCodec()),
@@ -783,6 +876,9 @@ func NewTacChainApp(
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		govtypes.ModuleName,
+		inflationtypes.ModuleName,
+		txpolicytypes.ModuleName,
+		feeabstypes.ModuleName,
 		genutiltypes.ModuleName,
 		icatypes.ModuleName,
 		feegrant.ModuleName,
@@ -801,6 +897,7 @@ func NewTacChainApp(
 
 		feegrant.ModuleName,
 		group.ModuleName,
+		inflationtypes.ModuleName,
 		// no-op modules
 		epochstypes.ModuleName,
 		stakingtypes.ModuleName,
@@ -814,6 +911,8 @@ func NewTacChainApp(
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
 		minttypes.ModuleName,
+		txpolicytypes.ModuleName,
+		feeabstypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
@@ -838,6 +937,9 @@ func NewTacChainApp(
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
+		inflationtypes.ModuleName,
+		txpolicytypes.ModuleName,
+		feeabstypes.ModuleName,
 		circuittypes.ModuleName,
 		// additional non simd modules
 		ibcexported.ModuleName,
@@ -919,10 +1021,26 @@ func NewTacChainApp(
 		cast.ToUint64(appOpts.Get(evmsrvflags.EVMMaxTxGasWanted)),
 	)
 
+	if err := app.configureRateLimiter(appOpts, logger); err != nil {
+		panic(fmt.Errorf("failed to configure rate limiter: %w", err))
+	}
+
+	if err := app.configureMempoolStream(appOpts, logger); err != nil {
+		panic(fmt.Errorf("failed to configure mempool stream: %w", err))
+	}
+
 	if err := app.configureEVMMempool(appOpts, logger); err != nil {
 		panic(fmt.Errorf("failed to configure EVM mempool: %w", err))
 	}
 
+	if err := app.configureEVMJournal(appOpts, logger); err != nil {
+		panic(fmt.Errorf("failed to configure EVM mempool journal: %w", err))
+	}
+
+	if err := app.configureMempoolAdmin(appOpts, logger); err != nil {
+		panic(fmt.Errorf("failed to configure mempool admin: %w", err))
+	}
+
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
 	// defined as a chain, and have the same signature as antehandlers.
@@ -972,8 +1090,11 @@ func (app *TacChainApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted ui
 		AccountKeeper:     app.AccountKeeper,
 		IBCKeeper:         app.IBCKeeper,
 		CircuitKeeper:     &app.CircuitKeeper,
+		InterfaceRegistry: app.interfaceRegistry,
 		EvmKeeper:         app.EVMKeeper,
 		FeeMarketKeeper:   app.FeeMarketKeeper,
+		TxPolicyKeeper:    &app.TxPolicyKeeper,
+		FeeAbsKeeper:      &app.FeeAbsKeeper,
 		MaxTxGasWanted:    maxGasWanted,
 		PendingTxListener: app.onPendingTx,
 	},
@@ -993,8 +1114,34 @@ func (app *TacChainApp) onPendingTx(hash ethcmn.Hash) {
 	}
 }
 
+// configureRateLimiter sets up the node-local CheckTx rate limiter configured
+// in app.toml.
+func (app *TacChainApp) configureRateLimiter(appOpts servertypes.AppOptions, logger log.Logger) error {
+	cfg, err := ratelimit.ConfigFromAppOptions(appOpts)
+	if err != nil {
+		return err
+	}
+	if !cfg.Enable {
+		return nil
+	}
+
+	app.rateLimiter, err = ratelimit.NewLimiter(cfg)
+	if err != nil {
+		return err
+	}
+	logger.Info("CheckTx rate limiter enabled",
+		"sender_rate", cfg.SenderRate, "sender_burst", cfg.SenderBurst,
+		"ip_rate", cfg.IPRate, "ip_burst", cfg.IPBurst,
+	)
+	return nil
+}
+
 // configureEVMMempool sets up the ExperimentalEVMMempool required by the EVM JSON-RPC server.
 func (app *TacChainApp) configureEVMMempool(appOpts servertypes.AppOptions, logger log.Logger) error {
+	if err := app.configureEVMBroadcastQueue(appOpts, logger); err != nil {
+		return err
+	}
+
 	cosmosPoolMaxTx := evmconfig.GetCosmosPoolMaxTx(appOpts, logger)
 	if cosmosPoolMaxTx < 0 {
 		// -1 means "no limit" in the SDK default, but the EVM server requires
@@ -1009,13 +1156,7 @@ func (app *TacChainApp) configureEVMMempool(appOpts servertypes.AppOptions, logg
 		MinTip:           evmconfig.GetMinTip(appOpts, logger),
 		BroadCastTxFn: func(txs []*ethtypes.Transaction) error {
 			logger.Debug("broadcasting EVM transactions", "tx_count", len(txs))
-			txs = append([]*ethtypes.Transaction(nil), txs...)
-			go func() {
-				if err := app.broadcastEVMTransactions(txs); err != nil {
-					logger.Error("failed to broadcast EVM transactions", "err", err, "tx_count", len(txs))
-				}
-			}()
-			return nil
+			return app.enqueueEVMBroadcast(txs)
 		},
 	}
 
@@ -1030,50 +1171,201 @@ func (app *TacChainApp) configureEVMMempool(appOpts servertypes.AppOptions, logg
 		cosmosPoolMaxTx,
 	)
 	app.EVMMempool = evmMp
-	app.SetMempool(evmMp)
+	// the EVM txs paying their fee through a fee abstraction extension option
+	// are kept with it, which the EVM pool would drop
+	mempool := tacmempool.NewFeeAbsMempool(evmMp, cosmosPoolMaxTx)
+	app.SetMempool(mempool)
+	if app.mempoolFeed != nil {
+		app.mempoolFeedSub = app.mempoolFeed.SubscribeEVMPool(evmMp.GetTxPool())
+	}
 
-	checkTxHandler := evmmempool.NewCheckTxHandler(evmMp)
+	checkTxHandler := tacmempool.NewFeeAbsCheckTxHandler(app.txConfig.TxDecoder(), evmmempool.NewCheckTxHandler(evmMp))
+	if app.mempoolFeed != nil {
+		checkTxHandler = tacmempool.NewCheckTxHandler(app.mempoolFeed, app.txConfig.TxDecoder(), checkTxHandler)
+	}
+	if app.rateLimiter != nil {
+		checkTxHandler = ratelimit.NewCheckTxHandler(app.rateLimiter, app.txConfig.TxDecoder(), checkTxHandler)
+	}
 	app.SetCheckTxHandler(checkTxHandler)
 
-	abciProposalHandler := baseapp.NewDefaultProposalHandler(evmMp, app)
+	abciProposalHandler := baseapp.NewDefaultProposalHandler(mempool, app)
 	abciProposalHandler.SetSignerExtractionAdapter(
-		evmmempool.NewEthSignerExtractionAdapter(
-			sdkmempool.NewDefaultSignerExtractionAdapter(),
+		tacmempool.NewFeeAbsSignerExtractionAdapter(
+			evmmempool.NewEthSignerExtractionAdapter(
+				sdkmempool.NewDefaultSignerExtractionAdapter(),
+			),
 		),
 	)
+	lanesCfg, err := lanes.ConfigFromAppOptions(appOpts)
+	if err != nil {
+		return err
+	}
+	var txSelector baseapp.TxSelector = baseapp.NewDefaultTxSelector()
+	if lanesCfg.Enable {
+		txSelector = lanes.NewTxSelector(lanesCfg)
+	}
+	// proposals must respect the block gas limit ProcessProposal enforces
+	abciProposalHandler.SetTxSelector(newBlockGasLimitTxSelector(txSelector, mempoolCfg.BlockGasLimit))
 	app.SetPrepareProposal(abciProposalHandler.PrepareProposalHandler())
+	app.SetProcessProposal(NewProcessProposalHandler(app, mempoolCfg.BlockGasLimit))
 	return nil
 }
 
-func (app *TacChainApp) broadcastEVMTransactions(ethTxs []*ethtypes.Transaction) error {
-	for _, ethTx := range ethTxs {
-		msg := &evmvmtypes.MsgEthereumTx{}
-		msg.FromEthereumTx(ethTx)
+// configureEVMBroadcastQueue sets up the queue broadcasting the EVM txs the
+// mempool promotes, persisted in the node data directory. The queue is only
+// opened once the app has a broadcast path, see startEVMBroadcastQueue, so
+// that the apps of the CLI commands don't open it.
+func (app *TacChainApp) configureEVMBroadcastQueue(appOpts servertypes.AppOptions, logger log.Logger) error {
+	cfg, err := broadcast.ConfigFromAppOptions(appOpts)
+	if err != nil {
+		return err
+	}
 
-		txBuilder := app.txConfig.NewTxBuilder()
-		if err := txBuilder.SetMsgs(msg); err != nil {
-			return fmt.Errorf("failed to set msg in tx builder: %w", err)
+	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
+	backend := server.GetAppDBBackend(appOpts)
+	app.openEVMBroadcast = func() (*broadcast.Queue, error) {
+		var db dbm.DB = dbm.NewMemDB()
+		if homePath != "" {
+			var err error
+			db, err = dbm.NewDB("evm_broadcast", backend, filepath.Join(homePath, "data"))
+			if err != nil {
+				return nil, fmt.Errorf("failed to open EVM broadcast queue db: %w", err)
+			}
 		}
+		return broadcast.NewQueue(db, cfg, app.broadcastEVMTransaction, logger.With("module", "evm-broadcast"))
+	}
+	return nil
+}
 
-		txBytes, err := app.txConfig.TxEncoder()(txBuilder.GetTx())
-		if err != nil {
-			return fmt.Errorf("failed to encode transaction: %w", err)
-		}
+// configureEVMJournal sets up the journal of the EVM mempool txs, persisted
+// in the node data directory, and loads the txs to replay on startup. The
+// journal is rewritten from the mempool once they are replayed.
+func (app *TacChainApp) configureEVMJournal(appOpts servertypes.AppOptions, logger log.Logger) error {
+	cfg, err := journal.ConfigFromAppOptions(appOpts)
+	if err != nil {
+		return err
+	}
+	if !cfg.Enable {
+		return nil
+	}
+	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
+	if homePath == "" {
+		logger.Info("EVM mempool journal disabled without a home directory")
+		return nil
+	}
 
-		res, err := app.clientCtx.BroadcastTxSync(txBytes)
-		if err != nil {
-			return fmt.Errorf("failed to broadcast transaction %s: %w", ethTx.Hash().Hex(), err)
-		}
-		if res.Code != 0 {
-			return fmt.Errorf("transaction %s rejected by mempool: code=%d, log=%s", ethTx.Hash().Hex(), res.Code, res.RawLog)
-		}
+	app.evmJournal, err = journal.New(filepath.Join(homePath, "data", "evm_transactions.rlp"), cfg, logger.With("module", "evm-journal"))
+	if err != nil {
+		return err
+	}
+	if app.evmJournalTxs, err = app.evmJournal.Load(); err != nil {
+		return err
+	}
+	app.SetPrepareCheckStater(app.replayEVMJournal)
+	return nil
+}
+
+// configureMempoolAdmin sets up the node-local mempool admin gRPC service
+// when enabled.
+func (app *TacChainApp) configureMempoolAdmin(appOpts servertypes.AppOptions, logger log.Logger) error {
+	cfg, err := tacmempool.AdminConfigFromAppOptions(appOpts)
+	if err != nil {
+		return err
+	}
+	if !cfg.Enable {
+		return nil
+	}
+
+	legacyPool, ok := app.EVMMempool.GetTxPool().Subpools[0].(*legacypool.LegacyPool)
+	if !ok {
+		return errors.New("EVM mempool has no legacy pool")
+	}
+	cosmosPool, ok := app.Mempool().(tacmempool.CosmosPool)
+	if !ok {
+		return errors.New("app mempool cannot be selected from")
+	}
+	app.mempoolAdminCfg = cfg
+	app.mempoolAdmin = tacmempool.NewAdminServer(
+		legacyPool,
+		cosmosPool,
+		func() (sdk.Context, error) { return app.CreateQueryContext(0, false) },
+		app.txConfig.TxEncoder(),
+		logger.With("module", "mempool-admin"),
+	)
+	logger.Info("mempool admin gRPC service enabled", "address", cfg.Address)
+	return nil
+}
+
+// StartMempoolAdmin serves the mempool admin gRPC service, when enabled, on
+// its own listener until the app is closed. It is called by the start
+// command, so that the apps of the other commands don't listen.
+func (app *TacChainApp) StartMempoolAdmin() error {
+	if app.mempoolAdmin == nil || app.stopMempoolAdmin != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	grpcCodec := codec.NewProtoCodec(app.interfaceRegistry).GRPCCodec()
+	if err := tacmempool.StartAdminServer(ctx, app.mempoolAdminCfg, app.mempoolAdmin, grpcCodec, app.Logger().With("module", "mempool-admin")); err != nil {
+		cancel()
+		return err
+	}
+	app.stopMempoolAdmin = cancel
+	return nil
+}
+
+// configureMempoolStream sets up the node-local mempool stream gRPC service
+// when enabled. The cosmos txs are published by the CheckTx handler, and the
+// EVM txs by the EVM mempool once promoted to pending.
+func (app *TacChainApp) configureMempoolStream(appOpts servertypes.AppOptions, logger log.Logger) error {
+	cfg, err := tacmempool.StreamConfigFromAppOptions(appOpts)
+	if err != nil {
+		return err
+	}
+	if !cfg.Enable {
+		return nil
+	}
+
+	app.mempoolFeed, err = tacmempool.NewFeed(cfg)
+	if err != nil {
+		return err
 	}
+	logger.Info("mempool stream gRPC service enabled",
+		"buffer_size", cfg.BufferSize, "max_subscribers", cfg.MaxSubscribers,
+	)
 	return nil
 }
 
+// Close journals the EVM mempool, stops the EVM broadcast queue, leaving the
+// txs it still holds to the next start, and closes the app.
+func (app *TacChainApp) Close() error {
+	var errs []error
+	if app.stopMempoolAdmin != nil {
+		app.stopMempoolAdmin()
+	}
+	if app.mempoolFeedSub != nil {
+		app.mempoolFeedSub.Unsubscribe()
+	}
+	if app.evmJournal != nil {
+		errs = append(errs, app.evmJournal.Close())
+	}
+	if queue := app.broadcastQueue(); queue != nil {
+		errs = append(errs, queue.Close())
+	}
+	return errors.Join(append(errs, app.BaseApp.Close())...)
+}
+
 func (app *TacChainApp) setPostHandler() {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+	postHandler, err := NewPostHandler(
+		PostHandlerOptions{
+			BankKeeper:      app.BankKeeper,
+			FeeMarketKeeper: app.FeeMarketKeeper,
+			TxPolicyKeeper:  app.TxPolicyKeeper,
+			IBCKeeper:       app.IBCKeeper,
+			FeeAbsKeeper:    app.FeeAbsKeeper,
+			EVMKeeper:       app.EVMKeeper,
+			InflationKeeper: app.InflationKeeper,
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -1267,12 +1559,29 @@ func (app *TacChainApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.A
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
 	}
+
+	// the gRPC gateway broadcasts txs through the client context, bypassing
+	// the gRPC tx service limit
+	if app.rateLimiter != nil {
+		apiSvr.Router.Use(ratelimit.NewRESTMiddleware(app.rateLimiter))
+	}
+}
+
+// RateLimiter returns the node-local rate limiter, nil if disabled.
+func (app *TacChainApp) RateLimiter() *ratelimit.Limiter {
+	return app.rateLimiter
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *TacChainApp) RegisterTxService(clientCtx client.Context) {
 	app.SetClientCtx(clientCtx)
-	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	if app.rateLimiter == nil {
+		authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+		return
+	}
+
+	txServer := authtx.NewTxServer(clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	txtypes.RegisterServiceServer(app.BaseApp.GRPCQueryRouter(), ratelimit.NewTxServer(txServer, app.rateLimiter))
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -1290,17 +1599,40 @@ func (app *TacChainApp) RegisterNodeService(clientCtx client.Context, cfg config
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
 }
 
-// GetMempool returns the app's extended mempool (required by evmserver.Application).
+// GetMempool returns the EVM mempool (required by evmserver.Application). The
+// app-side mempool also keeps the EVM txs paying their fee through a fee
+// abstraction extension option next to it.
 func (app *TacChainApp) GetMempool() sdkmempool.ExtMempool {
-	if extMempool, ok := app.BaseApp.Mempool().(sdkmempool.ExtMempool); ok {
-		return extMempool
+	if app.EVMMempool == nil {
+		return nil
+	}
+	return app.EVMMempool
+}
+
+// RegisterGRPCServerWithSkipCheckHeader registers the gRPC services of the
+// app with the gRPC server of the node, along with the mempool stream service
+// when enabled, which is not routed through the CometBFT RPC.
+func (app *TacChainApp) RegisterGRPCServerWithSkipCheckHeader(server gogogrpc.Server, skipCheckHeader bool) {
+	app.BaseApp.RegisterGRPCServerWithSkipCheckHeader(server, skipCheckHeader)
+	if app.mempoolFeed != nil {
+		tacmempool.RegisterStreamServer(server, tacmempool.NewStreamServer(app.mempoolFeed))
 	}
-	return nil
 }
 
 // SetClientCtx stores the client context (required by evmserver.Application).
+// A client context with an RPC client starts the EVM broadcast queue. The
+// local client of the in-process node, set by the start command once the
+// node is started if one of its servers is enabled, sets the CometBFT
+// mempool of the node.
 func (app *TacChainApp) SetClientCtx(clientCtx client.Context) {
 	app.clientCtx = clientCtx
+	if localClient, ok := clientCtx.Client.(*local.Local); ok {
+		app.SetCometMempool(NewLocalCometMempool(localClient))
+		return
+	}
+	if clientCtx.Client != nil {
+		app.startEVMBroadcastQueue()
+	}
 }
 
 // RegisterPendingTxListener adds a listener for pending EVM transactions (required by evmserver.Application).
@@ -1328,6 +1660,11 @@ func BlockedAddresses() map[string]bool {
 	sort.Strings(accs)
 
 	for _, acc := range accs {
+		// the feeabs reserve can be funded with plain sends and community
+		// pool spends
+		if acc == feeabstypes.ModuleName {
+			continue
+		}
 		blockedAddrs[authtypes.NewModuleAddress(acc).String()] = true
 	}
 
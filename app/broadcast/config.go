@@ -0,0 +1,153 @@
+package broadcast
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// app.toml keys of the EVM broadcast queue
+const (
+	FlagMaxQueueSize   = "evm-broadcast.max-queue-size"
+	FlagMaxAttempts    = "evm-broadcast.max-attempts"
+	FlagInitialBackoff = "evm-broadcast.initial-backoff"
+	FlagMaxBackoff     = "evm-broadcast.max-backoff"
+	FlagMaxDeadLetters = "evm-broadcast.max-dead-letters"
+	FlagFlushTimeout   = "evm-broadcast.flush-timeout"
+)
+
+// Config is the node-local configuration of the queue broadcasting the EVM
+// txs the mempool promotes.
+type Config struct {
+	// MaxQueueSize is the maximum number of txs waiting to be broadcast.
+	MaxQueueSize uint64 `mapstructure:"max-queue-size"`
+	// MaxAttempts is the number of times a tx is broadcast before being
+	// dead-lettered.
+	MaxAttempts uint64 `mapstructure:"max-attempts"`
+	// InitialBackoff is the delay before the first retry of a tx, doubled on
+	// every retry.
+	InitialBackoff time.Duration `mapstructure:"initial-backoff"`
+	// MaxBackoff is the maximum delay between two attempts of a tx.
+	MaxBackoff time.Duration `mapstructure:"max-backoff"`
+	// MaxDeadLetters is the number of dead-lettered txs kept, the oldest
+	// being dropped first.
+	MaxDeadLetters uint64 `mapstructure:"max-dead-letters"`
+	// FlushTimeout is how long the queue keeps broadcasting the txs left on
+	// shutdown.
+	FlushTimeout time.Duration `mapstructure:"flush-timeout"`
+}
+
+// DefaultConfig returns the default EVM broadcast queue configuration.
+func DefaultConfig() Config {
+	return Config{
+		MaxQueueSize:   10_000,
+		MaxAttempts:    5,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		MaxDeadLetters: 1_000,
+		FlushTimeout:   5 * time.Second,
+	}
+}
+
+// ConfigFromAppOptions reads the EVM broadcast queue configuration from
+// app.toml.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+	if appOpts == nil {
+		return cfg, nil
+	}
+
+	var err error
+	if v := appOpts.Get(FlagMaxQueueSize); v != nil {
+		if cfg.MaxQueueSize, err = cast.ToUint64E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagMaxQueueSize, err)
+		}
+	}
+	if v := appOpts.Get(FlagMaxAttempts); v != nil {
+		if cfg.MaxAttempts, err = cast.ToUint64E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagMaxAttempts, err)
+		}
+	}
+	if v := appOpts.Get(FlagInitialBackoff); v != nil {
+		if cfg.InitialBackoff, err = cast.ToDurationE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagInitialBackoff, err)
+		}
+	}
+	if v := appOpts.Get(FlagMaxBackoff); v != nil {
+		if cfg.MaxBackoff, err = cast.ToDurationE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagMaxBackoff, err)
+		}
+	}
+	if v := appOpts.Get(FlagMaxDeadLetters); v != nil {
+		if cfg.MaxDeadLetters, err = cast.ToUint64E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagMaxDeadLetters, err)
+		}
+	}
+	if v := appOpts.Get(FlagFlushTimeout); v != nil {
+		if cfg.FlushTimeout, err = cast.ToDurationE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagFlushTimeout, err)
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// Validate validates the EVM broadcast queue configuration.
+func (c Config) Validate() error {
+	if c.MaxQueueSize == 0 {
+		return errors.New("max queue size must be positive")
+	}
+	if c.MaxAttempts == 0 {
+		return errors.New("max attempts must be positive")
+	}
+	if c.InitialBackoff <= 0 {
+		return fmt.Errorf("initial backoff must be positive: %s", c.InitialBackoff)
+	}
+	if c.MaxBackoff < c.InitialBackoff {
+		return fmt.Errorf("max backoff %s cannot be lower than the initial backoff %s", c.MaxBackoff, c.InitialBackoff)
+	}
+	if c.FlushTimeout < 0 {
+		return fmt.Errorf("flush timeout cannot be negative: %s", c.FlushTimeout)
+	}
+	return nil
+}
+
+// DefaultConfigTemplate is the app.toml template of the EVM broadcast queue
+// configuration.
+const DefaultConfigTemplate = `
+###############################################################################
+###                      EVM Broadcast Queue Configuration                  ###
+###############################################################################
+
+# The EVM txs the mempool promotes, e.g. once their nonce gap is filled, are
+# broadcast from a queue persisted in the node data directory. On shutdown, the queue
+# keeps broadcasting the txs left for up to the flush timeout, and the txs still queued
+# then are broadcast on the next start.
+[evm-broadcast]
+
+# MaxQueueSize is the maximum number of txs waiting to be broadcast. The txs promoted
+# while the queue is full are dead-lettered.
+max-queue-size = {{ .EVMBroadcast.MaxQueueSize }}
+
+# MaxAttempts is the number of times a tx is broadcast before being dead-lettered.
+# Txs rejected by CheckTx for a reason retrying cannot fix are dead-lettered at once.
+max-attempts = {{ .EVMBroadcast.MaxAttempts }}
+
+# InitialBackoff is the delay before the first retry of a tx, doubled on every retry.
+initial-backoff = "{{ .EVMBroadcast.InitialBackoff }}"
+
+# MaxBackoff is the maximum delay between two attempts of a tx.
+max-backoff = "{{ .EVMBroadcast.MaxBackoff }}"
+
+# MaxDeadLetters is the number of dead-lettered txs kept with the reason they were
+# dropped, the oldest being dropped first.
+max-dead-letters = {{ .EVMBroadcast.MaxDeadLetters }}
+
+# FlushTimeout is how long the queue keeps broadcasting the txs left on shutdown. The
+# txs waiting for a retry after the timeout are not retried before the next start.
+flush-timeout = "{{ .EVMBroadcast.FlushTimeout }}"
+`
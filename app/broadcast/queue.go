@@ -0,0 +1,519 @@
+package broadcast
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"cosmossdk.io/log"
+)
+
+// store key prefixes of the queued and the dead-lettered txs, followed by
+// their big endian sequence number
+var (
+	pendingPrefix    = []byte{0x01}
+	deadLetterPrefix = []byte{0x02}
+)
+
+var (
+	// ErrQueueFull is returned when txs are dropped because the queue is full.
+	ErrQueueFull = errors.New("EVM broadcast queue is full")
+	// ErrClosed is returned when txs are enqueued after the queue is closed.
+	ErrClosed = errors.New("EVM broadcast queue is closed")
+)
+
+// BroadcastFn broadcasts a tx. It returns a Permanent error when retrying
+// cannot succeed.
+type BroadcastFn func(tx *ethtypes.Transaction) error
+
+// permanentError is an error retrying a broadcast cannot fix.
+type permanentError struct{ err error }
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent marks a broadcast error as one retrying cannot fix, so that the
+// tx is dead-lettered at once.
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// IsPermanent reports whether a broadcast error was marked Permanent.
+func IsPermanent(err error) bool {
+	var permanent permanentError
+	return errors.As(err, &permanent)
+}
+
+// record is the stored state of a queued tx.
+type record struct {
+	Tx          []byte    `json:"tx"`
+	Attempts    uint64    `json:"attempts"`
+	NextAttempt time.Time `json:"next_attempt"`
+	LastError   string    `json:"last_error,omitempty"`
+}
+
+type entry struct {
+	seq uint64
+	tx  *ethtypes.Transaction
+	record
+}
+
+// DeadLetter is a tx the queue gave up broadcasting.
+type DeadLetter struct {
+	Hash     common.Hash `json:"hash"`
+	Tx       []byte      `json:"tx"`
+	Attempts uint64      `json:"attempts"`
+	Reason   string      `json:"reason"`
+	Time     time.Time   `json:"time"`
+}
+
+// Queue broadcasts EVM txs in the order they are enqueued, retrying each of
+// them with an exponential backoff until it is broadcast, or dead-lettered
+// with the reason it was dropped. A tx failing doesn't hold back the txs
+// after it. Every change of the queue is written to its database before the
+// queue moves on. On Close, the queue keeps broadcasting the txs left for up
+// to the flush timeout, and the txs still queued then are broadcast on the
+// next start.
+type Queue struct {
+	cfg       Config
+	db        dbm.DB
+	broadcast BroadcastFn
+	logger    log.Logger
+	now       func() time.Time
+
+	mu          sync.Mutex
+	pending     map[uint64]*entry
+	nextSeq     uint64
+	deadLetters uint64
+	nextDeadSeq uint64
+	closed      bool
+	// flushDeadline is the time the txs left on Close are broadcast until
+	flushDeadline time.Time
+
+	wake  chan struct{}
+	flush chan struct{}
+	stop  chan struct{}
+	done  chan struct{}
+}
+
+// NewQueue creates a Queue storing its txs in db, which it closes on Close,
+// and starts broadcasting the txs db holds.
+func NewQueue(db dbm.DB, cfg Config, broadcast BroadcastFn, logger log.Logger) (*Queue, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	q := &Queue{
+		cfg:       cfg,
+		db:        db,
+		broadcast: broadcast,
+		logger:    logger,
+		now:       time.Now,
+		pending:   make(map[uint64]*entry),
+		wake:      make(chan struct{}, 1),
+		flush:     make(chan struct{}),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+	if len(q.pending) > 0 {
+		logger.Info("resuming EVM broadcast queue", "pending", len(q.pending))
+	}
+
+	go q.run()
+	return q, nil
+}
+
+// load loads the queued txs and counts the dead letters of the database.
+func (q *Queue) load() error {
+	it, err := dbm.IteratePrefix(q.db, pendingPrefix)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		e := &entry{seq: binary.BigEndian.Uint64(it.Key()[len(pendingPrefix):])}
+		if err := json.Unmarshal(it.Value(), &e.record); err != nil {
+			return fmt.Errorf("failed to decode queued EVM tx %d: %w", e.seq, err)
+		}
+		e.tx = new(ethtypes.Transaction)
+		if err := e.tx.UnmarshalBinary(e.Tx); err != nil {
+			return fmt.Errorf("failed to decode queued EVM tx %d: %w", e.seq, err)
+		}
+		q.pending[e.seq] = e
+		q.nextSeq = e.seq + 1
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	deadIt, err := dbm.IteratePrefix(q.db, deadLetterPrefix)
+	if err != nil {
+		return err
+	}
+	defer deadIt.Close()
+	for ; deadIt.Valid(); deadIt.Next() {
+		q.deadLetters++
+		q.nextDeadSeq = binary.BigEndian.Uint64(deadIt.Key()[len(deadLetterPrefix):]) + 1
+	}
+	return deadIt.Error()
+}
+
+// Enqueue queues txs to be broadcast. The txs that don't fit in the queue are
+// dead-lettered and ErrQueueFull is returned.
+func (q *Queue) Enqueue(txs []*ethtypes.Transaction) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrClosed
+	}
+
+	now := q.now()
+	batch := q.db.NewBatch()
+	defer batch.Close()
+
+	// the counters are only moved on once the batch is written
+	seq, deadSeq, deadLetters := q.nextSeq, q.nextDeadSeq, q.deadLetters
+	var added []*entry
+	dropped := 0
+	for _, tx := range txs {
+		txBytes, err := tx.MarshalBinary()
+		if err != nil {
+			return fmt.Errorf("failed to encode EVM tx %s: %w", tx.Hash().Hex(), err)
+		}
+		e := &entry{seq: seq, tx: tx, record: record{Tx: txBytes, NextAttempt: now}}
+		if uint64(len(q.pending)+len(added)) >= q.cfg.MaxQueueSize {
+			stored, err := q.deadLetter(batch, deadSeq, e, ErrQueueFull.Error())
+			if err != nil {
+				return err
+			}
+			if stored {
+				deadSeq++
+				deadLetters++
+			}
+			dropped++
+			continue
+		}
+		if err := q.setPending(batch, e); err != nil {
+			return err
+		}
+		added = append(added, e)
+		seq++
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	q.nextSeq, q.nextDeadSeq, q.deadLetters = seq, deadSeq, deadLetters
+	for _, e := range added {
+		q.pending[e.seq] = e
+	}
+	if err := q.pruneDeadLetters(); err != nil {
+		return err
+	}
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+
+	if dropped > 0 {
+		return fmt.Errorf("%w: dropped %d of %d txs", ErrQueueFull, dropped, len(txs))
+	}
+	return nil
+}
+
+// Pending returns the number of txs waiting to be broadcast.
+func (q *Queue) Pending() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending)
+}
+
+// DeadLetters returns the dead-lettered txs, the oldest first.
+func (q *Queue) DeadLetters() ([]DeadLetter, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	it, err := dbm.IteratePrefix(q.db, deadLetterPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var deadLetters []DeadLetter
+	for ; it.Valid(); it.Next() {
+		var deadLetter DeadLetter
+		if err := json.Unmarshal(it.Value(), &deadLetter); err != nil {
+			return nil, err
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return deadLetters, it.Error()
+}
+
+// Close stops accepting txs and keeps broadcasting the txs left until none
+// is due before the flush timeout. It then stops once the tx being broadcast,
+// if any, is done, and closes the database. The txs left are broadcast on the
+// next start.
+func (q *Queue) Close() error {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return nil
+	}
+	q.closed = true
+	q.flushDeadline = q.now().Add(q.cfg.FlushTimeout)
+	q.mu.Unlock()
+
+	close(q.flush)
+	timer := time.NewTimer(q.cfg.FlushTimeout)
+	defer timer.Stop()
+	select {
+	case <-q.done:
+	case <-timer.C:
+	}
+	close(q.stop)
+	<-q.done
+
+	q.logger.Info("closing EVM broadcast queue", "pending", q.Pending())
+	return q.db.Close()
+}
+
+// run broadcasts the due txs until the queue is stopped, or flushed once
+// closed.
+func (q *Queue) run() {
+	defer close(q.done)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	flush := q.flush
+	for {
+		wait, stopped := q.broadcastDue()
+		if stopped || (flush == nil && q.flushed(wait)) {
+			return
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		var next <-chan time.Time
+		if wait >= 0 {
+			timer.Reset(wait)
+			next = timer.C
+		}
+
+		select {
+		case <-q.stop:
+			return
+		case <-flush:
+			// the closed channel is only received once
+			flush = nil
+		case <-q.wake:
+		case <-next:
+		}
+	}
+}
+
+// flushed reports whether the closed queue is done broadcasting, with no tx
+// left or due before the flush deadline, wait being the time until the next
+// attempt.
+func (q *Queue) flushed(wait time.Duration) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return wait < 0 || q.now().Add(wait).After(q.flushDeadline)
+}
+
+// broadcastDue broadcasts the txs due for an attempt, in the order they were
+// enqueued, and returns the time until the next attempt, negative if no tx
+// is queued.
+func (q *Queue) broadcastDue() (wait time.Duration, stopped bool) {
+	q.mu.Lock()
+	now := q.now()
+	var due []*entry
+	for _, e := range q.pending {
+		if !e.NextAttempt.After(now) {
+			due = append(due, e)
+		}
+	}
+	q.mu.Unlock()
+	sort.Slice(due, func(i, j int) bool { return due[i].seq < due[j].seq })
+
+	for _, e := range due {
+		select {
+		case <-q.stop:
+			return 0, true
+		default:
+		}
+
+		err := q.broadcast(e.tx)
+
+		q.mu.Lock()
+		if err := q.settle(e, err); err != nil {
+			q.logger.Error("failed to update EVM broadcast queue", "tx", e.tx.Hash().Hex(), "err", err)
+		}
+		q.mu.Unlock()
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	wait = -1
+	now = q.now()
+	for _, e := range q.pending {
+		if d := max(e.NextAttempt.Sub(now), 0); wait < 0 || d < wait {
+			wait = d
+		}
+	}
+	return wait, false
+}
+
+// settle records the result of a broadcast attempt of e. The queue is only
+// updated once the result is written. It must be called with q.mu held.
+func (q *Queue) settle(e *entry, broadcastErr error) error {
+	batch := q.db.NewBatch()
+	defer batch.Close()
+
+	next := *e
+	next.Attempts++
+	deadLettered := false
+	switch {
+	case broadcastErr == nil:
+		if err := batch.Delete(pendingKey(e.seq)); err != nil {
+			return err
+		}
+
+	case IsPermanent(broadcastErr) || next.Attempts >= q.cfg.MaxAttempts:
+		reason := broadcastErr.Error()
+		if !IsPermanent(broadcastErr) {
+			reason = fmt.Sprintf("gave up after %d attempts: %s", next.Attempts, reason)
+		}
+		if err := batch.Delete(pendingKey(e.seq)); err != nil {
+			return err
+		}
+		stored, err := q.deadLetter(batch, q.nextDeadSeq, &next, reason)
+		if err != nil {
+			return err
+		}
+		deadLettered = stored
+
+	default:
+		next.LastError = broadcastErr.Error()
+		next.NextAttempt = q.now().Add(q.backoff(next.Attempts))
+		q.logger.Debug("retrying EVM tx broadcast", "tx", e.tx.Hash().Hex(), "attempts", next.Attempts, "next_attempt", next.NextAttempt, "err", broadcastErr)
+		if err := q.setPending(batch, &next); err != nil {
+			return err
+		}
+		if err := batch.WriteSync(); err != nil {
+			return err
+		}
+		e.record = next.record
+		return nil
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	delete(q.pending, e.seq)
+	if deadLettered {
+		q.nextDeadSeq++
+		q.deadLetters++
+	}
+	return q.pruneDeadLetters()
+}
+
+// backoff returns the delay before the next attempt of a tx that failed
+// attempts times.
+func (q *Queue) backoff(attempts uint64) time.Duration {
+	backoff := q.cfg.InitialBackoff
+	for i := uint64(1); i < attempts && backoff < q.cfg.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, q.cfg.MaxBackoff)
+}
+
+func (q *Queue) setPending(batch dbm.Batch, e *entry) error {
+	bz, err := json.Marshal(e.record)
+	if err != nil {
+		return err
+	}
+	return batch.Set(pendingKey(e.seq), bz)
+}
+
+// deadLetter writes e as the dead letter seq, and reports whether it was
+// stored. The dead letter counters are left to the caller, to move on once
+// the batch is written.
+func (q *Queue) deadLetter(batch dbm.Batch, seq uint64, e *entry, reason string) (bool, error) {
+	q.logger.Error("dropping EVM tx broadcast", "tx", e.tx.Hash().Hex(), "attempts", e.Attempts, "reason", reason)
+	if q.cfg.MaxDeadLetters == 0 {
+		return false, nil
+	}
+
+	bz, err := json.Marshal(DeadLetter{
+		Hash:     e.tx.Hash(),
+		Tx:       e.Tx,
+		Attempts: e.Attempts,
+		Reason:   reason,
+		Time:     q.now(),
+	})
+	if err != nil {
+		return false, err
+	}
+	if err := batch.Set(deadLetterKey(seq), bz); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// pruneDeadLetters drops the oldest dead letters above the maximum. It must
+// be called with q.mu held.
+func (q *Queue) pruneDeadLetters() error {
+	if q.deadLetters <= q.cfg.MaxDeadLetters {
+		return nil
+	}
+
+	it, err := dbm.IteratePrefix(q.db, deadLetterPrefix)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; it.Valid() && q.deadLetters-uint64(len(keys)) > q.cfg.MaxDeadLetters; it.Next() {
+		keys = append(keys, append([]byte(nil), it.Key()...))
+	}
+	if err := errors.Join(it.Error(), it.Close()); err != nil {
+		return err
+	}
+
+	batch := q.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	q.deadLetters -= uint64(len(keys))
+	return nil
+}
+
+func pendingKey(seq uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte(nil), pendingPrefix...), seq)
+}
+
+func deadLetterKey(seq uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte(nil), deadLetterPrefix...), seq)
+}
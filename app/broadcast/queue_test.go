@@ -0,0 +1,316 @@
+package broadcast
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"text/template"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+func newTx(nonce uint64) *ethtypes.Transaction {
+	return ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: nonce, Gas: 21_000, GasPrice: big.NewInt(1)})
+}
+
+func testConfig() Config {
+	return Config{
+		MaxQueueSize:   10,
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		MaxDeadLetters: 10,
+		FlushTimeout:   time.Second,
+	}
+}
+
+// broadcaster records the broadcast txs and fails them as scripted.
+type broadcaster struct {
+	mu        sync.Mutex
+	errs      map[uint64][]error
+	attempts  map[uint64]int
+	broadcast []uint64
+}
+
+func newBroadcaster(errs map[uint64][]error) *broadcaster {
+	return &broadcaster{errs: errs, attempts: make(map[uint64]int)}
+}
+
+func (b *broadcaster) fn(tx *ethtypes.Transaction) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	nonce := tx.Nonce()
+	attempt := b.attempts[nonce]
+	b.attempts[nonce]++
+	if errs := b.errs[nonce]; attempt < len(errs) && errs[attempt] != nil {
+		return errs[attempt]
+	}
+	b.broadcast = append(b.broadcast, nonce)
+	return nil
+}
+
+func (b *broadcaster) broadcastNonces() []uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]uint64(nil), b.broadcast...)
+}
+
+func TestQueueRetries(t *testing.T) {
+	transient := errors.New("connection refused")
+	b := newBroadcaster(map[uint64][]error{
+		0: {transient, transient},
+		1: {Permanent(errors.New("insufficient funds"))},
+		2: {transient, transient, transient},
+	})
+	q, err := NewQueue(dbm.NewMemDB(), testConfig(), b.fn, log.NewNopLogger())
+	require.NoError(t, err)
+
+	require.NoError(t, q.Enqueue([]*ethtypes.Transaction{newTx(0), newTx(1), newTx(2), newTx(3)}))
+	require.Eventually(t, func() bool { return q.Pending() == 0 }, time.Second, time.Millisecond)
+
+	// the failures of the first txs don't hold back the last one
+	require.Equal(t, []uint64{3, 0}, b.broadcastNonces())
+
+	deadLetters, err := q.DeadLetters()
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 2)
+	require.Equal(t, newTx(1).Hash(), deadLetters[0].Hash)
+	require.Equal(t, uint64(1), deadLetters[0].Attempts)
+	require.Equal(t, "insufficient funds", deadLetters[0].Reason)
+	require.Equal(t, newTx(2).Hash(), deadLetters[1].Hash)
+	require.Equal(t, uint64(3), deadLetters[1].Attempts)
+	require.Equal(t, "gave up after 3 attempts: connection refused", deadLetters[1].Reason)
+
+	var tx ethtypes.Transaction
+	require.NoError(t, tx.UnmarshalBinary(deadLetters[1].Tx))
+	require.Equal(t, newTx(2).Hash(), tx.Hash())
+	require.NoError(t, q.Close())
+}
+
+func TestQueueFull(t *testing.T) {
+	release := make(chan struct{})
+	cfg := testConfig()
+	cfg.MaxQueueSize = 2
+	cfg.MaxDeadLetters = 1
+	q, err := NewQueue(dbm.NewMemDB(), cfg, func(*ethtypes.Transaction) error {
+		<-release
+		return nil
+	}, log.NewNopLogger())
+	require.NoError(t, err)
+
+	err = q.Enqueue([]*ethtypes.Transaction{newTx(0), newTx(1), newTx(2), newTx(3)})
+	require.ErrorIs(t, err, ErrQueueFull)
+	require.Equal(t, 2, q.Pending())
+
+	// the oldest dead letters are dropped
+	deadLetters, err := q.DeadLetters()
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, newTx(3).Hash(), deadLetters[0].Hash)
+	require.Equal(t, ErrQueueFull.Error(), deadLetters[0].Reason)
+
+	close(release)
+	require.Eventually(t, func() bool { return q.Pending() == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, q.Enqueue([]*ethtypes.Transaction{newTx(4)}))
+
+	require.NoError(t, q.Close())
+	require.ErrorIs(t, q.Enqueue([]*ethtypes.Transaction{newTx(5)}), ErrClosed)
+}
+
+func TestQueuePersistence(t *testing.T) {
+	dir := t.TempDir()
+	cfg := testConfig()
+	cfg.InitialBackoff = time.Hour
+	cfg.MaxBackoff = time.Hour
+
+	db, err := dbm.NewGoLevelDB("evm_broadcast", dir, nil)
+	require.NoError(t, err)
+	failing := newBroadcaster(map[uint64][]error{
+		0: {errors.New("connection refused")},
+		1: {errors.New("connection refused")},
+	})
+	q, err := NewQueue(db, cfg, failing.fn, log.NewNopLogger())
+	require.NoError(t, err)
+	require.NoError(t, q.Enqueue([]*ethtypes.Transaction{newTx(0), newTx(1)}))
+	require.Eventually(t, func() bool {
+		failing.mu.Lock()
+		defer failing.mu.Unlock()
+		return failing.attempts[1] == 1
+	}, time.Second, time.Millisecond)
+	require.NoError(t, q.Close())
+
+	// the txs left on shutdown are broadcast on the next start
+	db, err = dbm.NewGoLevelDB("evm_broadcast", dir, nil)
+	require.NoError(t, err)
+	cfg.InitialBackoff = time.Millisecond
+	cfg.MaxBackoff = time.Millisecond
+	b := newBroadcaster(nil)
+	q, err = NewQueue(db, cfg, b.fn, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, 2, q.Pending())
+
+	// retried after the backoff persisted before the restart
+	q.mu.Lock()
+	for _, e := range q.pending {
+		require.Equal(t, uint64(1), e.Attempts)
+		require.Equal(t, "connection refused", e.LastError)
+		e.NextAttempt = time.Time{}
+	}
+	q.mu.Unlock()
+	require.NoError(t, q.Enqueue([]*ethtypes.Transaction{newTx(2)}))
+
+	require.Eventually(t, func() bool { return q.Pending() == 0 }, time.Second, time.Millisecond)
+	require.Equal(t, []uint64{0, 1, 2}, b.broadcastNonces())
+	require.NoError(t, q.Close())
+}
+
+func TestQueueCloseFlushes(t *testing.T) {
+	release := make(chan struct{})
+	b := newBroadcaster(nil)
+	q, err := NewQueue(dbm.NewMemDB(), testConfig(), func(tx *ethtypes.Transaction) error {
+		<-release
+		return b.fn(tx)
+	}, log.NewNopLogger())
+	require.NoError(t, err)
+	require.NoError(t, q.Enqueue([]*ethtypes.Transaction{newTx(0), newTx(1), newTx(2)}))
+
+	// the txs left on shutdown are broadcast before the queue is closed
+	closed := make(chan error)
+	go func() { closed <- q.Close() }()
+	require.Eventually(t, func() bool {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		return q.closed
+	}, time.Second, time.Millisecond)
+	close(release)
+	require.NoError(t, <-closed)
+	require.Equal(t, []uint64{0, 1, 2}, b.broadcastNonces())
+	require.Zero(t, q.Pending())
+}
+
+func TestQueueCloseFlushTimeout(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxAttempts = 1_000
+	cfg.FlushTimeout = 50 * time.Millisecond
+	transient := errors.New("connection refused")
+	q, err := NewQueue(dbm.NewMemDB(), cfg, func(*ethtypes.Transaction) error { return transient }, log.NewNopLogger())
+	require.NoError(t, err)
+	require.NoError(t, q.Enqueue([]*ethtypes.Transaction{newTx(0)}))
+
+	// the txs still failing at the flush timeout are kept for the next start
+	start := time.Now()
+	require.NoError(t, q.Close())
+	require.Less(t, time.Since(start), time.Second)
+	require.Equal(t, 1, q.Pending())
+}
+
+// failingDB is a database whose batch writes fail while fail is set.
+type failingDB struct {
+	dbm.DB
+	fail bool
+}
+
+func (db *failingDB) NewBatch() dbm.Batch { return failingBatch{db.DB.NewBatch(), db} }
+
+type failingBatch struct {
+	dbm.Batch
+	db *failingDB
+}
+
+func (b failingBatch) WriteSync() error {
+	if b.db.fail {
+		return errors.New("disk full")
+	}
+	return b.Batch.WriteSync()
+}
+
+func TestQueueFailedWrite(t *testing.T) {
+	release := make(chan struct{})
+	cfg := testConfig()
+	cfg.MaxQueueSize = 1
+	db := &failingDB{DB: dbm.NewMemDB()}
+	q, err := NewQueue(db, cfg, func(*ethtypes.Transaction) error {
+		<-release
+		return nil
+	}, log.NewNopLogger())
+	require.NoError(t, err)
+
+	// a failed write leaves the queue as it was
+	q.mu.Lock()
+	db.fail = true
+	q.mu.Unlock()
+	require.ErrorContains(t, q.Enqueue([]*ethtypes.Transaction{newTx(0), newTx(1)}), "disk full")
+	q.mu.Lock()
+	require.Empty(t, q.pending)
+	require.Zero(t, q.nextSeq)
+	require.Zero(t, q.nextDeadSeq)
+	require.Zero(t, q.deadLetters)
+	db.fail = false
+	q.mu.Unlock()
+
+	require.ErrorIs(t, q.Enqueue([]*ethtypes.Transaction{newTx(0), newTx(1)}), ErrQueueFull)
+	q.mu.Lock()
+	require.Len(t, q.pending, 1)
+	require.Equal(t, uint64(1), q.nextSeq)
+	require.Equal(t, uint64(1), q.nextDeadSeq)
+	require.Equal(t, uint64(1), q.deadLetters)
+	q.mu.Unlock()
+
+	close(release)
+	require.Eventually(t, func() bool { return q.Pending() == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, q.Close())
+}
+
+func TestQueueBackoff(t *testing.T) {
+	q := &Queue{cfg: Config{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}}
+	require.Equal(t, time.Second, q.backoff(1))
+	require.Equal(t, 2*time.Second, q.backoff(2))
+	require.Equal(t, 4*time.Second, q.backoff(3))
+	require.Equal(t, 5*time.Second, q.backoff(4))
+	require.Equal(t, 5*time.Second, q.backoff(100))
+}
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, DefaultConfig().Validate())
+
+	cfg := DefaultConfig()
+	cfg.MaxQueueSize = 0
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.MaxAttempts = 0
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.MaxBackoff = cfg.InitialBackoff / 2
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.FlushTimeout = -time.Second
+	require.Error(t, cfg.Validate())
+}
+
+func TestConfigTemplate(t *testing.T) {
+	cfg := testConfig()
+
+	tmpl, err := template.New("app").Parse(DefaultConfigTemplate)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, struct{ EVMBroadcast Config }{cfg}))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+	parsed, err := ConfigFromAppOptions(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg, parsed)
+}
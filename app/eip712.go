@@ -0,0 +1,219 @@
+package app
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	evmanteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/ethereum/eip712"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// evmChainIDRegex matches the EVM chain ID of cosmos chain IDs such as
+// tacchain_239-1.
+var evmChainIDRegex = regexp.MustCompile(`^[a-z]+[a-z0-9_]*_([0-9]+)-[0-9]+$`)
+
+// EVMChainIDFromChainID returns the EVM chain ID embedded in a cosmos chain
+// ID, e.g. 239 for tacchain_239-1.
+func EVMChainIDFromChainID(chainID string) (uint64, error) {
+	matches := evmChainIDRegex.FindStringSubmatch(chainID)
+	if matches == nil {
+		return 0, fmt.Errorf("chain ID %s does not embed an EVM chain ID", chainID)
+	}
+	return strconv.ParseUint(matches[1], 10, 64)
+}
+
+// EIP712TypedData returns the EIP-712 typed data the fee payer of a cosmos tx
+// signs to authorize it with the ExtensionOptionsWeb3Tx option. The message is
+// the legacy amino JSON sign doc of the tx, the domain uses the EVM chain ID.
+func EIP712TypedData(
+	cdc codectypes.AnyUnpacker,
+	tx authsigning.Tx,
+	chainID string,
+	accountNumber, sequence, evmChainID uint64,
+	feePayer sdk.AccAddress,
+) (apitypes.TypedData, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return apitypes.TypedData{}, errorsmod.Wrap(errortypes.ErrNoSignatures, "tx doesn't contain any msgs to sign")
+	}
+
+	signBytes := legacytx.StdSignBytes( //nolint:staticcheck // EIP-712 signs the legacy amino JSON sign doc
+		chainID,
+		accountNumber,
+		sequence,
+		tx.GetTimeoutHeight(),
+		legacytx.StdFee{
+			Amount: tx.GetFee(),
+			Gas:    tx.GetGas(),
+		},
+		msgs, tx.GetMemo(),
+	)
+
+	return eip712.LegacyWrapTxToTypedData(cdc, evmChainID, msgs[0], signBytes, &eip712.FeeDelegationOptions{FeePayer: feePayer})
+}
+
+// EIP712SigVerificationDecorator verifies the signature of cosmos txs signed
+// by an ethsecp256k1 account as EIP-712 typed data, e.g. with MetaMask. The
+// signature is carried by the ExtensionOptionsWeb3Tx option of the tx and
+// the tx signature itself must be empty. It replaces the SDK
+// SigVerificationDecorator for these txs.
+//
+// Unlike the deprecated cosmos EVM LegacyEip712SigVerificationDecorator, the
+// typed data domain uses the EVM chain ID: the cosmos chain ID of the chain is
+// not a number.
+// CONTRACT: Pubkeys are set in context for all signers before this decorator runs
+type EIP712SigVerificationDecorator struct {
+	ak  evmanteinterfaces.AccountKeeper
+	cdc codectypes.AnyUnpacker
+}
+
+// NewEIP712SigVerificationDecorator creates a new EIP712SigVerificationDecorator.
+// cdc unpacks the Any fields of the tx messages.
+func NewEIP712SigVerificationDecorator(ak evmanteinterfaces.AccountKeeper, cdc codectypes.AnyUnpacker) EIP712SigVerificationDecorator {
+	return EIP712SigVerificationDecorator{ak: ak, cdc: cdc}
+}
+
+func (svd EIP712SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// no need to verify signatures on recheck tx
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "tx %T doesn't implement the authsigning.Tx interface", tx)
+	}
+	if unorderedTx, ok := tx.(sdk.TxWithUnordered); ok && unorderedTx.GetUnordered() {
+		return ctx, errorsmod.Wrap(errortypes.ErrNotSupported, "unordered txs cannot be signed with EIP-712")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	// EIP-712 allows just one signature
+	if len(sigs) != 1 || len(signers) != 1 {
+		return ctx, errorsmod.Wrapf(errortypes.ErrTooManySignatures, "EIP-712 signed txs must have exactly one signer, got %d signers and %d signatures", len(signers), len(sigs))
+	}
+
+	acc, err := authante.GetSignerAcc(ctx, svd.ak, signers[0])
+	if err != nil {
+		return ctx, err
+	}
+	if sigs[0].Sequence != acc.GetSequence() {
+		return ctx, errorsmod.Wrapf(errortypes.ErrWrongSequence, "account sequence mismatch, expected %d, got %d", acc.GetSequence(), sigs[0].Sequence)
+	}
+
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	pubKey, ok := acc.GetPubKey().(*ethsecp256k1.PubKey)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "EIP-712 signed txs require an %s account", ethsecp256k1.KeyType)
+	}
+
+	var accNum uint64
+	if ctx.BlockHeight() > 0 {
+		accNum = acc.GetAccountNumber()
+	}
+	if err := svd.verifySignature(ctx, sigTx, sigs[0].Data, pubKey, accNum, acc.GetSequence()); err != nil {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "signature verification failed; please verify account number (%d) and chain-id (%s): %s", accNum, ctx.ChainID(), err)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (svd EIP712SigVerificationDecorator) verifySignature(
+	ctx sdk.Context,
+	tx authsigning.Tx,
+	sigData signing.SignatureData,
+	pubKey *ethsecp256k1.PubKey,
+	accountNumber, sequence uint64,
+) error {
+	data, ok := sigData.(*signing.SingleSignatureData)
+	if !ok || data.SignMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return errorsmod.Wrapf(errortypes.ErrNotSupported, "unexpected SignatureData %T: EIP-712 signatures use SIGN_MODE_LEGACY_AMINO_JSON", sigData)
+	}
+	// the signature is carried by the extension option
+	if len(data.Signature) != 0 {
+		return errorsmod.Wrap(errortypes.ErrTooManySignatures, "EIP-712 signed txs must have an empty tx signature")
+	}
+
+	option, err := web3TxOption(tx)
+	if err != nil {
+		return err
+	}
+
+	evmChainID := evmtypes.GetEthChainConfig().ChainID.Uint64()
+	if option.TypedDataChainID != evmChainID {
+		return errorsmod.Wrapf(errortypes.ErrInvalidChainID, "invalid typed data chain ID %d, expected %d", option.TypedDataChainID, evmChainID)
+	}
+
+	feePayer, err := sdk.AccAddressFromBech32(option.FeePayer)
+	if err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid fee payer address: %s", err)
+	}
+	if !feePayer.Equals(sdk.AccAddress(pubKey.Address())) {
+		return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "fee payer %s is not the signer of the tx", feePayer)
+	}
+
+	typedData, err := EIP712TypedData(svd.cdc, tx, ctx.ChainID(), accountNumber, sequence, evmChainID, feePayer)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to create EIP-712 typed data from tx")
+	}
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return err
+	}
+
+	sig := option.FeePayerSig
+	if len(sig) != ethcrypto.SignatureLength {
+		return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "signature length doesn't match typical [R||S||V] signature 65 bytes")
+	}
+
+	// ethsecp256k1 verifies the 64 byte [R||S] signature
+	if !ethcrypto.VerifySignature(pubKey.Bytes(), sigHash, sig[:ethcrypto.RecoveryIDOffset]) {
+		return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "unable to verify signer signature of EIP-712 typed data")
+	}
+	return nil
+}
+
+func web3TxOption(tx sdk.Tx) (*eip712.ExtensionOptionsWeb3Tx, error) {
+	if extTx, ok := tx.(authante.HasExtensionOptionsTx); ok {
+		if opts := extTx.GetExtensionOptions(); len(opts) == 1 {
+			if option, ok := opts[0].GetCachedValue().(*eip712.ExtensionOptionsWeb3Tx); ok {
+				return option, nil
+			}
+		}
+	}
+	return nil, errorsmod.Wrapf(errortypes.ErrUnknownExtensionOptions, "expected a single %T extension option", (*eip712.ExtensionOptionsWeb3Tx)(nil))
+}
+
+// isWeb3TxExtensionOption is the extension option checker of EIP-712 signed
+// cosmos txs.
+func isWeb3TxExtensionOption(anyType *codectypes.Any) bool {
+	_, ok := anyType.GetCachedValue().(*eip712.ExtensionOptionsWeb3Tx)
+	return ok
+}
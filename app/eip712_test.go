@@ -0,0 +1,165 @@
+package app
+
+import (
+	"testing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	evmencoding "github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/ethereum/eip712"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func TestEVMChainIDFromChainID(t *testing.T) {
+	testCases := []struct {
+		chainID    string
+		evmChainID uint64
+		success    bool
+	}{
+		{"tacchain_239-1", 239, true},
+		{"tacchain_2391-12", 2391, true},
+		{"tac_test_1-1", 1, true},
+		{"tacchain-239", 0, false},
+		{"tacchain_239", 0, false},
+		{"239", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.chainID, func(t *testing.T) {
+			evmChainID, err := EVMChainIDFromChainID(tc.chainID)
+			if !tc.success {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.evmChainID, evmChainID)
+		})
+	}
+}
+
+func TestEIP712SigVerification(t *testing.T) {
+	require.NoError(t, evmtypes.SetChainConfig(nil))
+	evmChainID := evmtypes.GetEthChainConfig().ChainID.Uint64()
+
+	encodingConfig := evmencoding.MakeConfig(evmChainID)
+	banktypes.RegisterLegacyAminoCodec(encodingConfig.Amino)
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey().(*ethsecp256k1.PubKey)
+	signer := sdk.AccAddress(pubKey.Address())
+	otherKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+
+	const (
+		chainID       = "tacchain_239-1"
+		accountNumber = 7
+		sequence      = 3
+	)
+	ctx := sdk.Context{}.WithChainID(chainID)
+
+	// newTx returns a bank send of signer signed as EIP-712 typed data by key
+	newTx := func(key *ethsecp256k1.PrivKey, typedDataChainID uint64, feePayer sdk.AccAddress) authsigning.Tx {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(signer, sdk.AccAddress("recipient"), sdk.NewCoins(sdk.NewInt64Coin("utac", 100)))))
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("utac", 1_000)))
+		txBuilder.SetGasLimit(200_000)
+		txBuilder.SetMemo("eip712")
+
+		typedData, err := EIP712TypedData(encodingConfig.InterfaceRegistry, txBuilder.GetTx(), chainID, accountNumber, sequence, typedDataChainID, feePayer)
+		require.NoError(t, err)
+		sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+		require.NoError(t, err)
+		ecdsaKey, err := key.ToECDSA()
+		require.NoError(t, err)
+		sig, err := ethcrypto.Sign(sigHash, ecdsaKey)
+		require.NoError(t, err)
+
+		option, err := codectypes.NewAnyWithValue(&eip712.ExtensionOptionsWeb3Tx{
+			TypedDataChainID: typedDataChainID,
+			FeePayer:         feePayer.String(),
+			FeePayerSig:      sig,
+		})
+		require.NoError(t, err)
+		txBuilder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(option)
+		return txBuilder.GetTx()
+	}
+
+	emptySig := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}
+
+	testCases := []struct {
+		name     string
+		tx       authsigning.Tx
+		sigData  signing.SignatureData
+		sequence uint64
+		success  bool
+	}{
+		{
+			name:     "valid signature",
+			tx:       newTx(privKey, evmChainID, signer),
+			sigData:  emptySig,
+			sequence: sequence,
+			success:  true,
+		},
+		{
+			name:     "signed by another key",
+			tx:       newTx(otherKey, evmChainID, signer),
+			sigData:  emptySig,
+			sequence: sequence,
+		},
+		{
+			name:     "signed for another sequence",
+			tx:       newTx(privKey, evmChainID, signer),
+			sigData:  emptySig,
+			sequence: sequence + 1,
+		},
+		{
+			name:     "wrong typed data chain ID",
+			tx:       newTx(privKey, evmChainID+1, signer),
+			sigData:  emptySig,
+			sequence: sequence,
+		},
+		{
+			name:     "fee payer is not the signer",
+			tx:       newTx(otherKey, evmChainID, sdk.AccAddress(otherKey.PubKey().Address())),
+			sigData:  emptySig,
+			sequence: sequence,
+		},
+		{
+			name:     "direct sign mode",
+			tx:       newTx(privKey, evmChainID, signer),
+			sigData:  &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			sequence: sequence,
+		},
+		{
+			name:     "non-empty tx signature",
+			tx:       newTx(privKey, evmChainID, signer),
+			sigData:  &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: []byte{1}},
+			sequence: sequence,
+		},
+	}
+
+	svd := NewEIP712SigVerificationDecorator(nil, encodingConfig.InterfaceRegistry)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := svd.verifySignature(ctx, tc.tx, tc.sigData, pubKey, accountNumber, tc.sequence)
+			if tc.success {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
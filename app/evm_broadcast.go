@@ -0,0 +1,202 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtmempool "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/rpc/client/local"
+	cmttypes "github.com/cometbft/cometbft/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/TacBuild/tacchain/app/broadcast"
+	"github.com/TacBuild/tacchain/app/ratelimit"
+
+	errorsmod "cosmossdk.io/errors"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/evm/mempool/txpool"
+	evmvmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// cometCheckTxTimeout bounds the wait for the CometBFT mempool to run CheckTx
+// on an inserted tx.
+const cometCheckTxTimeout = 10 * time.Second
+
+// CometMempool is the CometBFT mempool the EVM txs the app mempool promotes are
+// inserted into, e.g. the mempool of the in-process node.
+type CometMempool interface {
+	CheckTx(tx cmttypes.Tx, callback func(*abci.ResponseCheckTx), txInfo cmtmempool.TxInfo) error
+}
+
+// localCometMempool is the CometBFT mempool of the in-process node, reached
+// through its local client, which runs CheckTx in the node mempool without
+// going through the RPC server.
+type localCometMempool struct {
+	client *local.Local
+}
+
+// NewLocalCometMempool returns the CometBFT mempool of the in-process node of
+// a local client.
+func NewLocalCometMempool(client *local.Local) CometMempool {
+	return localCometMempool{client: client}
+}
+
+// CheckTx implements CometMempool. The callback is called with the CheckTx
+// result before it returns.
+func (m localCometMempool) CheckTx(tx cmttypes.Tx, callback func(*abci.ResponseCheckTx), _ cmtmempool.TxInfo) error {
+	res, err := m.client.BroadcastTxSync(context.Background(), tx)
+	if err != nil {
+		return err
+	}
+	callback(&abci.ResponseCheckTx{
+		Code:      res.Code,
+		Data:      res.Data,
+		Log:       res.Log,
+		Codespace: res.Codespace,
+	})
+	return nil
+}
+
+// SetCometMempool sets the CometBFT mempool the promoted EVM txs are inserted
+// into in-process, and starts the EVM broadcast queue. Until it is set, they
+// are broadcast through the CometBFT RPC client of the client context.
+func (app *TacChainApp) SetCometMempool(mempool CometMempool) {
+	if mempool == nil {
+		app.cometMempool.Store(nil)
+		return
+	}
+	app.cometMempool.Store(&mempool)
+	app.startEVMBroadcastQueue()
+}
+
+// startEVMBroadcastQueue opens the EVM broadcast queue, which resumes
+// broadcasting the txs it holds, unless it is already open. It is called
+// once the app has a broadcast path.
+func (app *TacChainApp) startEVMBroadcastQueue() {
+	app.evmBroadcastMu.Lock()
+	defer app.evmBroadcastMu.Unlock()
+	if app.evmBroadcastQueue != nil || app.openEVMBroadcast == nil {
+		return
+	}
+
+	queue, err := app.openEVMBroadcast()
+	if err != nil {
+		panic(fmt.Errorf("failed to start EVM broadcast queue: %w", err))
+	}
+	app.evmBroadcastQueue = queue
+}
+
+// broadcastQueue returns the EVM broadcast queue, nil until it is started.
+func (app *TacChainApp) broadcastQueue() *broadcast.Queue {
+	app.evmBroadcastMu.Lock()
+	defer app.evmBroadcastMu.Unlock()
+	return app.evmBroadcastQueue
+}
+
+// enqueueEVMBroadcast queues EVM txs to be broadcast. It fails until the app
+// has a broadcast path.
+func (app *TacChainApp) enqueueEVMBroadcast(txs []*ethtypes.Transaction) error {
+	queue := app.broadcastQueue()
+	if queue == nil {
+		return errors.New("EVM broadcast queue is not started: neither a CometBFT mempool nor an RPC client is set")
+	}
+	return queue.Enqueue(txs)
+}
+
+// broadcastEVMTransaction inserts an EVM tx the mempool promoted into the
+// CometBFT mempool, falling back to the CometBFT RPC of the node. The txs
+// CheckTx rejects are not retried, unless the mempool is full.
+func (app *TacChainApp) broadcastEVMTransaction(ethTx *ethtypes.Transaction) error {
+	// the tx is built like the JSON-RPC broadcasts, with its sender, fee and
+	// ExtensionOptionsEthereumTx option, for CheckTx to accept it
+	msg := &evmvmtypes.MsgEthereumTx{}
+	signer := ethtypes.LatestSignerForChainID(evmvmtypes.GetEthChainConfig().ChainID)
+	if err := msg.FromSignedEthereumTx(ethTx, signer); err != nil {
+		return broadcast.Permanent(fmt.Errorf("failed to recover transaction sender: %w", err))
+	}
+
+	tx, err := msg.BuildTx(app.txConfig.NewTxBuilder(), evmvmtypes.GetEVMCoinDenom())
+	if err != nil {
+		return broadcast.Permanent(fmt.Errorf("failed to build transaction: %w", err))
+	}
+
+	txBytes, err := app.txConfig.TxEncoder()(tx)
+	if err != nil {
+		return broadcast.Permanent(fmt.Errorf("failed to encode transaction: %w", err))
+	}
+
+	if cometMempool := app.cometMempool.Load(); cometMempool != nil {
+		return app.insertEVMTransaction(*cometMempool, ethTx, txBytes)
+	}
+	if app.clientCtx.Client != nil {
+		return app.broadcastEVMTransactionRPC(ethTx, txBytes)
+	}
+	// retrying cannot succeed without a broadcast path
+	return broadcast.Permanent(fmt.Errorf("failed to broadcast transaction %s: neither a CometBFT mempool nor an RPC client is set", ethTx.Hash().Hex()))
+}
+
+// insertEVMTransaction runs CheckTx on an EVM tx through the CometBFT mempool,
+// which adds it to the mempool and gossips it to the peers once accepted.
+func (app *TacChainApp) insertEVMTransaction(cometMempool CometMempool, ethTx *ethtypes.Transaction, txBytes []byte) error {
+	resCh := make(chan *abci.ResponseCheckTx, 1)
+	err := cometMempool.CheckTx(txBytes, func(res *abci.ResponseCheckTx) {
+		resCh <- res
+	}, cmtmempool.TxInfo{})
+	if err != nil {
+		// the CometBFT mempool cache already holds the tx
+		if errors.Is(err, cmtmempool.ErrTxInCache) {
+			return nil
+		}
+		err = fmt.Errorf("failed to insert transaction %s: %w", ethTx.Hash().Hex(), err)
+		if errors.As(err, &cmtmempool.ErrTxTooLarge{}) {
+			return broadcast.Permanent(err)
+		}
+		return err
+	}
+
+	select {
+	case res := <-resCh:
+		return checkTxError(ethTx, res.Code, res.Codespace, res.Log)
+	case <-time.After(cometCheckTxTimeout):
+		return fmt.Errorf("transaction %s: CheckTx result not received within %s", ethTx.Hash().Hex(), cometCheckTxTimeout)
+	}
+}
+
+// broadcastEVMTransactionRPC broadcasts an EVM tx through the CometBFT RPC
+// client of the client context.
+func (app *TacChainApp) broadcastEVMTransactionRPC(ethTx *ethtypes.Transaction, txBytes []byte) error {
+	// the CometBFT mempool errors are returned as the matching sdk error codes
+	res, err := app.clientCtx.BroadcastTxSync(txBytes)
+	if err != nil {
+		return fmt.Errorf("failed to broadcast transaction %s: %w", ethTx.Hash().Hex(), err)
+	}
+	return checkTxError(ethTx, res.Code, res.Codespace, res.RawLog)
+}
+
+// checkTxError returns the error of a CheckTx result, permanent unless the
+// mempool was full or the sender was rate limited.
+func checkTxError(ethTx *ethtypes.Transaction, code uint32, codespace, log string) error {
+	if code == 0 || isCheckTxError(code, codespace, errortypes.ErrTxInMempoolCache) {
+		// the CometBFT mempool cache may already hold the tx
+		return nil
+	}
+	if codespace == errorsmod.UndefinedCodespace && log == txpool.ErrAlreadyKnown.Error() {
+		// the app mempool holds the tx it promoted
+		return nil
+	}
+	err := fmt.Errorf("transaction %s rejected by mempool: code=%d, log=%s", ethTx.Hash().Hex(), code, log)
+	if isCheckTxError(code, codespace, errortypes.ErrMempoolIsFull) || isCheckTxError(code, codespace, ratelimit.ErrSenderRateLimited) {
+		return err
+	}
+	return broadcast.Permanent(err)
+}
+
+// isCheckTxError reports whether a CheckTx result code is the given error.
+func isCheckTxError(code uint32, codespace string, err *errorsmod.Error) bool {
+	return codespace == err.Codespace() && code == err.ABCICode()
+}
@@ -0,0 +1,248 @@
+package app
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	feeabstypes "github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+// testChain is an app past its genesis block whose blocks are proposed
+// by its validator.
+type testChain struct {
+	t          *testing.T
+	app        *TacChainApp
+	height     int64
+	proposer   sdk.ConsAddress
+	maxTxBytes int64
+}
+
+// newTestChain returns a chain whose app is configured by appOpts. Without a
+// home, the EVM block gas limit is not read from a genesis file and is
+// unlimited.
+func newTestChain(t *testing.T, appOpts simtestutil.AppOptionsMap) *testChain {
+	t.Helper()
+
+	tacApp := NewTacChainAppWithCustomOptions(t, false, SetupOptions{
+		Logger:  log.NewNopLogger(),
+		DB:      dbm.NewMemDB(),
+		AppOpts: appOpts,
+	})
+	c := &testChain{t: t, app: tacApp, maxTxBytes: 1 << 20}
+	c.nextBlock(nil)
+
+	validators, err := tacApp.StakingKeeper.GetAllValidators(c.stateCtx())
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	c.proposer, err = validators[0].GetConsAddr()
+	require.NoError(t, err)
+	return c
+}
+
+// stateCtx returns a context writing to the state of the next block.
+func (c *testChain) stateCtx() sdk.Context {
+	return c.app.NewUncachedContext(false, cmtproto.Header{Height: c.height})
+}
+
+// fund mints coins to addr.
+func (c *testChain) fund(addr sdk.AccAddress, coins ...sdk.Coin) {
+	c.t.Helper()
+	ctx := c.stateCtx()
+	require.NoError(c.t, c.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(c.t, c.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+}
+
+// fundModule mints coins to the module account of module.
+func (c *testChain) fundModule(module string, coins ...sdk.Coin) {
+	c.t.Helper()
+	ctx := c.stateCtx()
+	require.NoError(c.t, c.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(c.t, c.app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, module, coins))
+}
+
+func (c *testChain) balance(addr sdk.AccAddress, denom string) sdkmath.Int {
+	return c.app.BankKeeper.GetBalance(c.stateCtx(), addr, denom).Amount
+}
+
+// evmTx returns a transfer of key of gas limit gas carrying data, and its gas
+// price. A non-nil option replaces the ExtensionOptionsEthereumTx option.
+func (c *testChain) evmTx(key *ecdsa.PrivateKey, nonce, gas uint64, data []byte, accessList ethtypes.AccessList, option proto.Message) ([]byte, sdkmath.Int) {
+	c.t.Helper()
+
+	chainID := evmtypes.GetEthChainConfig().ChainID
+	gasPrice := c.app.FeeMarketKeeper.GetBaseFee(c.stateCtx()).TruncateInt().MulRaw(2)
+	to := ethcmn.Address{1}
+	ethTx, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.AccessListTx{
+		ChainID:    chainID,
+		Nonce:      nonce,
+		To:         &to,
+		Value:      big.NewInt(0),
+		Gas:        gas,
+		GasPrice:   gasPrice.BigInt(),
+		Data:       data,
+		AccessList: accessList,
+	}), ethtypes.LatestSignerForChainID(chainID), key)
+	require.NoError(c.t, err)
+
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(c.t, msg.FromSignedEthereumTx(ethTx, ethtypes.LatestSignerForChainID(chainID)))
+	builder := c.app.txConfig.NewTxBuilder()
+	_, err = msg.BuildTx(builder, evmtypes.GetEVMCoinDenom())
+	require.NoError(c.t, err)
+	if option != nil {
+		anyOption, err := codectypes.NewAnyWithValue(option)
+		require.NoError(c.t, err)
+		builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(anyOption)
+	}
+
+	bz, err := c.app.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(c.t, err)
+	return bz, gasPrice
+}
+
+// checkTx checks the tx and asserts it is accepted into the mempool.
+func (c *testChain) checkTx(tx []byte) {
+	c.t.Helper()
+	count := c.app.Mempool().CountTx()
+	res, err := c.app.CheckTx(&abci.RequestCheckTx{Tx: tx, Type: abci.CheckTxType_New})
+	require.NoError(c.t, err)
+	require.Equal(c.t, abci.CodeTypeOK, res.Code, res.Log)
+	require.Equal(c.t, count+1, c.app.Mempool().CountTx())
+}
+
+// proposeBlock prepares, processes and finalizes the next block from the
+// mempool, and asserts it executes the txs.
+func (c *testChain) proposeBlock(txs ...[]byte) {
+	c.t.Helper()
+	proposal, err := c.app.PrepareProposal(&abci.RequestPrepareProposal{
+		Height:          c.height + 1,
+		MaxTxBytes:      c.maxTxBytes,
+		ProposerAddress: c.proposer,
+	})
+	require.NoError(c.t, err)
+	require.Equal(c.t, txs, proposal.Txs)
+
+	processed, err := c.app.ProcessProposal(&abci.RequestProcessProposal{
+		Height:          c.height + 1,
+		Txs:             proposal.Txs,
+		ProposerAddress: c.proposer,
+	})
+	require.NoError(c.t, err)
+	require.Equal(c.t, abci.ResponseProcessProposal_ACCEPT, processed.Status)
+
+	res := c.nextBlock(proposal.Txs)
+	for _, txRes := range res.TxResults {
+		require.Equal(c.t, abci.CodeTypeOK, txRes.Code, txRes.Log)
+	}
+}
+
+func (c *testChain) nextBlock(txs [][]byte) *abci.ResponseFinalizeBlock {
+	c.t.Helper()
+	c.height++
+	res, err := c.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:          c.height,
+		Txs:             txs,
+		ProposerAddress: c.proposer,
+	})
+	require.NoError(c.t, err)
+	_, err = c.app.Commit()
+	require.NoError(c.t, err)
+	// the EVM mempool validates the txs against the head it resets to
+	// asynchronously
+	require.NoError(c.t, c.app.EVMMempool.GetTxPool().Sync())
+	return res
+}
+
+func TestEVMFeeTokenTx(t *testing.T) {
+	c := newTestChain(t, simtestutil.AppOptionsMap{})
+	evmDenom := evmtypes.GetEVMCoinDenom()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := sdk.AccAddress(crypto.PubkeyToAddress(key.PublicKey).Bytes())
+	feeToken := feeabstypes.FeeToken{Denom: "ufee", RateSource: feeabstypes.RATE_SOURCE_STATIC, StaticRate: sdkmath.LegacyNewDec(2)}
+	require.NoError(t, c.app.FeeAbsKeeper.Params.Set(c.stateCtx(), feeabstypes.NewParams([]feeabstypes.FeeToken{feeToken}, 60, 60, sdkmath.LegacyZeroDec())))
+	feemarketParams := c.app.FeeMarketKeeper.GetParams(c.stateCtx())
+	feemarketParams.MinGasMultiplier = sdkmath.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, c.app.FeeMarketKeeper.SetParams(c.stateCtx(), feemarketParams))
+	c.fund(sender, sdk.NewInt64Coin("ufee", 1_000_000_000_000_000))
+	c.fundModule(feeabstypes.ModuleName, sdk.NewInt64Coin(evmDenom, 1_000_000_000_000_000))
+	c.nextBlock(nil)
+
+	tx, gasPrice := c.evmTx(key, 0, 100_000, nil, nil, &feeabstypes.ExtensionOptionFeeToken{Denom: "ufee"})
+	c.checkTx(tx)
+	// the tx is kept with its option rather than in the EVM pool
+	require.Zero(t, c.app.EVMMempool.CountTx())
+
+	c.proposeBlock(tx)
+	require.Zero(t, c.app.Mempool().CountTx())
+	// the transfer uses 21000 gas, but x/vm charges half of the gas limit
+	// with the min gas multiplier, paid in the fee token worth twice the EVM
+	// denom
+	spent := gasPrice.MulRaw(50_000).QuoRaw(2)
+	require.Equal(t, sdkmath.NewInt(1_000_000_000_000_000).Sub(spent), c.balance(sender, "ufee"))
+	require.True(t, c.balance(sender, evmDenom).IsZero())
+}
+
+func TestEVMFeeGrantTx(t *testing.T) {
+	c := newTestChain(t, simtestutil.AppOptionsMap{})
+	evmDenom := evmtypes.GetEVMCoinDenom()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := sdk.AccAddress(crypto.PubkeyToAddress(key.PublicKey).Bytes())
+	granter := sdk.AccAddress("granter_____________")
+	feemarketParams := c.app.FeeMarketKeeper.GetParams(c.stateCtx())
+	feemarketParams.MinGasMultiplier = sdkmath.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, c.app.FeeMarketKeeper.SetParams(c.stateCtx(), feemarketParams))
+	c.fund(granter, sdk.NewInt64Coin(evmDenom, 1_000_000_000_000_000))
+	ctx := c.stateCtx()
+	c.app.AccountKeeper.SetAccount(ctx, c.app.AccountKeeper.NewAccountWithAddress(ctx, sender))
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 1_000_000_000_000_000))
+	require.NoError(t, c.app.FeeGrantKeeper.GrantAllowance(ctx, granter, sender, &feegrant.BasicAllowance{SpendLimit: spendLimit}))
+	c.nextBlock(nil)
+
+	// the sender consents to the granter paying its fee by naming it in the
+	// signed access list
+	accessList := ethtypes.AccessList{{Address: ethcmn.BytesToAddress(granter)}}
+	tx, gasPrice := c.evmTx(key, 0, 100_000, nil, accessList, &feeabstypes.ExtensionOptionFeeGrant{FeeGranter: granter.String()})
+	c.checkTx(tx)
+	// the tx is kept with its option rather than in the EVM pool
+	require.Zero(t, c.app.EVMMempool.CountTx())
+
+	c.proposeBlock(tx)
+	require.Zero(t, c.app.Mempool().CountTx())
+	// x/vm charges half of the gas limit with the min gas multiplier, and the
+	// fee of the rest of the gas limit is refunded to the granter
+	spent := gasPrice.MulRaw(50_000)
+	require.Equal(t, sdkmath.NewInt(1_000_000_000_000_000).Sub(spent), c.balance(granter, evmDenom))
+	require.True(t, c.balance(sender, evmDenom).IsZero())
+
+	// the allowance is used up by the fee of the gas limit
+	grant, err := c.app.FeeGrantKeeper.GetAllowance(c.stateCtx(), granter, sender)
+	require.NoError(t, err)
+	allowance, ok := grant.(*feegrant.BasicAllowance)
+	require.True(t, ok)
+	require.Equal(t, spendLimit.Sub(sdk.NewCoin(evmDenom, gasPrice.MulRaw(100_000))), allowance.SpendLimit)
+}
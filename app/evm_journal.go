@@ -0,0 +1,81 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/TacBuild/tacchain/app/broadcast"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmvmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// evmMempoolContent returns the pending and queued txs of the EVM mempool,
+// grouped by sender and sorted by nonce.
+func (app *TacChainApp) evmMempoolContent() []*ethtypes.Transaction {
+	pending, queued := app.EVMMempool.GetTxPool().Content()
+
+	senders := make([]ethcmn.Address, 0, len(pending)+len(queued))
+	for sender := range pending {
+		senders = append(senders, sender)
+	}
+	for sender := range queued {
+		if _, ok := pending[sender]; !ok {
+			senders = append(senders, sender)
+		}
+	}
+	sort.Slice(senders, func(i, j int) bool {
+		return bytes.Compare(senders[i].Bytes(), senders[j].Bytes()) < 0
+	})
+
+	var txs []*ethtypes.Transaction
+	for _, sender := range senders {
+		txs = append(txs, pending[sender]...)
+		txs = append(txs, queued[sender]...)
+	}
+	return txs
+}
+
+// replayEVMJournal broadcasts the journaled txs loaded on startup, once a
+// block is committed after the EVM broadcast queue is started, through the
+// queue, so that they go through CheckTx. The blocks the node replays before
+// the start command sets its broadcast path are skipped. The txs whose nonce
+// was used meanwhile are dropped. The journal is only rewritten from the
+// mempool once the txs are queued, so that the ones not replayed are kept for
+// the next start.
+func (app *TacChainApp) replayEVMJournal(ctx sdk.Context) {
+	if app.evmJournalReplayed || app.broadcastQueue() == nil {
+		return
+	}
+	app.evmJournalReplayed = true
+	txs := app.evmJournalTxs
+	app.evmJournalTxs = nil
+
+	signer := ethtypes.LatestSignerForChainID(evmvmtypes.GetEthChainConfig().ChainID)
+	replayed := make([]*ethtypes.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		sender, err := ethtypes.Sender(signer, tx)
+		if err != nil {
+			app.Logger().Debug("dropping journaled EVM tx with an invalid signature", "hash", tx.Hash().Hex(), "error", err)
+			continue
+		}
+		if tx.Nonce() < app.EVMKeeper.GetNonce(ctx, sender) {
+			continue
+		}
+		replayed = append(replayed, tx)
+	}
+
+	if len(replayed) > 0 {
+		app.Logger().Info("replaying EVM mempool journal", "txs", len(replayed), "stale", len(txs)-len(replayed))
+		if err := app.enqueueEVMBroadcast(replayed); err != nil && !errors.Is(err, broadcast.ErrQueueFull) {
+			app.Logger().Error("failed to replay EVM mempool journal, keeping it for the next start", "error", err)
+			return
+		}
+	}
+	app.evmJournal.Start(app.evmMempoolContent)
+}
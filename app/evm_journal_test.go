@@ -0,0 +1,130 @@
+package app
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/TacBuild/tacchain/app/journal"
+)
+
+func TestEVMJournalReplay(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o755))
+
+	tacApp := NewTacChainAppWithCustomOptions(t, true, SetupOptions{
+		Logger: log.NewTestLogger(t),
+		DB:     dbm.NewMemDB(),
+		AppOpts: simtestutil.AppOptionsMap{
+			flags.FlagHome:     home,
+			journal.FlagEnable: true,
+		},
+	})
+	require.Empty(t, tacApp.evmJournalTxs)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := ethtypes.LatestSignerForChainID(evmtypes.GetEthChainConfig().ChainID)
+	to := ethcmn.Address{}
+	newTx := func(nonce uint64) *ethtypes.Transaction {
+		tx, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.LegacyTx{
+			Nonce:    nonce,
+			To:       &to,
+			Value:    big.NewInt(0),
+			Gas:      21_000,
+			GasPrice: big.NewInt(1),
+		}), signer, key)
+		require.NoError(t, err)
+		return tx
+	}
+	// the mempool content journaled before a restart
+	require.NoError(t, tacApp.evmJournal.Rotate([]*ethtypes.Transaction{newTx(0), newTx(1)}))
+
+	txs, err := tacApp.evmJournal.Load()
+	require.NoError(t, err)
+	tacApp.evmJournalTxs = txs
+
+	// the blocks committed before the node has a broadcast path don't replay
+	// the journal
+	ctx := tacApp.NewContextLegacy(true, cmtproto.Header{})
+	tacApp.replayEVMJournal(ctx)
+	require.Len(t, tacApp.evmJournalTxs, 2)
+
+	cometMempool := &cometMempoolRecorder{}
+	tacApp.SetCometMempool(cometMempool)
+
+	// the nonce 0 was used meanwhile
+	sender := sdk.AccAddress(crypto.PubkeyToAddress(key.PublicKey).Bytes())
+	acc := tacApp.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	require.NoError(t, acc.SetSequence(1))
+	tacApp.AccountKeeper.SetAccount(ctx, acc)
+
+	tacApp.replayEVMJournal(ctx)
+	require.Nil(t, tacApp.evmJournalTxs)
+	require.Eventually(t, func() bool {
+		return len(cometMempool.insertedTxs()) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	decodedTx, err := tacApp.txConfig.TxDecoder()(cometMempool.insertedTxs()[0])
+	require.NoError(t, err)
+	msg, ok := decodedTx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+	require.True(t, ok)
+	require.Equal(t, newTx(1).Hash(), msg.Hash())
+
+	// the mempool content is journaled on shutdown
+	require.NoError(t, tacApp.Close())
+	txs, err = tacApp.evmJournal.Load()
+	require.NoError(t, err)
+	require.Empty(t, txs)
+}
+
+func TestEVMJournalKeptUntilReplayed(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o755))
+
+	tacApp := NewTacChainAppWithCustomOptions(t, true, SetupOptions{
+		Logger: log.NewTestLogger(t),
+		DB:     dbm.NewMemDB(),
+		AppOpts: simtestutil.AppOptionsMap{
+			flags.FlagHome:     home,
+			journal.FlagEnable: true,
+		},
+	})
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := ethtypes.LatestSignerForChainID(evmtypes.GetEthChainConfig().ChainID)
+	to := ethcmn.Address{}
+	tx, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    0,
+		To:       &to,
+		Value:    big.NewInt(0),
+		Gas:      21_000,
+		GasPrice: big.NewInt(1),
+	}), signer, key)
+	require.NoError(t, err)
+	require.NoError(t, tacApp.evmJournal.Rotate([]*ethtypes.Transaction{tx}))
+
+	// the journal is not rewritten from the empty mempool before it is
+	// replayed
+	require.NoError(t, tacApp.Close())
+	txs, err := tacApp.evmJournal.Load()
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, tx.Hash(), txs[0].Hash())
+}
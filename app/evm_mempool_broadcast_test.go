@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"sync"
 	"testing"
@@ -10,17 +11,27 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtmempool "github.com/cometbft/cometbft/mempool"
 	rpcmock "github.com/cometbft/cometbft/rpc/client/mock"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/evm/mempool/txpool"
 	"github.com/cosmos/evm/mempool/txpool/legacypool"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	ethcmn "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/TacBuild/tacchain/app/broadcast"
+	"github.com/TacBuild/tacchain/app/ratelimit"
 )
 
 type broadcastRecorder struct {
@@ -52,6 +63,46 @@ func (r *broadcastRecorder) txBytes() cmttypes.Tx {
 	return append(cmttypes.Tx(nil), r.tx...)
 }
 
+type cometMempoolRecorder struct {
+	mu  sync.Mutex
+	txs []cmttypes.Tx
+	res *abci.ResponseCheckTx
+	err error
+}
+
+func (m *cometMempoolRecorder) CheckTx(tx cmttypes.Tx, callback func(*abci.ResponseCheckTx), _ cmtmempool.TxInfo) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.txs = append(m.txs, append(cmttypes.Tx(nil), tx...))
+	if m.err != nil {
+		return m.err
+	}
+	res := m.res
+	if res == nil {
+		res = &abci.ResponseCheckTx{}
+	}
+	callback(res)
+	return nil
+}
+
+func (m *cometMempoolRecorder) insertedTxs() []cmttypes.Tx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]cmttypes.Tx(nil), m.txs...)
+}
+
+type rejectingBroadcastClient struct {
+	rpcmock.Client
+
+	res *coretypes.ResultBroadcastTx
+	err error
+}
+
+func (c *rejectingBroadcastClient) BroadcastTxSync(context.Context, cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return c.res, c.err
+}
+
 type blockingBroadcastClient struct {
 	rpcmock.Client
 
@@ -67,6 +118,24 @@ func (c *blockingBroadcastClient) BroadcastTxSync(context.Context, cmttypes.Tx)
 	return &coretypes.ResultBroadcastTx{}, nil
 }
 
+// signedEVMTx returns a transfer of nonce signed by a new key, and its sender.
+func signedEVMTx(t *testing.T, nonce uint64) (*ethtypes.Transaction, ethcmn.Address) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	chainID := evmtypes.GetEthChainConfig().ChainID
+	to := ethcmn.Address{}
+	ethTx, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    nonce,
+		To:       &to,
+		Value:    big.NewInt(0),
+		Gas:      21_000,
+		GasPrice: big.NewInt(1),
+	}), ethtypes.LatestSignerForChainID(chainID), key)
+	require.NoError(t, err)
+	return ethTx, crypto.PubkeyToAddress(key.PublicKey)
+}
+
 func TestEVMMempoolBroadcastTxFnUsesUpdatedClientCtx(t *testing.T) {
 	tacApp := NewTacChainAppWithCustomOptions(t, true, SetupOptions{
 		Logger:  log.NewTestLogger(t),
@@ -87,14 +156,7 @@ func TestEVMMempoolBroadcastTxFnUsesUpdatedClientCtx(t *testing.T) {
 		WithClient(rpcClient),
 	)
 
-	to := ethcmn.Address{}
-	ethTx := ethtypes.NewTx(&ethtypes.LegacyTx{
-		Nonce:    1,
-		To:       &to,
-		Value:    big.NewInt(0),
-		Gas:      21_000,
-		GasPrice: big.NewInt(1),
-	})
+	ethTx, _ := signedEVMTx(t, 1)
 
 	// Before the explicit BroadCastTxFn override, this callback captured the
 	// empty client.Context from app construction and returned "no RPC client is
@@ -144,14 +206,7 @@ func TestEVMMempoolBroadcastTxFnDoesNotBlockOnBroadcast(t *testing.T) {
 		WithClient(rpcClient),
 	)
 
-	to := ethcmn.Address{}
-	ethTx := ethtypes.NewTx(&ethtypes.LegacyTx{
-		Nonce:    1,
-		To:       &to,
-		Value:    big.NewInt(0),
-		Gas:      21_000,
-		GasPrice: big.NewInt(1),
-	})
+	ethTx, _ := signedEVMTx(t, 1)
 
 	done := make(chan error, 1)
 	go func() {
@@ -178,3 +233,181 @@ func TestEVMMempoolBroadcastTxFnDoesNotBlockOnBroadcast(t *testing.T) {
 		t.Fatal("background broadcast goroutine did not exit")
 	}
 }
+
+func TestEVMMempoolBroadcastTxFnUsesCometMempool(t *testing.T) {
+	tacApp := NewTacChainAppWithCustomOptions(t, true, SetupOptions{
+		Logger:  log.NewTestLogger(t),
+		DB:      dbm.NewMemDB(),
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+
+	txPool := tacApp.EVMMempool.GetTxPool()
+	require.Len(t, txPool.Subpools, 1)
+
+	legacyPool, ok := txPool.Subpools[0].(*legacypool.LegacyPool)
+	require.True(t, ok)
+	require.NotNil(t, legacyPool.BroadcastTxFn)
+
+	// the queue is only started once the app has a broadcast path
+	require.Nil(t, tacApp.broadcastQueue())
+	require.ErrorContains(t, legacyPool.BroadcastTxFn(nil), "EVM broadcast queue is not started")
+
+	// the RPC client is only the fallback of the in-process mempool
+	rpcClient := &broadcastRecorder{}
+	tacApp.RegisterTxService(client.Context{}.
+		WithTxConfig(tacApp.txConfig).
+		WithClient(rpcClient),
+	)
+	cometMempool := &cometMempoolRecorder{}
+	tacApp.SetCometMempool(cometMempool)
+
+	ethTx, sender := signedEVMTx(t, 1)
+
+	require.NoError(t, legacyPool.BroadcastTxFn([]*ethtypes.Transaction{ethTx}))
+	require.Eventually(t, func() bool {
+		return len(cometMempool.insertedTxs()) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.Zero(t, rpcClient.callCount())
+
+	decodedTx, err := tacApp.txConfig.TxDecoder()(cometMempool.insertedTxs()[0])
+	require.NoError(t, err)
+
+	msgs := decodedTx.GetMsgs()
+	require.Len(t, msgs, 1)
+
+	msg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	require.True(t, ok)
+	require.Equal(t, ethTx.Hash(), msg.Hash())
+	// the tx carries its sender and option for CheckTx to accept it
+	require.Equal(t, sender.Bytes(), msg.From)
+	extTx, ok := decodedTx.(authante.HasExtensionOptionsTx)
+	require.True(t, ok)
+	require.Len(t, extTx.GetExtensionOptions(), 1)
+	require.Equal(t, "/cosmos.evm.vm.v1.ExtensionOptionsEthereumTx", extTx.GetExtensionOptions()[0].TypeUrl)
+}
+
+func TestBroadcastEVMTransaction(t *testing.T) {
+	tacApp := NewTacChainAppWithCustomOptions(t, true, SetupOptions{
+		Logger:  log.NewTestLogger(t),
+		DB:      dbm.NewMemDB(),
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+
+	ethTx, _ := signedEVMTx(t, 1)
+	mempoolFull := &abci.ResponseCheckTx{
+		Code:      errortypes.ErrMempoolIsFull.ABCICode(),
+		Codespace: errortypes.ErrMempoolIsFull.Codespace(),
+	}
+	rateLimited := &abci.ResponseCheckTx{
+		Code:      ratelimit.ErrSenderRateLimited.ABCICode(),
+		Codespace: ratelimit.ErrSenderRateLimited.Codespace(),
+	}
+	rejected := &abci.ResponseCheckTx{
+		Code:      errortypes.ErrInsufficientFunds.ABCICode(),
+		Codespace: errortypes.ErrInsufficientFunds.Codespace(),
+	}
+
+	testCases := []struct {
+		name         string
+		cometMempool CometMempool
+		rpcClient    client.CometRPC
+		expErr       bool
+		expPermanent bool
+	}{
+		{
+			name:         "inserted in the CometBFT mempool",
+			cometMempool: &cometMempoolRecorder{},
+		},
+		{
+			name:         "already in the CometBFT mempool cache",
+			cometMempool: &cometMempoolRecorder{err: cmtmempool.ErrTxInCache},
+		},
+		{
+			name: "already in the app mempool",
+			cometMempool: &cometMempoolRecorder{res: &abci.ResponseCheckTx{
+				Code:      1,
+				Codespace: errorsmod.UndefinedCodespace,
+				Log:       txpool.ErrAlreadyKnown.Error(),
+			}},
+		},
+		{
+			name:         "CometBFT mempool full",
+			cometMempool: &cometMempoolRecorder{err: cmtmempool.ErrMempoolIsFull{NumTxs: 1, MaxTxs: 1}},
+			expErr:       true,
+		},
+		{
+			name:         "too large for the CometBFT mempool",
+			cometMempool: &cometMempoolRecorder{err: cmtmempool.ErrTxTooLarge{Max: 1, Actual: 2}},
+			expErr:       true,
+			expPermanent: true,
+		},
+		{
+			name:         "app mempool full",
+			cometMempool: &cometMempoolRecorder{res: mempoolFull},
+			expErr:       true,
+		},
+		{
+			name:         "sender rate limited",
+			cometMempool: &cometMempoolRecorder{res: rateLimited},
+			expErr:       true,
+		},
+		{
+			name:         "rejected by CheckTx",
+			cometMempool: &cometMempoolRecorder{res: rejected},
+			expErr:       true,
+			expPermanent: true,
+		},
+		{
+			name:      "broadcast through the RPC",
+			rpcClient: &rejectingBroadcastClient{res: &coretypes.ResultBroadcastTx{}},
+		},
+		{
+			name:      "already in the CometBFT mempool cache through the RPC",
+			rpcClient: &rejectingBroadcastClient{err: cmtmempool.ErrTxInCache},
+		},
+		{
+			name:      "RPC unavailable",
+			rpcClient: &rejectingBroadcastClient{err: errors.New("connection refused")},
+			expErr:    true,
+		},
+		{
+			name: "rejected by CheckTx through the RPC",
+			rpcClient: &rejectingBroadcastClient{res: &coretypes.ResultBroadcastTx{
+				Code:      rejected.Code,
+				Codespace: rejected.Codespace,
+			}},
+			expErr:       true,
+			expPermanent: true,
+		},
+		{
+			name:         "neither a CometBFT mempool nor an RPC client",
+			expErr:       true,
+			expPermanent: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tacApp.SetCometMempool(tc.cometMempool)
+			tacApp.SetClientCtx(client.Context{}.
+				WithTxConfig(tacApp.txConfig).
+				WithClient(tc.rpcClient),
+			)
+
+			err := tacApp.broadcastEVMTransaction(ethTx)
+			if !tc.expErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Equal(t, tc.expPermanent, broadcast.IsPermanent(err))
+		})
+	}
+
+	// the sender of an unsigned tx cannot be recovered
+	unsignedTx := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, Gas: 21_000, GasPrice: big.NewInt(1)})
+	tacApp.SetCometMempool(&cometMempoolRecorder{})
+	err := tacApp.broadcastEVMTransaction(unsignedTx)
+	require.ErrorContains(t, err, "failed to recover transaction sender")
+	require.True(t, broadcast.IsPermanent(err))
+}
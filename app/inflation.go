@@ -5,8 +5,20 @@ import (
 
 	"cosmossdk.io/math"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	inflationtypes "github.com/TacBuild/tacchain/x/inflation/types"
 )
 
+// InflationCurves returns the formulas governance can select through the
+// x/inflation params. A new curve needs an InflationCurve enum value and an
+// entry here.
+func InflationCurves() map[inflationtypes.InflationCurve]minttypes.InflationCalculationFn {
+	return map[inflationtypes.InflationCurve]minttypes.InflationCalculationFn{
+		inflationtypes.INFLATION_CURVE_LINEAR:    TacLinearInflationFormula,
+		inflationtypes.INFLATION_CURVE_PARABOLIC: TacParabolicInflationFormula,
+	}
+}
+
 // Parabolic formula
 // inflation (X) = Max inflation * (1 - ((X - Xtarget)/Xtarget)^2), where
 // X - actual staking rate
@@ -0,0 +1,102 @@
+package journal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// app.toml keys of the EVM mempool journal
+const (
+	FlagEnable    = "evm-journal.enable"
+	FlagLifetime  = "evm-journal.lifetime"
+	FlagRejournal = "evm-journal.rejournal"
+)
+
+// Config is the node-local configuration of the journal of the EVM mempool
+// txs.
+type Config struct {
+	// Enable enables the journal.
+	Enable bool `mapstructure:"enable"`
+	// Lifetime is the maximum age of the journaled txs replayed on startup.
+	Lifetime time.Duration `mapstructure:"lifetime"`
+	// Rejournal is the interval at which the journal is rewritten from the
+	// mempool content.
+	Rejournal time.Duration `mapstructure:"rejournal"`
+}
+
+// DefaultConfig returns the default EVM mempool journal configuration,
+// disabled.
+func DefaultConfig() Config {
+	return Config{
+		Enable:    false,
+		Lifetime:  3 * time.Hour,
+		Rejournal: time.Minute,
+	}
+}
+
+// ConfigFromAppOptions reads the EVM mempool journal configuration from
+// app.toml.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+	if appOpts == nil {
+		return cfg, nil
+	}
+
+	var err error
+	if v := appOpts.Get(FlagEnable); v != nil {
+		if cfg.Enable, err = cast.ToBoolE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagEnable, err)
+		}
+	}
+	if v := appOpts.Get(FlagLifetime); v != nil {
+		if cfg.Lifetime, err = cast.ToDurationE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagLifetime, err)
+		}
+	}
+	if v := appOpts.Get(FlagRejournal); v != nil {
+		if cfg.Rejournal, err = cast.ToDurationE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagRejournal, err)
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// Validate validates the EVM mempool journal configuration.
+func (c Config) Validate() error {
+	if c.Lifetime <= 0 {
+		return fmt.Errorf("lifetime must be positive: %s", c.Lifetime)
+	}
+	if c.Rejournal <= 0 {
+		return fmt.Errorf("rejournal interval must be positive: %s", c.Rejournal)
+	}
+	return nil
+}
+
+// DefaultConfigTemplate is the app.toml template of the EVM mempool journal
+// configuration.
+const DefaultConfigTemplate = `
+###############################################################################
+###                      EVM Mempool Journal Configuration                  ###
+###############################################################################
+
+# The journal keeps the pending and queued txs of the EVM mempool in the node
+# data directory. On startup, the journaled txs still valid against the current
+# nonces are replayed through CheckTx once the first block is committed.
+[evm-journal]
+
+# Enable enables the journal.
+enable = {{ .EVMJournal.Enable }}
+
+# Lifetime is the maximum age of the journaled txs replayed on startup, the age of
+# a tx being counted from when it was first journaled.
+lifetime = "{{ .EVMJournal.Lifetime }}"
+
+# Rejournal is the interval at which the journal is rewritten from the mempool
+# content. The journal is also rewritten on shutdown.
+rejournal = "{{ .EVMJournal.Rejournal }}"
+`
@@ -0,0 +1,196 @@
+package journal
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"cosmossdk.io/log"
+)
+
+// Snapshot returns the txs to journal, in the order they are replayed.
+type Snapshot func() []*ethtypes.Transaction
+
+// entry is a journaled tx with the unix time it was first journaled.
+type entry struct {
+	Time uint64
+	Tx   *ethtypes.Transaction
+}
+
+// Journal keeps EVM mempool txs in an RLP file, like the transactions.rlp
+// journal of geth, with the time each tx was first journaled so that the
+// txs past their lifetime are not replayed. The file is rewritten from a
+// snapshot of the mempool periodically and on Close.
+type Journal struct {
+	path   string
+	cfg    Config
+	logger log.Logger
+	now    func() time.Time
+
+	mu        sync.Mutex
+	firstSeen map[common.Hash]time.Time
+	snapshot  Snapshot
+	closed    bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// New creates a Journal of the file at path.
+func New(path string, cfg Config, logger log.Logger) (*Journal, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &Journal{
+		path:      path,
+		cfg:       cfg,
+		logger:    logger,
+		now:       time.Now,
+		firstSeen: make(map[common.Hash]time.Time),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}, nil
+}
+
+// Load returns the journaled txs within their lifetime, in the order they
+// were journaled. A corrupted tail of the file, e.g. left by a crash, is
+// dropped.
+func (j *Journal) Load() ([]*ethtypes.Transaction, error) {
+	file, err := os.Open(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open EVM mempool journal: %w", err)
+	}
+	defer file.Close()
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	var (
+		txs     []*ethtypes.Transaction
+		expired int
+		now     = j.now()
+		stream  = rlp.NewStream(bufio.NewReader(file), 0)
+	)
+	for {
+		var e entry
+		if err := stream.Decode(&e); err != nil {
+			if !errors.Is(err, io.EOF) {
+				j.logger.Error("dropping corrupted EVM mempool journal tail", "loaded", len(txs), "error", err)
+			}
+			break
+		}
+		firstSeen := time.Unix(int64(e.Time), 0)
+		if now.Sub(firstSeen) > j.cfg.Lifetime {
+			expired++
+			continue
+		}
+		j.firstSeen[e.Tx.Hash()] = firstSeen
+		txs = append(txs, e.Tx)
+	}
+	j.logger.Info("loaded EVM mempool journal", "txs", len(txs), "expired", expired)
+	return txs, nil
+}
+
+// Rotate rewrites the journal with txs, keeping the time the txs already
+// journaled were first journaled. The file is replaced atomically.
+func (j *Journal) Rotate(txs []*ethtypes.Transaction) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.rotate(txs)
+}
+
+func (j *Journal) rotate(txs []*ethtypes.Transaction) error {
+	tmpPath := j.path + ".new"
+	file, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create EVM mempool journal: %w", err)
+	}
+
+	now := j.now()
+	firstSeen := make(map[common.Hash]time.Time, len(txs))
+	w := bufio.NewWriter(file)
+	for _, tx := range txs {
+		seen, ok := j.firstSeen[tx.Hash()]
+		if !ok {
+			seen = now
+		}
+		firstSeen[tx.Hash()] = seen
+		if err = rlp.Encode(w, entry{Time: uint64(seen.Unix()), Tx: tx}); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write EVM mempool journal: %w", err)
+	}
+	if err := os.Rename(tmpPath, j.path); err != nil {
+		return fmt.Errorf("failed to replace EVM mempool journal: %w", err)
+	}
+
+	j.firstSeen = firstSeen
+	return nil
+}
+
+// Start rewrites the journal from snapshot every rejournal interval, until
+// Close.
+func (j *Journal) Start(snapshot Snapshot) {
+	j.mu.Lock()
+	j.snapshot = snapshot
+	j.mu.Unlock()
+
+	go func() {
+		defer close(j.done)
+
+		ticker := time.NewTicker(j.cfg.Rejournal)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-j.stop:
+				return
+			case <-ticker.C:
+				if err := j.Rotate(snapshot()); err != nil {
+					j.logger.Error("failed to rotate EVM mempool journal", "error", err)
+				}
+			}
+		}
+	}()
+}
+
+// Close stops rewriting the journal and writes the last snapshot of the
+// mempool.
+func (j *Journal) Close() error {
+	j.mu.Lock()
+	if j.closed || j.snapshot == nil {
+		j.closed = true
+		j.mu.Unlock()
+		return nil
+	}
+	j.closed = true
+	j.mu.Unlock()
+
+	close(j.stop)
+	<-j.done
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.rotate(j.snapshot())
+}
@@ -0,0 +1,139 @@
+package journal
+
+import (
+	"bytes"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"text/template"
+	"time"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+func newTx(nonce uint64) *ethtypes.Transaction {
+	return ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: nonce, Gas: 21_000, GasPrice: big.NewInt(1)})
+}
+
+func hashes(txs []*ethtypes.Transaction) []string {
+	var hs []string
+	for _, tx := range txs {
+		hs = append(hs, tx.Hash().Hex())
+	}
+	return hs
+}
+
+func newJournal(t *testing.T, path string, cfg Config, now *time.Time) *Journal {
+	t.Helper()
+	j, err := New(path, cfg, log.NewNopLogger())
+	require.NoError(t, err)
+	j.now = func() time.Time { return *now }
+	return j
+}
+
+func TestJournalLifetime(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "evm_transactions.rlp")
+	cfg := DefaultConfig()
+	cfg.Lifetime = time.Hour
+	now := time.Unix(1_000_000, 0)
+
+	j := newJournal(t, path, cfg, &now)
+	txs, err := j.Load()
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	require.NoError(t, j.Rotate([]*ethtypes.Transaction{newTx(0), newTx(1)}))
+	now = now.Add(40 * time.Minute)
+	// the first journaled time of the txs already journaled is kept
+	require.NoError(t, j.Rotate([]*ethtypes.Transaction{newTx(1), newTx(2)}))
+
+	now = now.Add(30 * time.Minute)
+	txs, err = newJournal(t, path, cfg, &now).Load()
+	require.NoError(t, err)
+	require.Equal(t, hashes([]*ethtypes.Transaction{newTx(2)}), hashes(txs))
+}
+
+func TestJournalCorruptedTail(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "evm_transactions.rlp")
+	now := time.Unix(1_000_000, 0)
+
+	require.NoError(t, newJournal(t, path, DefaultConfig(), &now).Rotate([]*ethtypes.Transaction{newTx(0), newTx(1)}))
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = file.Write([]byte{0xf8, 0xff, 0x01})
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	txs, err := newJournal(t, path, DefaultConfig(), &now).Load()
+	require.NoError(t, err)
+	require.Equal(t, hashes([]*ethtypes.Transaction{newTx(0), newTx(1)}), hashes(txs))
+}
+
+func TestJournalRejournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "evm_transactions.rlp")
+	cfg := DefaultConfig()
+	cfg.Rejournal = time.Millisecond
+	now := time.Unix(1_000_000, 0)
+
+	var (
+		mu      sync.Mutex
+		mempool = []*ethtypes.Transaction{newTx(0)}
+	)
+	snapshot := func() []*ethtypes.Transaction {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]*ethtypes.Transaction(nil), mempool...)
+	}
+
+	j := newJournal(t, path, cfg, &now)
+	j.Start(snapshot)
+	require.Eventually(t, func() bool {
+		txs, err := newJournal(t, path, cfg, &now).Load()
+		return err == nil && len(txs) == 1
+	}, time.Second, time.Millisecond)
+
+	// the mempool content on shutdown is journaled
+	mu.Lock()
+	mempool = append(mempool, newTx(1))
+	mu.Unlock()
+	require.NoError(t, j.Close())
+	require.NoError(t, j.Close())
+
+	txs, err := newJournal(t, path, cfg, &now).Load()
+	require.NoError(t, err)
+	require.Equal(t, hashes([]*ethtypes.Transaction{newTx(0), newTx(1)}), hashes(txs))
+}
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, DefaultConfig().Validate())
+
+	cfg := DefaultConfig()
+	cfg.Lifetime = 0
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.Rejournal = 0
+	require.Error(t, cfg.Validate())
+}
+
+func TestConfigTemplate(t *testing.T) {
+	cfg := Config{Enable: true, Lifetime: time.Hour, Rejournal: 30 * time.Second}
+
+	tmpl, err := template.New("app").Parse(DefaultConfigTemplate)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, struct{ EVMJournal Config }{cfg}))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+	parsed, err := ConfigFromAppOptions(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg, parsed)
+}
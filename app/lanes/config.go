@@ -0,0 +1,194 @@
+package lanes
+
+import (
+	"fmt"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// app.toml keys of the block building lanes
+const (
+	FlagEnable             = "lanes.enable"
+	FlagRelayShare         = "lanes.relay-share"
+	FlagRelayMsgTypes      = "lanes.relay-msg-types"
+	FlagGovernanceShare    = "lanes.governance-share"
+	FlagGovernanceMsgTypes = "lanes.governance-msg-types"
+	FlagDefaultShare       = "lanes.default-share"
+)
+
+// Config is the node-local configuration of the lanes the proposer builds
+// blocks with. A share is the maximum fraction of the block bytes and gas the
+// txs of a lane may use.
+type Config struct {
+	// Enable enables the lanes, otherwise txs are selected in mempool order.
+	Enable bool `mapstructure:"enable"`
+	// RelayShare is the share of the relay lane.
+	RelayShare float64 `mapstructure:"relay-share"`
+	// RelayMsgTypes are the type URLs of the msgs of the relay lane.
+	RelayMsgTypes []string `mapstructure:"relay-msg-types"`
+	// GovernanceShare is the share of the governance lane.
+	GovernanceShare float64 `mapstructure:"governance-share"`
+	// GovernanceMsgTypes are the type URLs of the msgs of the governance lane.
+	GovernanceMsgTypes []string `mapstructure:"governance-msg-types"`
+	// DefaultShare is the share of the default lane.
+	DefaultShare float64 `mapstructure:"default-share"`
+}
+
+// DefaultConfig returns the default lanes configuration, with the lanes
+// disabled until the node operator enables them.
+func DefaultConfig() Config {
+	return Config{
+		Enable:     false,
+		RelayShare: 0.2,
+		RelayMsgTypes: []string{
+			"/ibc.core.client.v1.MsgUpdateClient",
+			"/ibc.core.channel.v1.MsgRecvPacket",
+			"/ibc.core.channel.v1.MsgAcknowledgement",
+			"/ibc.core.channel.v1.MsgTimeout",
+			"/ibc.core.channel.v1.MsgTimeoutOnClose",
+			"/ibc.core.channel.v2.MsgRecvPacket",
+			"/ibc.core.channel.v2.MsgAcknowledgement",
+			"/ibc.core.channel.v2.MsgTimeout",
+			"/tacchain.feeabs.v1.MsgSubmitPrice",
+		},
+		GovernanceShare: 0.1,
+		GovernanceMsgTypes: []string{
+			"/cosmos.gov.v1.MsgVote",
+			"/cosmos.gov.v1.MsgVoteWeighted",
+			"/cosmos.gov.v1beta1.MsgVote",
+			"/cosmos.gov.v1beta1.MsgVoteWeighted",
+			"/cosmos.staking.v1beta1.MsgCreateValidator",
+			"/cosmos.staking.v1beta1.MsgEditValidator",
+			"/cosmos.staking.v1beta1.MsgDelegate",
+			"/cosmos.staking.v1beta1.MsgUndelegate",
+			"/cosmos.staking.v1beta1.MsgBeginRedelegate",
+			"/cosmos.staking.v1beta1.MsgCancelUnbondingDelegation",
+			"/cosmos.slashing.v1beta1.MsgUnjail",
+			"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+			"/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission",
+		},
+		DefaultShare: 0.7,
+	}
+}
+
+// ConfigFromAppOptions reads the lanes configuration from app.toml.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+	if appOpts == nil {
+		return cfg, nil
+	}
+
+	var err error
+	if v := appOpts.Get(FlagEnable); v != nil {
+		if cfg.Enable, err = cast.ToBoolE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagEnable, err)
+		}
+	}
+	if v := appOpts.Get(FlagRelayShare); v != nil {
+		if cfg.RelayShare, err = cast.ToFloat64E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagRelayShare, err)
+		}
+	}
+	if v := appOpts.Get(FlagRelayMsgTypes); v != nil {
+		if cfg.RelayMsgTypes, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagRelayMsgTypes, err)
+		}
+	}
+	if v := appOpts.Get(FlagGovernanceShare); v != nil {
+		if cfg.GovernanceShare, err = cast.ToFloat64E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagGovernanceShare, err)
+		}
+	}
+	if v := appOpts.Get(FlagGovernanceMsgTypes); v != nil {
+		if cfg.GovernanceMsgTypes, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagGovernanceMsgTypes, err)
+		}
+	}
+	if v := appOpts.Get(FlagDefaultShare); v != nil {
+		if cfg.DefaultShare, err = cast.ToFloat64E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagDefaultShare, err)
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// Validate validates the lanes configuration.
+func (c Config) Validate() error {
+	for _, share := range []struct {
+		name  string
+		value float64
+	}{
+		{RelayLane, c.RelayShare},
+		{GovernanceLane, c.GovernanceShare},
+		{DefaultLane, c.DefaultShare},
+	} {
+		if share.value < 0 || share.value > 1 {
+			return fmt.Errorf("%s lane share must be between 0 and 1: %v", share.name, share.value)
+		}
+	}
+
+	relayMsgTypes := make(map[string]struct{}, len(c.RelayMsgTypes))
+	for _, typeURL := range c.RelayMsgTypes {
+		if err := validateMsgTypeURL(typeURL); err != nil {
+			return err
+		}
+		relayMsgTypes[typeURL] = struct{}{}
+	}
+	for _, typeURL := range c.GovernanceMsgTypes {
+		if err := validateMsgTypeURL(typeURL); err != nil {
+			return err
+		}
+		if _, ok := relayMsgTypes[typeURL]; ok {
+			return fmt.Errorf("msg type %s is in both the %s and %s lanes", typeURL, RelayLane, GovernanceLane)
+		}
+	}
+	return nil
+}
+
+func validateMsgTypeURL(typeURL string) error {
+	if len(typeURL) < 2 || typeURL[0] != '/' {
+		return fmt.Errorf("invalid msg type URL %q, expected /package.Msg", typeURL)
+	}
+	return nil
+}
+
+// DefaultConfigTemplate is the app.toml template of the lanes configuration.
+const DefaultConfigTemplate = `
+###############################################################################
+###                           Block Lanes Configuration                     ###
+###############################################################################
+
+# When this node proposes a block, its txs are selected in lanes: the relay lane first,
+# then the governance lane, then the default lane. A tx belongs to a lane if all its
+# msgs are of the lane msg types, otherwise to the default lane; EVM txs always belong
+# to the default lane. Within a lane, txs keep the EVM and cosmos mempool order.
+#
+# A share is the maximum fraction of the block bytes and gas the txs of a lane may use,
+# so the space left by one lane cannot be filled by the others: with a default share
+# below 1, busy default lane txs leave room for relays and governance txs.
+#
+# The lanes only change the blocks this node proposes, so each validator may enable them.
+# They are disabled by default.
+[lanes]
+
+# Enable enables the lanes, otherwise txs are selected in mempool order.
+enable = {{ .Lanes.Enable }}
+
+# RelayShare is the share of the relay lane, IBC relays and price feeds by default.
+relay-share = {{ .Lanes.RelayShare }}
+
+# RelayMsgTypes are the type URLs of the msgs of the relay lane.
+relay-msg-types = [{{ range $i, $typeURL := .Lanes.RelayMsgTypes }}{{ if $i }}, {{ end }}"{{ $typeURL }}"{{ end }}]
+
+# GovernanceShare is the share of the governance lane, staking and gov txs by default.
+governance-share = {{ .Lanes.GovernanceShare }}
+
+# GovernanceMsgTypes are the type URLs of the msgs of the governance lane.
+governance-msg-types = [{{ range $i, $typeURL := .Lanes.GovernanceMsgTypes }}{{ if $i }}, {{ end }}"{{ $typeURL }}"{{ end }}]
+
+# DefaultShare is the share of the default lane.
+default-share = {{ .Lanes.DefaultShare }}
+`
@@ -0,0 +1,194 @@
+package lanes
+
+import (
+	"context"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// lane names
+const (
+	RelayLane      = "relay"
+	GovernanceLane = "governance"
+	DefaultLane    = "default"
+)
+
+// lane is a share of the block reserved to the txs of some msg types. The
+// default lane has no msg types.
+type lane struct {
+	name     string
+	share    float64
+	msgTypes map[string]struct{}
+
+	txs      [][]byte
+	txsBytes uint64
+	txsGas   uint64
+}
+
+// matches returns true if all the msgs of the tx are of the lane msg types.
+func (l *lane) matches(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if _, ok := l.msgTypes[sdk.MsgTypeURL(msg)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// TxSelector is a baseapp.TxSelector selecting txs in lanes: the relay lane
+// first, then the governance lane, then the default lane. The txs of a lane
+// may use up to the lane share of the block bytes and gas, and keep the
+// mempool order within the lane.
+//
+// The txs of a sender are never reordered: a tx is moved to the latest lane
+// its signers already have a tx selected in. Once a tx of a sender does not
+// fit, its later txs are not selected either, so that the block has no nonce
+// gap.
+type TxSelector struct {
+	lanes []*lane
+	// signerLanes is the index of the latest lane of the txs of each signer
+	signerLanes map[string]int
+	// skippedSigners are the signers of the txs that were not selected
+	skippedSigners map[string]struct{}
+
+	totalTxBytes uint64
+	totalTxGas   uint64
+}
+
+var _ baseapp.TxSelector = (*TxSelector)(nil)
+
+// NewTxSelector creates a new TxSelector from a validated config.
+func NewTxSelector(cfg Config) *TxSelector {
+	return &TxSelector{
+		lanes: []*lane{
+			{name: RelayLane, share: cfg.RelayShare, msgTypes: msgTypeSet(cfg.RelayMsgTypes)},
+			{name: GovernanceLane, share: cfg.GovernanceShare, msgTypes: msgTypeSet(cfg.GovernanceMsgTypes)},
+			{name: DefaultLane, share: cfg.DefaultShare},
+		},
+		signerLanes:    make(map[string]int),
+		skippedSigners: make(map[string]struct{}),
+	}
+}
+
+func msgTypeSet(typeURLs []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		set[typeURL] = struct{}{}
+	}
+	return set
+}
+
+// SelectedTxs returns the selected txs, lane by lane.
+func (ts *TxSelector) SelectedTxs(_ context.Context) [][]byte {
+	var txs [][]byte
+	for _, l := range ts.lanes {
+		txs = append(txs, l.txs...)
+	}
+	return txs
+}
+
+// Clear clears the selected txs.
+func (ts *TxSelector) Clear() {
+	for _, l := range ts.lanes {
+		l.txs = nil
+		l.txsBytes = 0
+		l.txsGas = 0
+	}
+	ts.signerLanes = make(map[string]int)
+	ts.skippedSigners = make(map[string]struct{})
+	ts.totalTxBytes = 0
+	ts.totalTxGas = 0
+}
+
+// SelectTxForProposal selects the tx if it fits in both its lane and the
+// block and no earlier tx of its signers was skipped, and returns true once
+// the block is full.
+func (ts *TxSelector) SelectTxForProposal(_ context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	txSize := uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+
+	var txGasLimit uint64
+	if memTx != nil {
+		if gasTx, ok := memTx.(baseapp.GasTx); ok {
+			txGasLimit = gasTx.GetGas()
+		}
+	}
+
+	index := ts.laneIndex(memTx)
+	var signers [][]byte
+	if sigTx, ok := memTx.(authsigning.SigVerifiableTx); ok {
+		// the signers of the txs the ante handler verified can be read
+		signers, _ = sigTx.GetSigners()
+	}
+	for _, signer := range signers {
+		if signerLane, ok := ts.signerLanes[string(signer)]; ok && signerLane > index {
+			index = signerLane
+		}
+	}
+	l := ts.lanes[index]
+
+	fits := !ts.skipped(signers) &&
+		ts.totalTxBytes+txSize <= maxTxBytes &&
+		l.txsBytes+txSize <= shareOf(maxTxBytes, l.share)
+	if maxBlockGas > 0 {
+		fits = fits &&
+			ts.totalTxGas+txGasLimit <= maxBlockGas &&
+			l.txsGas+txGasLimit <= shareOf(maxBlockGas, l.share)
+	}
+	if fits {
+		l.txs = append(l.txs, txBz)
+		l.txsBytes += txSize
+		l.txsGas += txGasLimit
+		ts.totalTxBytes += txSize
+		ts.totalTxGas += txGasLimit
+		for _, signer := range signers {
+			ts.signerLanes[string(signer)] = index
+		}
+	} else {
+		for _, signer := range signers {
+			ts.skippedSigners[string(signer)] = struct{}{}
+		}
+	}
+
+	// check if we've reached capacity; if so, we cannot select any more transactions
+	return ts.totalTxBytes >= maxTxBytes || (maxBlockGas > 0 && (ts.totalTxGas >= maxBlockGas))
+}
+
+// skipped returns true if a tx of one of the signers was not selected.
+func (ts *TxSelector) skipped(signers [][]byte) bool {
+	for _, signer := range signers {
+		if _, ok := ts.skippedSigners[string(signer)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// laneIndex returns the index of the lane of the tx msgs.
+func (ts *TxSelector) laneIndex(tx sdk.Tx) int {
+	last := len(ts.lanes) - 1
+	if tx == nil {
+		return last
+	}
+	for i, l := range ts.lanes[:last] {
+		if l.matches(tx) {
+			return i
+		}
+	}
+	return last
+}
+
+// shareOf returns the share of a block limit.
+func shareOf(limit uint64, share float64) uint64 {
+	if share >= 1 {
+		return limit
+	}
+	return uint64(float64(limit) * share)
+}
@@ -0,0 +1,199 @@
+package lanes
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"text/template"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+type laneTx struct {
+	authsigning.SigVerifiableTx
+
+	msgs   []sdk.Msg
+	gas    uint64
+	signer sdk.AccAddress
+}
+
+func (tx laneTx) GetMsgs() []sdk.Msg            { return tx.msgs }
+func (tx laneTx) GetGas() uint64                { return tx.gas }
+func (tx laneTx) GetSigners() ([][]byte, error) { return [][]byte{tx.signer}, nil }
+
+func testConfig() Config {
+	return Config{
+		Enable:             true,
+		RelayShare:         0.25,
+		RelayMsgTypes:      []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})},
+		GovernanceShare:    0.25,
+		GovernanceMsgTypes: []string{sdk.MsgTypeURL(&govv1.MsgVote{})},
+		DefaultShare:       0.5,
+	}
+}
+
+func TestTxSelector(t *testing.T) {
+	alice, bob, carol, dave := sdk.AccAddress("alice"), sdk.AccAddress("bob"), sdk.AccAddress("carol"), sdk.AccAddress("dave")
+	send := &banktypes.MsgSend{}
+	vote := &govv1.MsgVote{}
+	relay := &banktypes.MsgMultiSend{}
+
+	testCases := []struct {
+		name        string
+		txs         map[string]laneTx
+		order       []string
+		maxBlockGas uint64
+		expTxs      []string
+	}{
+		{
+			name: "lanes are ordered",
+			txs: map[string]laneTx{
+				"send":  {msgs: []sdk.Msg{send}, gas: 10, signer: alice},
+				"vote":  {msgs: []sdk.Msg{vote}, gas: 10, signer: bob},
+				"relay": {msgs: []sdk.Msg{relay}, gas: 10, signer: carol},
+			},
+			order:       []string{"send", "vote", "relay"},
+			maxBlockGas: 100,
+			expTxs:      []string{"relay", "vote", "send"},
+		},
+		{
+			name: "mixed msgs belong to the default lane",
+			txs: map[string]laneTx{
+				"vote":      {msgs: []sdk.Msg{vote}, gas: 10, signer: alice},
+				"vote+send": {msgs: []sdk.Msg{vote, send}, gas: 10, signer: bob},
+			},
+			order:       []string{"vote+send", "vote"},
+			maxBlockGas: 100,
+			expTxs:      []string{"vote", "vote+send"},
+		},
+		{
+			name: "the default lane cannot use the block space of the other lanes",
+			txs: map[string]laneTx{
+				"send 1": {msgs: []sdk.Msg{send}, gas: 30, signer: alice},
+				"send 2": {msgs: []sdk.Msg{send}, gas: 30, signer: bob},
+				"vote 1": {msgs: []sdk.Msg{vote}, gas: 10, signer: carol},
+				"vote 2": {msgs: []sdk.Msg{vote}, gas: 10, signer: dave},
+			},
+			order:       []string{"send 1", "send 2", "vote 1", "vote 2"},
+			maxBlockGas: 100,
+			expTxs:      []string{"vote 1", "vote 2", "send 1"},
+		},
+		{
+			name: "the txs of a sender are not reordered",
+			txs: map[string]laneTx{
+				"send":  {msgs: []sdk.Msg{send}, gas: 10, signer: alice},
+				"vote":  {msgs: []sdk.Msg{vote}, gas: 10, signer: alice},
+				"relay": {msgs: []sdk.Msg{relay}, gas: 10, signer: bob},
+			},
+			order:       []string{"send", "vote", "relay"},
+			maxBlockGas: 100,
+			expTxs:      []string{"relay", "send", "vote"},
+		},
+		{
+			name: "the txs of a sender are not selected after one of them is skipped",
+			txs: map[string]laneTx{
+				"send 1": {msgs: []sdk.Msg{send}, gas: 40, signer: alice},
+				"send 2": {msgs: []sdk.Msg{send}, gas: 20, signer: alice},
+				"send 3": {msgs: []sdk.Msg{send}, gas: 5, signer: alice},
+				"send 4": {msgs: []sdk.Msg{send}, gas: 5, signer: bob},
+				"vote":   {msgs: []sdk.Msg{vote}, gas: 5, signer: alice},
+			},
+			order:       []string{"send 1", "send 2", "send 3", "send 4", "vote"},
+			maxBlockGas: 100,
+			expTxs:      []string{"send 1", "send 4"},
+		},
+		{
+			name: "no block gas limit",
+			txs: map[string]laneTx{
+				"send": {msgs: []sdk.Msg{send}, gas: 1_000, signer: alice},
+				"vote": {msgs: []sdk.Msg{vote}, gas: 1_000, signer: bob},
+			},
+			order:  []string{"send", "vote"},
+			expTxs: []string{"vote", "send"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := NewTxSelector(testConfig())
+			maxTxBytes := uint64(1_000)
+			for _, name := range tc.order {
+				txBz := []byte(name)
+				require.False(t, ts.SelectTxForProposal(context.Background(), maxTxBytes, tc.maxBlockGas, tc.txs[name], txBz))
+			}
+
+			var selected []string
+			for _, txBz := range ts.SelectedTxs(context.Background()) {
+				selected = append(selected, string(txBz))
+			}
+			require.Equal(t, tc.expTxs, selected)
+
+			ts.Clear()
+			require.Empty(t, ts.SelectedTxs(context.Background()))
+		})
+	}
+}
+
+func TestTxSelectorBlockFull(t *testing.T) {
+	ts := NewTxSelector(testConfig())
+	tx := laneTx{msgs: []sdk.Msg{&banktypes.MsgSend{}}, signer: sdk.AccAddress("alice")}
+	txBz := []byte("send")
+	txSize := uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+
+	// the default lane may use half of the block bytes
+	maxTxBytes := 4 * txSize
+	require.False(t, ts.SelectTxForProposal(context.Background(), maxTxBytes, 0, tx, txBz))
+	require.False(t, ts.SelectTxForProposal(context.Background(), maxTxBytes, 0, tx, txBz))
+	require.False(t, ts.SelectTxForProposal(context.Background(), maxTxBytes, 0, tx, txBz))
+	require.Len(t, ts.SelectedTxs(context.Background()), 2)
+
+	// the block is full once all its bytes are used
+	vote := laneTx{msgs: []sdk.Msg{&govv1.MsgVote{}}, signer: sdk.AccAddress("bob")}
+	relay := laneTx{msgs: []sdk.Msg{&banktypes.MsgMultiSend{}}, signer: sdk.AccAddress("carol")}
+	require.False(t, ts.SelectTxForProposal(context.Background(), maxTxBytes, 0, vote, txBz))
+	require.True(t, ts.SelectTxForProposal(context.Background(), maxTxBytes, 0, relay, txBz))
+}
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, DefaultConfig().Validate())
+	require.False(t, DefaultConfig().Enable)
+
+	cfg := DefaultConfig()
+	cfg.DefaultShare = 1.5
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.RelayShare = -0.1
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.GovernanceMsgTypes = append(cfg.GovernanceMsgTypes, cfg.RelayMsgTypes[0])
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.RelayMsgTypes = []string{"ibc.core.client.v1.MsgUpdateClient"}
+	require.Error(t, cfg.Validate())
+}
+
+func TestConfigTemplate(t *testing.T) {
+	cfg := testConfig()
+
+	tmpl, err := template.New("app").Parse(DefaultConfigTemplate)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, struct{ Lanes Config }{cfg}))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+	parsed, err := ConfigFromAppOptions(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg, parsed)
+}
@@ -0,0 +1,335 @@
+package mempool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+
+	evmvmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// EVMPool is the pool of the EVM txs of the app-side mempool.
+type EVMPool interface {
+	Content() (map[common.Address][]*ethtypes.Transaction, map[common.Address][]*ethtypes.Transaction)
+	Has(hash common.Hash) bool
+	RemoveTx(hash common.Hash, outofbound bool, unreserve bool) int
+}
+
+// CosmosPool is the app-side mempool the cosmos txs are selected from and
+// removed from.
+type CosmosPool interface {
+	SelectBy(ctx context.Context, txs [][]byte, f func(sdk.Tx) bool)
+	Remove(tx sdk.Tx) error
+}
+
+// entry is a mempool tx with what its eviction requires.
+type entry struct {
+	*MempoolTx
+	sender   []byte
+	cosmosTx sdk.Tx
+}
+
+type adminServer struct {
+	evmPool    EVMPool
+	cosmosPool CosmosPool
+	queryCtx   func() (sdk.Context, error)
+	txEncoder  sdk.TxEncoder
+	signers    sdkmempool.SignerExtractionAdapter
+	logger     log.Logger
+}
+
+// NewAdminServer returns the mempool admin service of the EVM and cosmos txs
+// of evmPool and cosmosPool. The cosmos txs are selected with the context
+// queryCtx returns. Every eviction is logged to logger.
+func NewAdminServer(
+	evmPool EVMPool,
+	cosmosPool CosmosPool,
+	queryCtx func() (sdk.Context, error),
+	txEncoder sdk.TxEncoder,
+	logger log.Logger,
+) AdminServer {
+	return adminServer{
+		evmPool:    evmPool,
+		cosmosPool: cosmosPool,
+		queryCtx:   queryCtx,
+		txEncoder:  txEncoder,
+		signers:    NewFeeAbsSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter()),
+		logger:     logger,
+	}
+}
+
+// Txs implements the AdminServer.Txs RPC method.
+func (s adminServer) Txs(_ context.Context, req *TxsRequest) (*TxsResponse, error) {
+	var sender []byte
+	if req.Sender != "" {
+		var err error
+		if sender, err = parseAddress(req.Sender); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	entries, err := s.entries()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &TxsResponse{}
+	for _, e := range entries {
+		if sender == nil || bytes.Equal(e.sender, sender) {
+			res.Txs = append(res.Txs, e.MempoolTx)
+		}
+	}
+	return res, nil
+}
+
+// EvictTx implements the AdminServer.EvictTx RPC method.
+func (s adminServer) EvictTx(ctx context.Context, req *EvictTxRequest) (*EvictTxResponse, error) {
+	hash := normalizeHash(req.Hash)
+	if hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty tx hash")
+	}
+
+	entries, err := s.entries()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, e := range entries {
+		if normalizeHash(e.Hash) != hash {
+			continue
+		}
+		hashes, err := s.evict(ctx, "EvictTx", []entry{e})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if len(hashes) == 0 {
+			break
+		}
+		return &EvictTxResponse{Hashes: hashes}, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "tx %s not found in the mempool", req.Hash)
+}
+
+// EvictSender implements the AdminServer.EvictSender RPC method.
+func (s adminServer) EvictSender(ctx context.Context, req *EvictSenderRequest) (*EvictSenderResponse, error) {
+	sender, err := parseAddress(req.Sender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	entries, err := s.entries()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// evict the highest nonces first, so that the pool doesn't requeue the
+	// txs left after the evicted ones
+	var evicted []entry
+	for i := len(entries) - 1; i >= 0; i-- {
+		if bytes.Equal(entries[i].sender, sender) {
+			evicted = append(evicted, entries[i])
+		}
+	}
+	hashes, err := s.evict(ctx, "EvictSender", evicted)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &EvictSenderResponse{Hashes: hashes}, nil
+}
+
+// evict removes entries from the mempool and logs the eviction with the
+// client address. The entries the mempool no longer holds, e.g. included in a
+// block meanwhile, are not reported as evicted.
+func (s adminServer) evict(ctx context.Context, method string, entries []entry) ([]string, error) {
+	hashes := make([]string, 0, len(entries))
+	var errs []error
+	for _, e := range entries {
+		if e.Type == TxType_TX_TYPE_EVM {
+			if !s.evictEVMTx(common.HexToHash(e.Hash)) {
+				continue
+			}
+		} else if err := s.cosmosPool.Remove(e.cosmosTx); errors.Is(err, sdkmempool.ErrTxNotFound) {
+			continue
+		} else if err != nil {
+			errs = append(errs, fmt.Errorf("failed to evict tx %s: %w", e.Hash, err))
+			continue
+		}
+		hashes = append(hashes, e.Hash)
+	}
+
+	if len(hashes) > 0 {
+		client := "unknown"
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			client = p.Addr.String()
+		}
+		s.logger.Info("evicted mempool txs", "method", method, "client", client, "hashes", hashes)
+	}
+	return hashes, errors.Join(errs...)
+}
+
+// evictEVMTx removes an EVM tx from the pool, and returns false if the pool
+// didn't hold it. RemoveTx only counts the pending txs it removes, so a
+// queued tx is evicted if the pool held it and no longer does.
+func (s adminServer) evictEVMTx(hash common.Hash) bool {
+	if !s.evmPool.Has(hash) {
+		return false
+	}
+	return s.evmPool.RemoveTx(hash, true, true) > 0 || !s.evmPool.Has(hash)
+}
+
+// entries returns the EVM txs of the mempool, grouped by sender and sorted by
+// nonce, followed by the cosmos txs, sorted the same way.
+func (s adminServer) entries() ([]entry, error) {
+	var entries []entry
+	pending, queued := s.evmPool.Content()
+	for txStatus, txs := range map[TxStatus]map[common.Address][]*ethtypes.Transaction{
+		TxStatus_TX_STATUS_PENDING: pending,
+		TxStatus_TX_STATUS_QUEUED:  queued,
+	} {
+		for sender, senderTxs := range txs {
+			for _, tx := range senderTxs {
+				bz, err := tx.MarshalBinary()
+				if err != nil {
+					return nil, err
+				}
+				entries = append(entries, entry{
+					MempoolTx: &MempoolTx{
+						Hash:   tx.Hash().Hex(),
+						Type:   TxType_TX_TYPE_EVM,
+						Sender: sender.Hex(),
+						Nonce:  tx.Nonce(),
+						Status: txStatus,
+						Tx:     bz,
+					},
+					sender: sender.Bytes(),
+				})
+			}
+		}
+	}
+
+	ctx, err := s.queryCtx()
+	if err != nil {
+		return nil, err
+	}
+	s.cosmosPool.SelectBy(ctx, nil, func(tx sdk.Tx) bool {
+		if isEVMTx(tx) {
+			return true
+		}
+		var bz []byte
+		if bz, err = s.txEncoder(tx); err != nil {
+			return false
+		}
+		e := entry{
+			MempoolTx: &MempoolTx{
+				Hash:   fmt.Sprintf("%X", cmttypes.Tx(bz).Hash()),
+				Type:   TxType_TX_TYPE_COSMOS,
+				Status: TxStatus_TX_STATUS_PENDING,
+				Tx:     bz,
+			},
+			cosmosTx: tx,
+		}
+		if signers, err := s.signers.GetSigners(tx); err == nil && len(signers) > 0 {
+			e.Sender = signers[0].Signer.String()
+			e.Nonce = signers[0].Sequence
+			e.sender = signers[0].Signer.Bytes()
+		}
+		entries = append(entries, e)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode cosmos tx: %w", err)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if c := bytes.Compare(a.sender, b.sender); c != 0 {
+			return c < 0
+		}
+		return a.Nonce < b.Nonce
+	})
+	return entries, nil
+}
+
+// isEVMTx reports whether tx wraps EVM txs kept in the EVM pool, listed from
+// it. The EVM txs paying their fee through a fee abstraction extension option
+// are kept as cosmos txs.
+func isEVMTx(tx sdk.Tx) bool {
+	if IsFeeAbsTx(tx) {
+		return false
+	}
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmvmtypes.MsgEthereumTx); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAddress parses a bech32 or 0x hex address.
+func parseAddress(addr string) ([]byte, error) {
+	addr = strings.TrimSpace(addr)
+	if common.IsHexAddress(addr) {
+		return common.HexToAddress(addr).Bytes(), nil
+	}
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %s: %w", addr, err)
+	}
+	return accAddr, nil
+}
+
+// normalizeHash returns the upper case hex of a tx hash, without 0x prefix.
+func normalizeHash(hash string) string {
+	hash = strings.TrimSpace(hash)
+	if strings.HasPrefix(hash, "0x") || strings.HasPrefix(hash, "0X") {
+		hash = hash[2:]
+	}
+	return strings.ToUpper(hash)
+}
+
+// StartAdminServer serves srv on its own gRPC listener at the address of cfg,
+// encoding the messages with grpcCodec, until ctx is done. It returns once
+// listening, the service being served in the background.
+func StartAdminServer(ctx context.Context, cfg AdminConfig, srv AdminServer, grpcCodec encoding.Codec, logger log.Logger) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	ln, err := net.Listen("tcp", cfg.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on mempool admin address %s: %w", cfg.Address, err)
+	}
+
+	grpcSrv := grpc.NewServer(grpc.ForceServerCodec(grpcCodec))
+	RegisterAdminServer(grpcSrv, srv)
+
+	go func() {
+		if err := grpcSrv.Serve(ln); err != nil {
+			logger.Error("mempool admin gRPC service failed", "address", ln.Addr().String(), "error", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		logger.Info("stopping mempool admin gRPC service", "address", ln.Addr().String())
+		grpcSrv.GracefulStop()
+	}()
+	logger.Info("mempool admin gRPC service started", "address", ln.Addr().String())
+	return nil
+}
@@ -0,0 +1,1842 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tacchain/mempool/v1/admin.proto
+
+package mempool
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TxType is the type of a mempool tx.
+type TxType int32
+
+const (
+	// TX_TYPE_UNSPECIFIED is the default value.
+	TxType_TX_TYPE_UNSPECIFIED TxType = 0
+	// TX_TYPE_EVM is an EVM tx.
+	TxType_TX_TYPE_EVM TxType = 1
+	// TX_TYPE_COSMOS is a cosmos tx.
+	TxType_TX_TYPE_COSMOS TxType = 2
+)
+
+var TxType_name = map[int32]string{
+	0: "TX_TYPE_UNSPECIFIED",
+	1: "TX_TYPE_EVM",
+	2: "TX_TYPE_COSMOS",
+}
+
+var TxType_value = map[string]int32{
+	"TX_TYPE_UNSPECIFIED": 0,
+	"TX_TYPE_EVM":         1,
+	"TX_TYPE_COSMOS":      2,
+}
+
+func (x TxType) String() string {
+	return proto.EnumName(TxType_name, int32(x))
+}
+
+func (TxType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aad609302d0857d7, []int{0}
+}
+
+// TxStatus is the status of a mempool tx.
+type TxStatus int32
+
+const (
+	// TX_STATUS_UNSPECIFIED is the default value.
+	TxStatus_TX_STATUS_UNSPECIFIED TxStatus = 0
+	// TX_STATUS_PENDING is a tx executable on the current state, which can be
+	// included in the next block.
+	TxStatus_TX_STATUS_PENDING TxStatus = 1
+	// TX_STATUS_QUEUED is an EVM tx waiting for a nonce gap to be filled.
+	TxStatus_TX_STATUS_QUEUED TxStatus = 2
+)
+
+var TxStatus_name = map[int32]string{
+	0: "TX_STATUS_UNSPECIFIED",
+	1: "TX_STATUS_PENDING",
+	2: "TX_STATUS_QUEUED",
+}
+
+var TxStatus_value = map[string]int32{
+	"TX_STATUS_UNSPECIFIED": 0,
+	"TX_STATUS_PENDING":     1,
+	"TX_STATUS_QUEUED":      2,
+}
+
+func (x TxStatus) String() string {
+	return proto.EnumName(TxStatus_name, int32(x))
+}
+
+func (TxStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aad609302d0857d7, []int{1}
+}
+
+// MempoolTx is a tx of the mempool.
+type MempoolTx struct {
+	// hash is the hex hash of the tx, the EVM tx hash for EVM txs and the
+	// CometBFT tx hash for cosmos txs.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// type is the type of the tx.
+	Type TxType `protobuf:"varint,2,opt,name=type,proto3,enum=tacchain.mempool.v1.TxType" json:"type,omitempty"`
+	// sender is the 0x hex address of the sender of EVM txs and the bech32
+	// address of the first signer of cosmos txs.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// nonce is the nonce of EVM txs and the sequence of the first signer of
+	// cosmos txs.
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// status is the status of the tx.
+	Status TxStatus `protobuf:"varint,5,opt,name=status,proto3,enum=tacchain.mempool.v1.TxStatus" json:"status,omitempty"`
+	// tx is the binary encoding of EVM txs and the encoded cosmos txs.
+	Tx []byte `protobuf:"bytes,6,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *MempoolTx) Reset()         { *m = MempoolTx{} }
+func (m *MempoolTx) String() string { return proto.CompactTextString(m) }
+func (*MempoolTx) ProtoMessage()    {}
+func (*MempoolTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aad609302d0857d7, []int{0}
+}
+func (m *MempoolTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MempoolTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MempoolTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolTx.Merge(m, src)
+}
+func (m *MempoolTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MempoolTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolTx proto.InternalMessageInfo
+
+func (m *MempoolTx) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *MempoolTx) GetType() TxType {
+	if m != nil {
+		return m.Type
+	}
+	return TxType_TX_TYPE_UNSPECIFIED
+}
+
+func (m *MempoolTx) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MempoolTx) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MempoolTx) GetStatus() TxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TxStatus_TX_STATUS_UNSPECIFIED
+}
+
+func (m *MempoolTx) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// TxsRequest is the request type for the Admin/Txs RPC method.
+type TxsRequest struct {
+	// sender optionally restricts the txs to those of a bech32 or 0x hex
+	// address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *TxsRequest) Reset()         { *m = TxsRequest{} }
+func (m *TxsRequest) String() string { return proto.CompactTextString(m) }
+func (*TxsRequest) ProtoMessage()    {}
+func (*TxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aad609302d0857d7, []int{1}
+}
+func (m *TxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxsRequest.Merge(m, src)
+}
+func (m *TxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxsRequest proto.InternalMessageInfo
+
+func (m *TxsRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// TxsResponse is the response type for the Admin/Txs RPC method.
+type TxsResponse struct {
+	// txs are the txs of the mempool.
+	Txs []*MempoolTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *TxsResponse) Reset()         { *m = TxsResponse{} }
+func (m *TxsResponse) String() string { return proto.CompactTextString(m) }
+func (*TxsResponse) ProtoMessage()    {}
+func (*TxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aad609302d0857d7, []int{2}
+}
+func (m *TxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxsResponse.Merge(m, src)
+}
+func (m *TxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxsResponse proto.InternalMessageInfo
+
+func (m *TxsResponse) GetTxs() []*MempoolTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+// EvictTxRequest is the request type for the Admin/EvictTx RPC method.
+type EvictTxRequest struct {
+	// hash is the hex hash of the tx.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *EvictTxRequest) Reset()         { *m = EvictTxRequest{} }
+func (m *EvictTxRequest) String() string { return proto.CompactTextString(m) }
+func (*EvictTxRequest) ProtoMessage()    {}
+func (*EvictTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aad609302d0857d7, []int{3}
+}
+func (m *EvictTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvictTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvictTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvictTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvictTxRequest.Merge(m, src)
+}
+func (m *EvictTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvictTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvictTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvictTxRequest proto.InternalMessageInfo
+
+func (m *EvictTxRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// EvictTxResponse is the response type for the Admin/EvictTx RPC method.
+type EvictTxResponse struct {
+	// hashes are the hashes of the evicted txs.
+	Hashes []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (m *EvictTxResponse) Reset()         { *m = EvictTxResponse{} }
+func (m *EvictTxResponse) String() string { return proto.CompactTextString(m) }
+func (*EvictTxResponse) ProtoMessage()    {}
+func (*EvictTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aad609302d0857d7, []int{4}
+}
+func (m *EvictTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvictTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvictTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvictTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvictTxResponse.Merge(m, src)
+}
+func (m *EvictTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvictTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvictTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvictTxResponse proto.InternalMessageInfo
+
+func (m *EvictTxResponse) GetHashes() []string {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+// EvictSenderRequest is the request type for the Admin/EvictSender RPC
+// method.
+type EvictSenderRequest struct {
+	// sender is the bech32 or 0x hex address of the sender.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *EvictSenderRequest) Reset()         { *m = EvictSenderRequest{} }
+func (m *EvictSenderRequest) String() string { return proto.CompactTextString(m) }
+func (*EvictSenderRequest) ProtoMessage()    {}
+func (*EvictSenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aad609302d0857d7, []int{5}
+}
+func (m *EvictSenderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvictSenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvictSenderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvictSenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvictSenderRequest.Merge(m, src)
+}
+func (m *EvictSenderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvictSenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvictSenderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvictSenderRequest proto.InternalMessageInfo
+
+func (m *EvictSenderRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// EvictSenderResponse is the response type for the Admin/EvictSender RPC
+// method.
+type EvictSenderResponse struct {
+	// hashes are the hashes of the evicted txs.
+	Hashes []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (m *EvictSenderResponse) Reset()         { *m = EvictSenderResponse{} }
+func (m *EvictSenderResponse) String() string { return proto.CompactTextString(m) }
+func (*EvictSenderResponse) ProtoMessage()    {}
+func (*EvictSenderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aad609302d0857d7, []int{6}
+}
+func (m *EvictSenderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvictSenderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvictSenderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvictSenderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvictSenderResponse.Merge(m, src)
+}
+func (m *EvictSenderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvictSenderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvictSenderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvictSenderResponse proto.InternalMessageInfo
+
+func (m *EvictSenderResponse) GetHashes() []string {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("tacchain.mempool.v1.TxType", TxType_name, TxType_value)
+	proto.RegisterEnum("tacchain.mempool.v1.TxStatus", TxStatus_name, TxStatus_value)
+	proto.RegisterType((*MempoolTx)(nil), "tacchain.mempool.v1.MempoolTx")
+	proto.RegisterType((*TxsRequest)(nil), "tacchain.mempool.v1.TxsRequest")
+	proto.RegisterType((*TxsResponse)(nil), "tacchain.mempool.v1.TxsResponse")
+	proto.RegisterType((*EvictTxRequest)(nil), "tacchain.mempool.v1.EvictTxRequest")
+	proto.RegisterType((*EvictTxResponse)(nil), "tacchain.mempool.v1.EvictTxResponse")
+	proto.RegisterType((*EvictSenderRequest)(nil), "tacchain.mempool.v1.EvictSenderRequest")
+	proto.RegisterType((*EvictSenderResponse)(nil), "tacchain.mempool.v1.EvictSenderResponse")
+}
+
+func init() { proto.RegisterFile("tacchain/mempool/v1/admin.proto", fileDescriptor_aad609302d0857d7) }
+
+var fileDescriptor_aad609302d0857d7 = []byte{
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x51, 0x6f, 0xd2, 0x50,
+	0x14, 0xc7, 0xb9, 0x85, 0x55, 0x39, 0x18, 0x56, 0x0f, 0xdb, 0xac, 0x18, 0xbb, 0xa6, 0x92, 0x58,
+	0x17, 0x6d, 0x1d, 0xc6, 0x67, 0x33, 0x46, 0xa7, 0x3c, 0xc0, 0xb0, 0xbd, 0x98, 0xe9, 0x0b, 0x76,
+	0xa5, 0x11, 0x92, 0xd1, 0xd6, 0xf5, 0x42, 0xba, 0x8f, 0xe0, 0x9b, 0x5f, 0xca, 0xc4, 0xc7, 0x3d,
+	0xfa, 0x68, 0xe0, 0x8b, 0x18, 0x4a, 0xcb, 0xc0, 0xc0, 0xf6, 0xc6, 0xb9, 0xe7, 0xc7, 0xf9, 0x9f,
+	0xfc, 0xff, 0x3d, 0xb0, 0xcf, 0x6c, 0xc7, 0xe9, 0xdb, 0x03, 0x4f, 0x1f, 0xba, 0xc3, 0xc0, 0xf7,
+	0x2f, 0xf4, 0xf1, 0xa1, 0x6e, 0xf7, 0x86, 0x03, 0x4f, 0x0b, 0x2e, 0x7d, 0xe6, 0x63, 0x29, 0x05,
+	0xb4, 0x04, 0xd0, 0xc6, 0x87, 0xca, 0x2f, 0x02, 0xf9, 0xe6, 0xbc, 0xa4, 0x11, 0x22, 0xe4, 0xfa,
+	0x76, 0xd8, 0x17, 0x89, 0x4c, 0xd4, 0xbc, 0x19, 0xff, 0x46, 0x1d, 0x72, 0xec, 0x2a, 0x70, 0x45,
+	0x4e, 0x26, 0x6a, 0xb1, 0xfa, 0x44, 0x5b, 0x33, 0x45, 0xa3, 0x11, 0xbd, 0x0a, 0x5c, 0x33, 0x06,
+	0x71, 0x0f, 0xf8, 0xd0, 0xf5, 0x7a, 0xee, 0xa5, 0x98, 0x8d, 0xc7, 0x24, 0x15, 0xee, 0xc0, 0x96,
+	0xe7, 0x7b, 0x8e, 0x2b, 0xe6, 0x64, 0xa2, 0xe6, 0xcc, 0x79, 0x81, 0x6f, 0x81, 0x0f, 0x99, 0xcd,
+	0x46, 0xa1, 0xb8, 0x15, 0x0b, 0x3c, 0xdd, 0x20, 0x60, 0xc5, 0x90, 0x99, 0xc0, 0x58, 0x04, 0x8e,
+	0x45, 0x22, 0x2f, 0x13, 0xf5, 0x81, 0xc9, 0xb1, 0x48, 0xa9, 0x00, 0xd0, 0x28, 0x34, 0xdd, 0xef,
+	0x23, 0x37, 0x64, 0x4b, 0x2b, 0x90, 0xe5, 0x15, 0x94, 0x77, 0x50, 0x88, 0xa9, 0x30, 0xf0, 0xbd,
+	0xd0, 0xc5, 0xd7, 0x90, 0x65, 0x51, 0x28, 0x12, 0x39, 0xab, 0x16, 0xaa, 0xd2, 0x5a, 0xe1, 0x85,
+	0x37, 0xe6, 0x0c, 0x55, 0x2a, 0x50, 0x34, 0xc6, 0x03, 0x87, 0xd1, 0x28, 0x95, 0x5a, 0x63, 0x99,
+	0xf2, 0x02, 0xb6, 0x17, 0x54, 0x22, 0xb5, 0x07, 0xfc, 0xac, 0xe5, 0xce, 0xd5, 0xf2, 0x66, 0x52,
+	0x29, 0x2f, 0x01, 0x63, 0xd4, 0x8a, 0x17, 0xbc, 0x6b, 0xff, 0x57, 0x50, 0x5a, 0xa1, 0x6f, 0x1f,
+	0x7e, 0x70, 0x02, 0xfc, 0x3c, 0x19, 0x7c, 0x04, 0x25, 0x7a, 0xd6, 0xa5, 0x9f, 0xdb, 0x46, 0xb7,
+	0xd3, 0xb2, 0xda, 0xc6, 0x71, 0xe3, 0xa4, 0x61, 0xd4, 0x85, 0x0c, 0x6e, 0x43, 0x21, 0x6d, 0x18,
+	0x9f, 0x9a, 0x02, 0x41, 0x84, 0x62, 0xfa, 0x70, 0x7c, 0x6a, 0x35, 0x4f, 0x2d, 0x81, 0x3b, 0x30,
+	0xe1, 0x7e, 0x1a, 0x00, 0x3e, 0x86, 0x5d, 0x7a, 0xd6, 0xb5, 0xe8, 0x11, 0xed, 0x58, 0xff, 0xcd,
+	0xda, 0x85, 0x87, 0x37, 0xad, 0xb6, 0xd1, 0xaa, 0x37, 0x5a, 0xef, 0x05, 0x82, 0x3b, 0x20, 0xdc,
+	0x3c, 0x7f, 0xec, 0x18, 0x1d, 0xa3, 0x2e, 0x70, 0xd5, 0x1f, 0x1c, 0x6c, 0x1d, 0xcd, 0xbe, 0x4e,
+	0xfc, 0x00, 0x59, 0x1a, 0x85, 0xb8, 0xbf, 0x21, 0xf8, 0x34, 0xd4, 0xb2, 0xbc, 0x19, 0x48, 0x7c,
+	0xa0, 0x70, 0x2f, 0xf1, 0x1d, 0x9f, 0xad, 0x85, 0x57, 0xb3, 0x2b, 0x57, 0x6e, 0x87, 0x92, 0xa9,
+	0x5f, 0xa1, 0xb0, 0x64, 0x3a, 0x3e, 0xdf, 0xfc, 0xa7, 0x95, 0x10, 0xcb, 0xea, 0xdd, 0xe0, 0x5c,
+	0xa1, 0x56, 0xfb, 0x3d, 0x91, 0xc8, 0xf5, 0x44, 0x22, 0x7f, 0x27, 0x12, 0xf9, 0x39, 0x95, 0x32,
+	0xd7, 0x53, 0x29, 0xf3, 0x67, 0x2a, 0x65, 0xbe, 0xa8, 0xdf, 0x06, 0xac, 0x3f, 0x3a, 0xd7, 0x1c,
+	0x7f, 0xa8, 0x53, 0xdb, 0xa9, 0x8d, 0x06, 0x17, 0x3d, 0x7d, 0x71, 0xe8, 0x76, 0x10, 0xa4, 0xc7,
+	0x7e, 0xce, 0xc7, 0x47, 0xfe, 0xe6, 0xdf, 0x00, 0xa8, 0xd2, 0x26, 0xe8, 0x07, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminClient interface {
+	// Txs returns the EVM and cosmos txs of the mempool, grouped by sender and
+	// sorted by nonce.
+	Txs(ctx context.Context, in *TxsRequest, opts ...grpc.CallOption) (*TxsResponse, error)
+	// EvictTx removes a tx from the mempool.
+	EvictTx(ctx context.Context, in *EvictTxRequest, opts ...grpc.CallOption) (*EvictTxResponse, error)
+	// EvictSender removes all the txs of a sender from the mempool.
+	EvictSender(ctx context.Context, in *EvictSenderRequest, opts ...grpc.CallOption) (*EvictSenderResponse, error)
+}
+
+type adminClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewAdminClient(cc grpc1.ClientConn) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) Txs(ctx context.Context, in *TxsRequest, opts ...grpc.CallOption) (*TxsResponse, error) {
+	out := new(TxsResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.mempool.v1.Admin/Txs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) EvictTx(ctx context.Context, in *EvictTxRequest, opts ...grpc.CallOption) (*EvictTxResponse, error) {
+	out := new(EvictTxResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.mempool.v1.Admin/EvictTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) EvictSender(ctx context.Context, in *EvictSenderRequest, opts ...grpc.CallOption) (*EvictSenderResponse, error) {
+	out := new(EvictSenderResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.mempool.v1.Admin/EvictSender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+type AdminServer interface {
+	// Txs returns the EVM and cosmos txs of the mempool, grouped by sender and
+	// sorted by nonce.
+	Txs(context.Context, *TxsRequest) (*TxsResponse, error)
+	// EvictTx removes a tx from the mempool.
+	EvictTx(context.Context, *EvictTxRequest) (*EvictTxResponse, error)
+	// EvictSender removes all the txs of a sender from the mempool.
+	EvictSender(context.Context, *EvictSenderRequest) (*EvictSenderResponse, error)
+}
+
+// UnimplementedAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (*UnimplementedAdminServer) Txs(ctx context.Context, req *TxsRequest) (*TxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Txs not implemented")
+}
+func (*UnimplementedAdminServer) EvictTx(ctx context.Context, req *EvictTxRequest) (*EvictTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvictTx not implemented")
+}
+func (*UnimplementedAdminServer) EvictSender(ctx context.Context, req *EvictSenderRequest) (*EvictSenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvictSender not implemented")
+}
+
+func RegisterAdminServer(s grpc1.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_Txs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Txs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.mempool.v1.Admin/Txs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Txs(ctx, req.(*TxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_EvictTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).EvictTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.mempool.v1.Admin/EvictTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).EvictTx(ctx, req.(*EvictTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_EvictSender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictSenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).EvictSender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.mempool.v1.Admin/EvictSender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).EvictSender(ctx, req.(*EvictSenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Admin_serviceDesc = _Admin_serviceDesc
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tacchain.mempool.v1.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Txs",
+			Handler:    _Admin_Txs_Handler,
+		},
+		{
+			MethodName: "EvictTx",
+			Handler:    _Admin_EvictTx_Handler,
+		},
+		{
+			MethodName: "EvictSender",
+			Handler:    _Admin_EvictSender_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tacchain/mempool/v1/admin.proto",
+}
+
+func (m *MempoolTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MempoolTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvictTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvictTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvictTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvictTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvictTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvictTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for iNdEx := len(m.Hashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hashes[iNdEx])
+			copy(dAtA[i:], m.Hashes[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.Hashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvictSenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvictSenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvictSenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvictSenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvictSenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvictSenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for iNdEx := len(m.Hashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hashes[iNdEx])
+			copy(dAtA[i:], m.Hashes[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.Hashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MempoolTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovAdmin(uint64(m.Type))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovAdmin(uint64(m.Nonce))
+	}
+	if m.Status != 0 {
+		n += 1 + sovAdmin(uint64(m.Status))
+	}
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *TxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *TxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvictTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *EvictTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for _, s := range m.Hashes {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvictSenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *EvictSenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for _, s := range m.Hashes {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MempoolTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MempoolTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MempoolTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TxType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &MempoolTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvictTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvictTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvictTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvictTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvictTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvictTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hashes = append(m.Hashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvictSenderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvictSenderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvictSenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvictSenderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvictSenderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvictSenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hashes = append(m.Hashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAdmin(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAdmin
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAdmin
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAdmin
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAdmin        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAdmin          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAdmin = fmt.Errorf("proto: unexpected end of group")
+)
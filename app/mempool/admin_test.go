@@ -0,0 +1,362 @@
+package mempool
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"net"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// evmPool mocks the EVM pool of the mempool.
+type evmPool struct {
+	pending map[common.Address][]*ethtypes.Transaction
+	queued  map[common.Address][]*ethtypes.Transaction
+	removed []common.Hash
+}
+
+func (p *evmPool) Content() (map[common.Address][]*ethtypes.Transaction, map[common.Address][]*ethtypes.Transaction) {
+	return p.pending, p.queued
+}
+
+func (p *evmPool) Has(hash common.Hash) bool {
+	for _, txs := range []map[common.Address][]*ethtypes.Transaction{p.pending, p.queued} {
+		for _, senderTxs := range txs {
+			for _, tx := range senderTxs {
+				if tx.Hash() == hash {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// RemoveTx removes a tx and, as the legacy pool, returns the number of
+// pending txs removed.
+func (p *evmPool) RemoveTx(hash common.Hash, _, _ bool) int {
+	p.removed = append(p.removed, hash)
+	removed := 0
+	for i, txs := range []map[common.Address][]*ethtypes.Transaction{p.pending, p.queued} {
+		for sender, senderTxs := range txs {
+			for j, tx := range senderTxs {
+				if tx.Hash() == hash {
+					txs[sender] = append(senderTxs[:j:j], senderTxs[j+1:]...)
+					if i == 0 {
+						removed = 1
+					}
+				}
+			}
+		}
+	}
+	return removed
+}
+
+// cosmosPool mocks the mempool the cosmos txs are selected from.
+type cosmosPool struct {
+	txs     []sdk.Tx
+	removed []sdk.Tx
+}
+
+func (p *cosmosPool) SelectBy(_ context.Context, _ [][]byte, f func(sdk.Tx) bool) {
+	for _, tx := range p.txs {
+		if !f(tx) {
+			return
+		}
+	}
+}
+
+func (p *cosmosPool) Remove(tx sdk.Tx) error {
+	for i, poolTx := range p.txs {
+		if poolTx == tx {
+			p.txs = append(p.txs[:i:i], p.txs[i+1:]...)
+			p.removed = append(p.removed, tx)
+			return nil
+		}
+	}
+	return sdkmempool.ErrTxNotFound
+}
+
+func evmTx(nonce uint64) *ethtypes.Transaction {
+	return ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: nonce, Gas: 21_000, GasPrice: big.NewInt(1)})
+}
+
+type adminFixture struct {
+	server     AdminServer
+	evmPool    *evmPool
+	cosmosPool *cosmosPool
+	logs       *bytes.Buffer
+	txConfig   moduletestutil.TestEncodingConfig
+
+	evmSender    common.Address
+	cosmosSender sdk.AccAddress
+	cosmosTxs    []sdk.Tx
+}
+
+func newAdminFixture(t *testing.T) adminFixture {
+	t.Helper()
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	priv := secp256k1.GenPrivKey()
+	cosmosSender := sdk.AccAddress(priv.PubKey().Address())
+
+	newCosmosTx := func(sequence uint64) sdk.Tx {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(cosmosSender, cosmosSender, sdk.NewCoins(sdk.NewInt64Coin("utac", int64(sequence+1))))))
+		require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+			PubKey:   priv.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: sequence,
+		}))
+		return txBuilder.GetTx()
+	}
+
+	evmSender := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	other := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	f := adminFixture{
+		evmPool: &evmPool{
+			pending: map[common.Address][]*ethtypes.Transaction{
+				evmSender: {evmTx(0), evmTx(1)},
+				other:     {evmTx(5)},
+			},
+			queued: map[common.Address][]*ethtypes.Transaction{
+				evmSender: {evmTx(3)},
+			},
+		},
+		logs:         &bytes.Buffer{},
+		txConfig:     encCfg,
+		evmSender:    evmSender,
+		cosmosSender: cosmosSender,
+		cosmosTxs:    []sdk.Tx{newCosmosTx(8), newCosmosTx(7)},
+	}
+	f.cosmosPool = &cosmosPool{txs: f.cosmosTxs}
+	f.server = NewAdminServer(
+		f.evmPool,
+		f.cosmosPool,
+		func() (sdk.Context, error) { return sdk.Context{}, nil },
+		encCfg.TxConfig.TxEncoder(),
+		log.NewLogger(f.logs, log.ColorOption(false)),
+	)
+	return f
+}
+
+func TestAdminTxs(t *testing.T) {
+	f := newAdminFixture(t)
+
+	res, err := f.server.Txs(context.Background(), &TxsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 6)
+
+	type listed struct {
+		sender string
+		nonce  uint64
+		status TxStatus
+	}
+	var txs []listed
+	for _, tx := range res.Txs {
+		txs = append(txs, listed{tx.Sender, tx.Nonce, tx.Status})
+	}
+	evmSender, other, cosmosSender := f.evmSender.Hex(), common.HexToAddress("0xbb").Hex(), f.cosmosSender.String()
+	require.Equal(t, []listed{
+		{evmSender, 0, TxStatus_TX_STATUS_PENDING},
+		{evmSender, 1, TxStatus_TX_STATUS_PENDING},
+		{evmSender, 3, TxStatus_TX_STATUS_QUEUED},
+		{other, 5, TxStatus_TX_STATUS_PENDING},
+		{cosmosSender, 7, TxStatus_TX_STATUS_PENDING},
+		{cosmosSender, 8, TxStatus_TX_STATUS_PENDING},
+	}, txs)
+
+	require.Equal(t, evmTx(0).Hash().Hex(), res.Txs[0].Hash)
+	require.Equal(t, TxType_TX_TYPE_EVM, res.Txs[0].Type)
+	var tx ethtypes.Transaction
+	require.NoError(t, tx.UnmarshalBinary(res.Txs[0].Tx))
+	require.Equal(t, evmTx(0).Hash(), tx.Hash())
+
+	require.Equal(t, TxType_TX_TYPE_COSMOS, res.Txs[4].Type)
+	decoded, err := f.txConfig.TxConfig.TxDecoder()(res.Txs[4].Tx)
+	require.NoError(t, err)
+	require.Equal(t, f.cosmosTxs[1].GetMsgs(), decoded.GetMsgs())
+
+	// the senders are given as bech32 or hex addresses
+	res, err = f.server.Txs(context.Background(), &TxsRequest{Sender: sdk.AccAddress(f.evmSender.Bytes()).String()})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 3)
+	res, err = f.server.Txs(context.Background(), &TxsRequest{Sender: common.BytesToAddress(f.cosmosSender).Hex()})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+
+	_, err = f.server.Txs(context.Background(), &TxsRequest{Sender: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAdminEvictTx(t *testing.T) {
+	f := newAdminFixture(t)
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 4321}})
+
+	hash := evmTx(3).Hash()
+	res, err := f.server.EvictTx(ctx, &EvictTxRequest{Hash: hash.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []string{hash.Hex()}, res.Hashes)
+	require.Equal(t, []common.Hash{hash}, f.evmPool.removed)
+	require.Contains(t, f.logs.String(), "evicted mempool txs")
+	require.Contains(t, f.logs.String(), "127.0.0.1:4321")
+
+	// the hashes are matched whatever their case and 0x prefix
+	txs, err := f.server.Txs(ctx, &TxsRequest{})
+	require.NoError(t, err)
+	require.Len(t, txs.Txs, 5)
+	cosmosHash := txs.Txs[3].Hash
+	res, err = f.server.EvictTx(ctx, &EvictTxRequest{Hash: "0x" + cosmosHash})
+	require.NoError(t, err)
+	require.Equal(t, []string{cosmosHash}, res.Hashes)
+	require.Equal(t, []sdk.Tx{f.cosmosTxs[1]}, f.cosmosPool.removed)
+
+	_, err = f.server.EvictTx(ctx, &EvictTxRequest{Hash: common.Hash{}.Hex()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = f.server.EvictTx(ctx, &EvictTxRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAdminEvictSender(t *testing.T) {
+	f := newAdminFixture(t)
+
+	res, err := f.server.EvictSender(context.Background(), &EvictSenderRequest{Sender: f.evmSender.Hex()})
+	require.NoError(t, err)
+	// the highest nonces are evicted first
+	expected := []common.Hash{evmTx(3).Hash(), evmTx(1).Hash(), evmTx(0).Hash()}
+	require.Equal(t, expected, f.evmPool.removed)
+	require.Equal(t, []string{expected[0].Hex(), expected[1].Hex(), expected[2].Hex()}, res.Hashes)
+	require.Contains(t, f.logs.String(), "EvictSender")
+
+	res, err = f.server.EvictSender(context.Background(), &EvictSenderRequest{Sender: f.cosmosSender.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Hashes, 2)
+	require.Equal(t, []sdk.Tx{f.cosmosTxs[0], f.cosmosTxs[1]}, f.cosmosPool.removed)
+
+	_, err = f.server.EvictSender(context.Background(), &EvictSenderRequest{Sender: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAdminEvictRemovedTxs(t *testing.T) {
+	f := newAdminFixture(t)
+	server := f.server.(adminServer)
+	entries, err := server.entries()
+	require.NoError(t, err)
+
+	// the txs removed from the mempool since they were listed, e.g. included
+	// in a block, are not reported nor logged as evicted
+	f.evmPool.pending[f.evmSender] = f.evmPool.pending[f.evmSender][1:]
+	f.cosmosPool.txs = f.cosmosPool.txs[1:]
+	hashes, err := server.evict(context.Background(), "EvictTx", entries)
+	require.NoError(t, err)
+	require.Equal(t, []string{entries[1].Hash, entries[2].Hash, entries[3].Hash, entries[4].Hash}, hashes)
+	require.NotContains(t, f.logs.String(), entries[0].Hash)
+	require.NotContains(t, f.logs.String(), entries[5].Hash)
+}
+
+func TestAdminConfigTemplate(t *testing.T) {
+	cfg := AdminConfig{Enable: true, Address: "[::1]:9095"}
+
+	tmpl, err := template.New("app").Parse(DefaultAdminConfigTemplate)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, struct{ MempoolAdmin AdminConfig }{cfg}))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+	parsed, err := AdminConfigFromAppOptions(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg, parsed)
+}
+
+func TestAdminConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cfg    AdminConfig
+		expErr string
+	}{
+		{name: "default", cfg: DefaultAdminConfig()},
+		{name: "IPv6 loopback", cfg: AdminConfig{Address: "[::1]:9095"}},
+		{name: "localhost", cfg: AdminConfig{Address: "localhost:9095"}},
+		{name: "all interfaces", cfg: AdminConfig{Address: "0.0.0.0:9095"}, expErr: "not a loopback one"},
+		{name: "no host", cfg: AdminConfig{Address: ":9095"}, expErr: "not a loopback one"},
+		{name: "hostname", cfg: AdminConfig{Address: "node.example.com:9095"}, expErr: "not a loopback one"},
+		{name: "non-loopback allowed", cfg: AdminConfig{Address: "0.0.0.0:9095", AllowNonLoopback: true}},
+		{name: "no port", cfg: AdminConfig{Address: "127.0.0.1"}, expErr: "invalid address"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+
+	v := viper.New()
+	v.Set(FlagAdminAddress, "0.0.0.0:9095")
+	_, err := AdminConfigFromAppOptions(v)
+	require.ErrorContains(t, err, FlagAdminAllowNonLoopback)
+}
+
+func TestStartAdminServer(t *testing.T) {
+	f := newAdminFixture(t)
+	grpcCodec := codec.NewProtoCodec(f.txConfig.InterfaceRegistry).GRPCCodec()
+
+	// a free loopback port
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, StartAdminServer(ctx, AdminConfig{Enable: true, Address: addr}, f.server, grpcCodec, log.NewNopLogger()))
+
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec)),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	res, err := NewAdminClient(conn).Txs(context.Background(), &TxsRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Txs)
+
+	// the service is stopped with ctx
+	cancel()
+	require.Eventually(t, func() bool {
+		_, err = NewAdminClient(conn).Txs(context.Background(), &TxsRequest{})
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// a non-loopback address is rejected before listening
+	err = StartAdminServer(context.Background(), AdminConfig{Enable: true, Address: "0.0.0.0:0"}, f.server, grpcCodec, log.NewNopLogger())
+	require.ErrorContains(t, err, "not a loopback one")
+}
@@ -0,0 +1,174 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+const flagSender = "sender"
+
+// Cmd returns the commands inspecting and evicting the txs of the mempool of
+// a node through its admin gRPC service.
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "mempool",
+		Short:                      "Inspect and evict the txs of the mempool of a node through its admin gRPC service",
+		Long:                       fmt.Sprintf("Inspect and evict the txs of the mempool of a node through its admin gRPC service, served on %s when %s is set in app.toml. Set --%s to that address, along with --%s for a plaintext loopback listener.", FlagAdminAddress, FlagAdminEnable, flags.FlagGRPC, flags.FlagGRPCInsecure),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ListCmd(),
+		ExportCmd(),
+		EvictTxCmd(),
+		EvictSenderCmd(),
+	)
+
+	return cmd
+}
+
+// ListCmd returns the command listing the txs of the mempool.
+func ListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the EVM and cosmos txs of the mempool by sender and nonce, with their pending or queued status",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, adminClient, err := newAdminClient(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := cmd.Flags().GetString(flagSender)
+			if err != nil {
+				return err
+			}
+
+			res, err := adminClient.Txs(cmd.Context(), &TxsRequest{Sender: sender})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagSender, "", "Only list the txs of a bech32 or 0x hex sender address")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ExportCmd returns the command exporting the txs of the mempool as JSON.
+func ExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [file]",
+		Short: "Export the txs of the mempool as JSON to a file, or to stdout",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, adminClient, err := newAdminClient(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := cmd.Flags().GetString(flagSender)
+			if err != nil {
+				return err
+			}
+
+			res, err := adminClient.Txs(cmd.Context(), &TxsRequest{Sender: sender})
+			if err != nil {
+				return err
+			}
+			bz, err := clientCtx.Codec.MarshalJSON(res)
+			if err != nil {
+				return err
+			}
+			if len(args) == 0 {
+				return clientCtx.PrintRaw(bz)
+			}
+			if err := os.WriteFile(args[0], bz, 0o600); err != nil {
+				return err
+			}
+			cmd.Printf("exported %d txs to %s\n", len(res.Txs), args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSender, "", "Only export the txs of a bech32 or 0x hex sender address")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// evictionNote warns that the evicted txs are left in the CometBFT mempool.
+const evictionNote = "The txs are only evicted from the app-side mempool the node proposes blocks from: " +
+	"the CometBFT mempool of the node is not purged, so it keeps them and may still gossip them to its peers, " +
+	"which may include them in their blocks."
+
+// EvictTxCmd returns the command evicting a tx from the mempool.
+func EvictTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evict-tx [hash]",
+		Short: "Evict a tx from the mempool by its EVM or CometBFT hash",
+		Long:  "Evict a tx from the mempool by its EVM or CometBFT hash. " + evictionNote,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, adminClient, err := newAdminClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := adminClient.EvictTx(cmd.Context(), &EvictTxRequest{Hash: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// EvictSenderCmd returns the command evicting all the txs of a sender from
+// the mempool.
+func EvictSenderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evict-sender [address]",
+		Short: "Evict all the txs of a bech32 or 0x hex sender address from the mempool",
+		Long:  "Evict all the txs of a bech32 or 0x hex sender address from the mempool. " + evictionNote,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, adminClient, err := newAdminClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := adminClient.EvictSender(cmd.Context(), &EvictSenderRequest{Sender: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// newAdminClient returns a client of the admin service. The service is not
+// routed through the CometBFT RPC, so its own gRPC address is required.
+func newAdminClient(cmd *cobra.Command) (client.Context, AdminClient, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return clientCtx, nil, err
+	}
+	if clientCtx.GRPCClient == nil {
+		return clientCtx, nil, errors.New("the mempool admin service is only served over gRPC, set --" + flags.FlagGRPC + " to the " + FlagAdminAddress + " of the node")
+	}
+	return clientCtx, NewAdminClient(clientCtx.GRPCClient), nil
+}
@@ -0,0 +1,201 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// app.toml keys of the mempool admin service
+const (
+	FlagAdminEnable           = "mempool-admin.enable"
+	FlagAdminAddress          = "mempool-admin.address"
+	FlagAdminAllowNonLoopback = "mempool-admin.allow-non-loopback"
+)
+
+// AdminConfig is the node-local configuration of the mempool admin gRPC
+// service.
+type AdminConfig struct {
+	// Enable serves the admin service on its own gRPC listener.
+	Enable bool `mapstructure:"enable"`
+	// Address is the host:port the admin service listens on.
+	Address string `mapstructure:"address"`
+	// AllowNonLoopback allows Address to be reachable from other hosts.
+	AllowNonLoopback bool `mapstructure:"allow-non-loopback"`
+}
+
+// DefaultAdminConfig returns the default mempool admin configuration,
+// disabled and listening on the loopback interface.
+func DefaultAdminConfig() AdminConfig {
+	return AdminConfig{
+		Enable:           false,
+		Address:          "127.0.0.1:9095",
+		AllowNonLoopback: false,
+	}
+}
+
+// AdminConfigFromAppOptions reads the mempool admin configuration from
+// app.toml.
+func AdminConfigFromAppOptions(appOpts servertypes.AppOptions) (AdminConfig, error) {
+	cfg := DefaultAdminConfig()
+	if appOpts == nil {
+		return cfg, nil
+	}
+
+	var err error
+	if v := appOpts.Get(FlagAdminEnable); v != nil {
+		if cfg.Enable, err = cast.ToBoolE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagAdminEnable, err)
+		}
+	}
+	if v := appOpts.Get(FlagAdminAddress); v != nil {
+		if cfg.Address, err = cast.ToStringE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagAdminAddress, err)
+		}
+	}
+	if v := appOpts.Get(FlagAdminAllowNonLoopback); v != nil {
+		if cfg.AllowNonLoopback, err = cast.ToBoolE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagAdminAllowNonLoopback, err)
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// Validate validates the mempool admin configuration. The address must be a
+// loopback one unless AllowNonLoopback is set.
+func (c AdminConfig) Validate() error {
+	host, _, err := net.SplitHostPort(c.Address)
+	if err != nil {
+		return fmt.Errorf("invalid address %q: %w", c.Address, err)
+	}
+	if c.AllowNonLoopback || host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("address %q is not a loopback one, set %s to serve the admin service to other hosts", c.Address, FlagAdminAllowNonLoopback)
+	}
+	return nil
+}
+
+// DefaultAdminConfigTemplate is the app.toml template of the mempool admin
+// configuration.
+const DefaultAdminConfigTemplate = `
+###############################################################################
+###                        Mempool Admin Configuration                      ###
+###############################################################################
+
+# The mempool admin service lists the txs of the app-side mempool and evicts
+# txs or senders. It is served on its own gRPC listener by the node started
+# in-process with CometBFT, apart from the gRPC server of the node. Every
+# eviction is logged with the client address.
+[mempool-admin]
+
+# Enable serves the tacchain.mempool.v1.Admin service.
+enable = {{ .MempoolAdmin.Enable }}
+
+# Address defines the gRPC address the admin service listens on. It must be
+# a loopback address unless allow-non-loopback is set.
+address = "{{ .MempoolAdmin.Address }}"
+
+# AllowNonLoopback allows the admin service to listen on an address reachable
+# from other hosts. The service has no authentication, only enable it behind
+# a firewall or an authenticating proxy.
+allow-non-loopback = {{ .MempoolAdmin.AllowNonLoopback }}
+`
+
+// app.toml keys of the mempool stream service
+const (
+	FlagStreamEnable         = "mempool-stream.enable"
+	FlagStreamBufferSize     = "mempool-stream.buffer-size"
+	FlagStreamMaxSubscribers = "mempool-stream.max-subscribers"
+)
+
+// StreamConfig is the node-local configuration of the mempool stream gRPC
+// service.
+type StreamConfig struct {
+	// Enable serves the stream service on the gRPC server of the node.
+	Enable bool `mapstructure:"enable"`
+	// BufferSize is the number of txs buffered for each subscriber, past
+	// which a subscriber not keeping up is disconnected.
+	BufferSize uint64 `mapstructure:"buffer-size"`
+	// MaxSubscribers is the maximum number of concurrent subscribers.
+	MaxSubscribers uint64 `mapstructure:"max-subscribers"`
+}
+
+// DefaultStreamConfig returns the default mempool stream configuration,
+// disabled.
+func DefaultStreamConfig() StreamConfig {
+	return StreamConfig{
+		Enable:         false,
+		BufferSize:     1024,
+		MaxSubscribers: 100,
+	}
+}
+
+// StreamConfigFromAppOptions reads the mempool stream configuration from
+// app.toml.
+func StreamConfigFromAppOptions(appOpts servertypes.AppOptions) (StreamConfig, error) {
+	cfg := DefaultStreamConfig()
+	if appOpts == nil {
+		return cfg, nil
+	}
+
+	var err error
+	if v := appOpts.Get(FlagStreamEnable); v != nil {
+		if cfg.Enable, err = cast.ToBoolE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagStreamEnable, err)
+		}
+	}
+	if v := appOpts.Get(FlagStreamBufferSize); v != nil {
+		if cfg.BufferSize, err = cast.ToUint64E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagStreamBufferSize, err)
+		}
+	}
+	if v := appOpts.Get(FlagStreamMaxSubscribers); v != nil {
+		if cfg.MaxSubscribers, err = cast.ToUint64E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagStreamMaxSubscribers, err)
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// Validate validates the mempool stream configuration.
+func (c StreamConfig) Validate() error {
+	if c.BufferSize == 0 {
+		return errors.New("buffer size must be positive")
+	}
+	if c.MaxSubscribers == 0 {
+		return errors.New("max subscribers must be positive")
+	}
+	return nil
+}
+
+// DefaultStreamConfigTemplate is the app.toml template of the mempool stream
+// configuration.
+const DefaultStreamConfigTemplate = `
+###############################################################################
+###                        Mempool Stream Configuration                     ###
+###############################################################################
+
+# The mempool stream service pushes the hashes of the cosmos txs accepted in
+# the mempool and of the EVM txs promoted to pending to its subscribers,
+# optionally filtered by sender.
+# It is only served by the gRPC server of the node.
+[mempool-stream]
+
+# Enable serves the tacchain.mempool.v1.Stream service on the gRPC server.
+enable = {{ .MempoolStream.Enable }}
+
+# BufferSize is the number of txs buffered for each subscriber. A subscriber
+# not keeping up with the stream is disconnected once its buffer is full.
+buffer-size = {{ .MempoolStream.BufferSize }}
+
+# MaxSubscribers is the maximum number of concurrent subscribers.
+max-subscribers = {{ .MempoolStream.MaxSubscribers }}
+`
@@ -0,0 +1,197 @@
+package mempool
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	evmvmtypes "github.com/cosmos/evm/x/vm/types"
+
+	feeabstypes "github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+// IsFeeAbsTx reports whether tx is an EVM tx paying its fee through a fee
+// abstraction extension option: in a fee token or from a fee grant.
+func IsFeeAbsTx(tx sdk.Tx) bool {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return false
+	}
+	opts := extTx.GetExtensionOptions()
+	if len(opts) == 0 {
+		return false
+	}
+	switch opts[0].GetTypeUrl() {
+	case sdk.MsgTypeURL(&feeabstypes.ExtensionOptionFeeToken{}), sdk.MsgTypeURL(&feeabstypes.ExtensionOptionFeeGrant{}):
+		return true
+	default:
+		return false
+	}
+}
+
+// FeeAbsMempool is the app-side mempool keeping the EVM txs paying their fee
+// through a fee abstraction extension option in a pool of their own, next to
+// the EVM mempool holding the other txs.
+//
+// The EVM pool only keeps the Ethereum tx of the txs it holds, so the option
+// would be lost once the tx is proposed, broadcast or journaled, and it
+// requires the sender to hold the fee in the EVM denom. The txs of the fee
+// abstraction pool are kept as they were checked, ordered by sender and
+// nonce, and selected after the txs of the EVM mempool.
+type FeeAbsMempool struct {
+	sdkmempool.ExtMempool
+
+	feeAbsPool sdkmempool.ExtMempool
+}
+
+var _ sdkmempool.ExtMempool = (*FeeAbsMempool)(nil)
+
+// NewFeeAbsMempool returns the mempool of evmMempool and of a fee abstraction
+// pool of at most maxTx txs, unlimited if 0.
+func NewFeeAbsMempool(evmMempool sdkmempool.ExtMempool, maxTx int) *FeeAbsMempool {
+	return &FeeAbsMempool{
+		ExtMempool: evmMempool,
+		feeAbsPool: sdkmempool.NewPriorityMempool(sdkmempool.PriorityNonceMempoolConfig[math.Int]{
+			TxPriority: sdkmempool.TxPriority[math.Int]{
+				GetTxPriority: func(_ context.Context, tx sdk.Tx) math.Int {
+					// the fee is not paid in the EVM denom, so the EVM txs
+					// are prioritized by their gas price
+					for _, msg := range tx.GetMsgs() {
+						if ethMsg, ok := msg.(*evmvmtypes.MsgEthereumTx); ok {
+							return math.NewIntFromBigInt(ethMsg.AsTransaction().GasPrice())
+						}
+					}
+					return math.ZeroInt()
+				},
+				Compare: func(a, b math.Int) int {
+					return a.BigInt().Cmp(b.BigInt())
+				},
+				MinValue: math.ZeroInt(),
+			},
+			SignerExtractor: NewFeeAbsSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter()),
+			MaxTx:           maxTx,
+		}),
+	}
+}
+
+// Insert implements sdkmempool.Mempool.
+func (m *FeeAbsMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	if IsFeeAbsTx(tx) {
+		return m.feeAbsPool.Insert(ctx, tx)
+	}
+	return m.ExtMempool.Insert(ctx, tx)
+}
+
+// Remove implements sdkmempool.Mempool.
+func (m *FeeAbsMempool) Remove(tx sdk.Tx) error {
+	if IsFeeAbsTx(tx) {
+		return m.feeAbsPool.Remove(tx)
+	}
+	return m.ExtMempool.Remove(tx)
+}
+
+// CountTx implements sdkmempool.Mempool.
+func (m *FeeAbsMempool) CountTx() int {
+	return m.ExtMempool.CountTx() + m.feeAbsPool.CountTx()
+}
+
+// Select implements sdkmempool.Mempool. The txs of the fee abstraction pool
+// are selected after the txs of the EVM mempool.
+func (m *FeeAbsMempool) Select(ctx context.Context, txs [][]byte) sdkmempool.Iterator {
+	return chainIterators(m.ExtMempool.Select(ctx, txs), m.feeAbsPool.Select(ctx, txs))
+}
+
+// SelectBy implements sdkmempool.ExtMempool.
+func (m *FeeAbsMempool) SelectBy(ctx context.Context, txs [][]byte, f func(sdk.Tx) bool) {
+	for it := m.Select(ctx, txs); it != nil && f(it.Tx()); {
+		it = it.Next()
+	}
+}
+
+// chainedIterator iterates over the txs of an iterator, then over the txs of
+// another one.
+type chainedIterator struct {
+	current sdkmempool.Iterator
+	next    sdkmempool.Iterator
+}
+
+func chainIterators(first, second sdkmempool.Iterator) sdkmempool.Iterator {
+	if first == nil || first.Tx() == nil {
+		return second
+	}
+	if second == nil || second.Tx() == nil {
+		return first
+	}
+	return &chainedIterator{current: first, next: second}
+}
+
+func (it *chainedIterator) Tx() sdk.Tx {
+	return it.current.Tx()
+}
+
+func (it *chainedIterator) Next() sdkmempool.Iterator {
+	if it.current = it.current.Next(); it.current != nil && it.current.Tx() != nil {
+		return it
+	}
+	return it.next
+}
+
+// FeeAbsSignerExtractionAdapter extracts the sender and nonce of the EVM txs
+// paying their fee through a fee abstraction extension option, and the
+// signers of the other txs with a fallback adapter.
+type FeeAbsSignerExtractionAdapter struct {
+	fallback sdkmempool.SignerExtractionAdapter
+}
+
+// NewFeeAbsSignerExtractionAdapter returns a FeeAbsSignerExtractionAdapter
+// falling back to fallback.
+func NewFeeAbsSignerExtractionAdapter(fallback sdkmempool.SignerExtractionAdapter) FeeAbsSignerExtractionAdapter {
+	return FeeAbsSignerExtractionAdapter{fallback: fallback}
+}
+
+// GetSigners implements sdkmempool.SignerExtractionAdapter.
+func (s FeeAbsSignerExtractionAdapter) GetSigners(tx sdk.Tx) ([]sdkmempool.SignerData, error) {
+	if IsFeeAbsTx(tx) {
+		for _, msg := range tx.GetMsgs() {
+			if ethMsg, ok := msg.(*evmvmtypes.MsgEthereumTx); ok {
+				return []sdkmempool.SignerData{
+					sdkmempool.NewSignerData(ethMsg.GetFrom(), ethMsg.AsTransaction().Nonce()),
+				}, nil
+			}
+		}
+	}
+	return s.fallback.GetSigners(tx)
+}
+
+// NewFeeAbsCheckTxHandler returns a CheckTx handler checking the EVM txs
+// paying their fee through a fee abstraction extension option as cosmos txs,
+// and the other txs with next. The EVM mempool handler next inserts the EVM
+// txs with a nonce gap into the EVM pool, which would drop their option, so
+// those are rejected instead.
+func NewFeeAbsCheckTxHandler(txDecoder sdk.TxDecoder, next sdk.CheckTxHandler) sdk.CheckTxHandler {
+	return func(runTx sdk.RunTx, req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+		tx, err := txDecoder(req.Tx)
+		if err != nil || !IsFeeAbsTx(tx) {
+			return next(runTx, req)
+		}
+
+		gInfo, result, anteEvents, err := runTx(req.Tx, tx)
+		if err != nil {
+			return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, false), nil
+		}
+		return &abci.ResponseCheckTx{
+			GasWanted: int64(gInfo.GasWanted), // #nosec G115 -- gas is bounded by the block gas limit
+			GasUsed:   int64(gInfo.GasUsed),   // #nosec G115 -- gas is bounded by the block gas limit
+			Log:       result.Log,
+			Data:      result.Data,
+			Events:    sdk.MarkEventsToIndex(result.Events, nil),
+		}, nil
+	}
+}
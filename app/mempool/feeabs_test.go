@@ -0,0 +1,94 @@
+package mempool
+
+import (
+	"math/big"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	evmvmtypes "github.com/cosmos/evm/x/vm/types"
+
+	feeabstypes "github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+type feeAbsTx struct {
+	msgs []sdk.Msg
+	opts []*codectypes.Any
+}
+
+func (tx feeAbsTx) GetMsgs() []sdk.Msg                                { return tx.msgs }
+func (tx feeAbsTx) GetMsgsV2() ([]protov2.Message, error)             { return nil, nil }
+func (tx feeAbsTx) GetExtensionOptions() []*codectypes.Any            { return tx.opts }
+func (tx feeAbsTx) GetNonCriticalExtensionOptions() []*codectypes.Any { return nil }
+
+// newFeeAbsTx returns an EVM tx of sender carrying option.
+func newFeeAbsTx(t *testing.T, sender sdk.AccAddress, nonce uint64, option *codectypes.Any) feeAbsTx {
+	t.Helper()
+	msg := &evmvmtypes.MsgEthereumTx{}
+	msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: nonce, Gas: 21_000, GasPrice: big.NewInt(1)}))
+	msg.From = sender
+	return feeAbsTx{msgs: []sdk.Msg{msg}, opts: []*codectypes.Any{option}}
+}
+
+func TestFeeAbsMempool(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	priv := secp256k1.GenPrivKey()
+	cosmosSender := sdk.AccAddress(priv.PubKey().Address())
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(cosmosSender, cosmosSender, sdk.NewCoins(sdk.NewInt64Coin("utac", 1)))))
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey: priv.PubKey(),
+		Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+	}))
+	cosmosTx := txBuilder.GetTx()
+
+	feeToken, err := codectypes.NewAnyWithValue(&feeabstypes.ExtensionOptionFeeToken{Denom: "ibc/token"})
+	require.NoError(t, err)
+	feeGrant, err := codectypes.NewAnyWithValue(&feeabstypes.ExtensionOptionFeeGrant{FeeGranter: cosmosSender.String()})
+	require.NoError(t, err)
+	alice := sdk.AccAddress("alice_______________")
+	aliceTxs := []sdk.Tx{newFeeAbsTx(t, alice, 0, feeToken), newFeeAbsTx(t, alice, 1, feeGrant)}
+	require.False(t, IsFeeAbsTx(cosmosTx))
+	require.True(t, IsFeeAbsTx(aliceTxs[0]))
+	require.True(t, IsFeeAbsTx(aliceTxs[1]))
+
+	signers, err := NewFeeAbsSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter()).GetSigners(aliceTxs[1])
+	require.NoError(t, err)
+	require.Equal(t, []sdkmempool.SignerData{sdkmempool.NewSignerData(alice, 1)}, signers)
+
+	// the other txs are kept in the wrapped mempool
+	evmMempool := sdkmempool.NewPriorityMempool(sdkmempool.DefaultPriorityNonceMempoolConfig())
+	mempool := NewFeeAbsMempool(evmMempool, 0)
+	ctx := sdk.Context{}
+	require.NoError(t, mempool.Insert(ctx, aliceTxs[1]))
+	require.NoError(t, mempool.Insert(ctx, cosmosTx))
+	require.NoError(t, mempool.Insert(ctx, aliceTxs[0]))
+	require.Equal(t, 3, mempool.CountTx())
+	require.Equal(t, 1, evmMempool.CountTx())
+
+	selected := func() []sdk.Tx {
+		var txs []sdk.Tx
+		mempool.SelectBy(ctx, nil, func(tx sdk.Tx) bool {
+			txs = append(txs, tx)
+			return true
+		})
+		return txs
+	}
+	// the fee abstraction txs are selected after the other ones, by nonce
+	require.Equal(t, []sdk.Tx{cosmosTx, aliceTxs[0], aliceTxs[1]}, selected())
+
+	require.NoError(t, mempool.Remove(aliceTxs[0]))
+	require.NoError(t, mempool.Remove(cosmosTx))
+	require.Equal(t, 1, mempool.CountTx())
+	require.Equal(t, []sdk.Tx{aliceTxs[1]}, selected())
+}
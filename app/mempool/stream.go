@@ -0,0 +1,246 @@
+package mempool
+
+import (
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// evmTxsEventBuffer is the number of promotions of the EVM pool buffered
+// before it waits for them to be published.
+const evmTxsEventBuffer = 64
+
+// subscription is a subscriber of a Feed.
+type subscription struct {
+	// senders are the senders followed, all of them when empty.
+	senders map[string]struct{}
+	txs     chan *PendingTxsResponse
+	// overflow is closed when the subscriber is dropped for not keeping up.
+	overflow chan struct{}
+}
+
+// follows reports whether the subscription follows one of signers.
+func (s *subscription) follows(signers [][]byte) bool {
+	if len(s.senders) == 0 {
+		return true
+	}
+	for _, signer := range signers {
+		if _, ok := s.senders[string(signer)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Feed fans the txs entering the mempool out to the subscribers of the
+// stream service. Publishing never blocks CheckTx or the EVM pool: every
+// subscriber has a bounded buffer, and a subscriber whose buffer is full is
+// dropped.
+type Feed struct {
+	cfg     StreamConfig
+	signers sdkmempool.SignerExtractionAdapter
+
+	mu   sync.Mutex
+	subs map[*subscription]struct{}
+}
+
+// NewFeed creates a Feed.
+func NewFeed(cfg StreamConfig) (*Feed, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &Feed{
+		cfg:     cfg,
+		signers: NewFeeAbsSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter()),
+		subs:    make(map[*subscription]struct{}),
+	}, nil
+}
+
+// Subscribers returns the number of subscribers.
+func (f *Feed) Subscribers() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.subs)
+}
+
+// Publish sends tx to the subscribers following one of signers, dropping
+// the subscribers whose buffer is full.
+func (f *Feed) Publish(tx *PendingTxsResponse, signers [][]byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for sub := range f.subs {
+		if !sub.follows(signers) {
+			continue
+		}
+		select {
+		case sub.txs <- tx:
+		default:
+			close(sub.overflow)
+			delete(f.subs, sub)
+		}
+	}
+}
+
+// PublishEVMTx publishes an EVM tx of sender.
+func (f *Feed) PublishEVMTx(tx *ethtypes.Transaction, sender common.Address) {
+	f.Publish(&PendingTxsResponse{
+		Hash:   tx.Hash().Hex(),
+		Type:   TxType_TX_TYPE_EVM,
+		Sender: sender.Hex(),
+		Nonce:  tx.Nonce(),
+	}, [][]byte{sender.Bytes()})
+}
+
+// PublishCosmosTx publishes a cosmos tx, bz being the bytes of tx.
+func (f *Feed) PublishCosmosTx(bz []byte, tx sdk.Tx) {
+	res := &PendingTxsResponse{
+		Hash: fmt.Sprintf("%X", cmttypes.Tx(bz).Hash()),
+		Type: TxType_TX_TYPE_COSMOS,
+	}
+	// a tx whose signers can't be extracted is only streamed unfiltered
+	signers, _ := f.signers.GetSigners(tx)
+	senders := make([][]byte, 0, len(signers))
+	for _, signer := range signers {
+		senders = append(senders, signer.Signer)
+	}
+	if len(signers) > 0 {
+		res.Sender = signers[0].Signer.String()
+		res.Nonce = signers[0].Sequence
+	}
+	f.Publish(res, senders)
+}
+
+// EVMTxSubscriber is the pool the EVM txs are fed from, the tx pool of the
+// EVM mempool, whose legacy pool sends the txs it promotes to pending.
+type EVMTxSubscriber interface {
+	SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription
+}
+
+// SubscribeEVMPool publishes the EVM txs pool promotes to pending until the
+// returned subscription is unsubscribed. The pool waits for the txs to be
+// received, so they are buffered and published apart from it.
+func (f *Feed) SubscribeEVMPool(pool EVMTxSubscriber) event.Subscription {
+	ch := make(chan core.NewTxsEvent, evmTxsEventBuffer)
+	sub := pool.SubscribeTransactions(ch, false)
+	go func() {
+		for {
+			select {
+			case ev := <-ch:
+				if f.Subscribers() == 0 {
+					continue
+				}
+				for _, tx := range ev.Txs {
+					// the pool already verified the signature for the chain ID
+					sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+					if err != nil {
+						continue
+					}
+					f.PublishEVMTx(tx, sender)
+				}
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+	return sub
+}
+
+// subscribe subscribes to the txs of senders, or to all the txs if senders
+// is empty.
+func (f *Feed) subscribe(senders [][]byte) (*subscription, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if uint64(len(f.subs)) >= f.cfg.MaxSubscribers {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many subscribers, max %d", f.cfg.MaxSubscribers)
+	}
+
+	sub := &subscription{
+		senders:  make(map[string]struct{}, len(senders)),
+		txs:      make(chan *PendingTxsResponse, f.cfg.BufferSize),
+		overflow: make(chan struct{}),
+	}
+	for _, sender := range senders {
+		sub.senders[string(sender)] = struct{}{}
+	}
+	f.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// unsubscribe removes sub from the subscribers, if it wasn't dropped.
+func (f *Feed) unsubscribe(sub *subscription) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.subs, sub)
+}
+
+// NewCheckTxHandler wraps a CheckTx handler, publishing the new cosmos txs
+// it accepts to feed. The EVM txs are fed from the EVM pool once promoted to
+// pending, see SubscribeEVMPool. Rechecks, rejected txs and, while there are
+// no subscribers, all txs are passed through untouched.
+func NewCheckTxHandler(feed *Feed, txDecoder sdk.TxDecoder, next sdk.CheckTxHandler) sdk.CheckTxHandler {
+	return func(runTx sdk.RunTx, req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+		res, err := next(runTx, req)
+		if err != nil || res.Code != abci.CodeTypeOK || req.Type != abci.CheckTxType_New || feed.Subscribers() == 0 {
+			return res, err
+		}
+
+		tx, decodeErr := txDecoder(req.Tx)
+		if decodeErr != nil || isEVMTx(tx) {
+			return res, err
+		}
+		feed.PublishCosmosTx(req.Tx, tx)
+		return res, err
+	}
+}
+
+type streamServer struct {
+	feed *Feed
+}
+
+// NewStreamServer returns the mempool stream service of the txs published
+// to feed.
+func NewStreamServer(feed *Feed) StreamServer {
+	return streamServer{feed: feed}
+}
+
+// PendingTxs implements the StreamServer.PendingTxs RPC method.
+func (s streamServer) PendingTxs(req *PendingTxsRequest, srv Stream_PendingTxsServer) error {
+	senders := make([][]byte, 0, len(req.Senders))
+	for _, addr := range req.Senders {
+		sender, err := parseAddress(addr)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		senders = append(senders, sender)
+	}
+
+	sub, err := s.feed.subscribe(senders)
+	if err != nil {
+		return err
+	}
+	defer s.feed.unsubscribe(sub)
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return status.FromContextError(srv.Context().Err()).Err()
+		case <-sub.overflow:
+			return status.Errorf(codes.ResourceExhausted, "subscriber not keeping up, more than %d txs buffered", s.feed.cfg.BufferSize)
+		case tx := <-sub.txs:
+			if err := srv.Send(tx); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package mempool
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+	"text/template"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmvmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// pendingTxsServer mocks the server side of a PendingTxs stream, Send
+// blocking until release is closed.
+type pendingTxsServer struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sent    chan *PendingTxsResponse
+	release chan struct{}
+}
+
+func newPendingTxsServer(ctx context.Context) *pendingTxsServer {
+	release := make(chan struct{})
+	close(release)
+	return &pendingTxsServer{ctx: ctx, sent: make(chan *PendingTxsResponse, 16), release: release}
+}
+
+func (s *pendingTxsServer) Context() context.Context { return s.ctx }
+
+func (s *pendingTxsServer) Send(tx *PendingTxsResponse) error {
+	<-s.release
+	s.sent <- tx
+	return nil
+}
+
+// runPendingTxs runs a PendingTxs stream of feed until it is subscribed.
+func runPendingTxs(t *testing.T, feed *Feed, req *PendingTxsRequest, srv *pendingTxsServer) <-chan error {
+	t.Helper()
+	subscribers := feed.Subscribers()
+	done := make(chan error, 1)
+	go func() { done <- NewStreamServer(feed).PendingTxs(req, srv) }()
+	require.Eventually(t, func() bool { return feed.Subscribers() == subscribers+1 }, time.Second, time.Millisecond)
+	return done
+}
+
+// ethereumTx is a cosmos tx wrapping a MsgEthereumTx.
+type ethereumTx struct {
+	sdk.Tx
+	msg *evmvmtypes.MsgEthereumTx
+}
+
+func (tx ethereumTx) GetMsgs() []sdk.Msg { return []sdk.Msg{tx.msg} }
+
+// evmPoolFeed mocks the EVM pool sending the txs it promotes.
+type evmPoolFeed struct {
+	feed event.Feed
+}
+
+func (p *evmPoolFeed) SubscribeTransactions(ch chan<- core.NewTxsEvent, _ bool) event.Subscription {
+	return p.feed.Subscribe(ch)
+}
+
+func newFeed(t *testing.T, bufferSize, maxSubscribers uint64) *Feed {
+	t.Helper()
+	feed, err := NewFeed(StreamConfig{Enable: true, BufferSize: bufferSize, MaxSubscribers: maxSubscribers})
+	require.NoError(t, err)
+	return feed
+}
+
+func TestStreamPendingTxs(t *testing.T) {
+	f := newAdminFixture(t)
+	feed := newFeed(t, 8, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	all := newPendingTxsServer(ctx)
+	allDone := runPendingTxs(t, feed, &PendingTxsRequest{}, all)
+	filtered := newPendingTxsServer(ctx)
+	filteredDone := runPendingTxs(t, feed, &PendingTxsRequest{Senders: []string{f.evmSender.Hex()}}, filtered)
+
+	err := NewStreamServer(feed).PendingTxs(&PendingTxsRequest{}, newPendingTxsServer(ctx))
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	feed.PublishEVMTx(evmTx(3), f.evmSender)
+	cosmosBz, err := f.txConfig.TxConfig.TxEncoder()(f.cosmosTxs[0])
+	require.NoError(t, err)
+	feed.PublishCosmosTx(cosmosBz, f.cosmosTxs[0])
+
+	evmRes := &PendingTxsResponse{Hash: evmTx(3).Hash().Hex(), Type: TxType_TX_TYPE_EVM, Sender: f.evmSender.Hex(), Nonce: 3}
+	require.Equal(t, evmRes, <-all.sent)
+	cosmosRes := <-all.sent
+	require.Equal(t, TxType_TX_TYPE_COSMOS, cosmosRes.Type)
+	require.Equal(t, f.cosmosSender.String(), cosmosRes.Sender)
+	require.Equal(t, uint64(8), cosmosRes.Nonce)
+	require.Len(t, cosmosRes.Hash, 64)
+
+	// the cosmos tx of another sender is filtered out
+	require.Equal(t, evmRes, <-filtered.sent)
+	require.Empty(t, filtered.sent)
+
+	cancel()
+	require.Equal(t, codes.Canceled, status.Code(<-allDone))
+	require.Equal(t, codes.Canceled, status.Code(<-filteredDone))
+	require.Zero(t, feed.Subscribers())
+
+	err = NewStreamServer(feed).PendingTxs(&PendingTxsRequest{Senders: []string{"invalid"}}, newPendingTxsServer(context.Background()))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStreamSlowSubscriber(t *testing.T) {
+	feed := newFeed(t, 1, 2)
+	sender := common.HexToAddress("0xaa")
+
+	slow := newPendingTxsServer(context.Background())
+	slow.release = make(chan struct{})
+	slowDone := runPendingTxs(t, feed, &PendingTxsRequest{}, slow)
+	fast := newPendingTxsServer(context.Background())
+	fastDone := runPendingTxs(t, feed, &PendingTxsRequest{Senders: []string{common.HexToAddress("0xbb").Hex()}}, fast)
+
+	// whether or not the first tx is already blocked in Send, the buffer of
+	// one tx overflows by the third
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		feed.PublishEVMTx(evmTx(nonce), sender)
+	}
+	require.Equal(t, 1, feed.Subscribers())
+	close(slow.release)
+	require.Equal(t, codes.ResourceExhausted, status.Code(<-slowDone))
+
+	// the subscribers keeping up are not affected
+	feed.PublishEVMTx(evmTx(0), common.HexToAddress("0xbb"))
+	require.Equal(t, uint64(0), (<-fast.sent).Nonce)
+	require.Empty(t, fastDone)
+}
+
+func TestStreamCheckTxHandler(t *testing.T) {
+	f := newAdminFixture(t)
+	feed := newFeed(t, 8, 1)
+	bz, err := f.txConfig.TxConfig.TxEncoder()(f.cosmosTxs[0])
+	require.NoError(t, err)
+
+	decoded := 0
+	txDecoder := func(txBytes []byte) (sdk.Tx, error) {
+		decoded++
+		return f.txConfig.TxConfig.TxDecoder()(txBytes)
+	}
+	code := abci.CodeTypeOK
+	next := func(sdk.RunTx, *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+		return &abci.ResponseCheckTx{Code: code}, nil
+	}
+	handler := NewCheckTxHandler(feed, txDecoder, next)
+
+	// the txs are not decoded while there are no subscribers
+	_, err = handler(nil, &abci.RequestCheckTx{Tx: bz, Type: abci.CheckTxType_New})
+	require.NoError(t, err)
+	require.Zero(t, decoded)
+
+	srv := newPendingTxsServer(context.Background())
+	runPendingTxs(t, feed, &PendingTxsRequest{}, srv)
+
+	_, err = handler(nil, &abci.RequestCheckTx{Tx: bz, Type: abci.CheckTxType_Recheck})
+	require.NoError(t, err)
+	code = 1
+	res, err := handler(nil, &abci.RequestCheckTx{Tx: bz, Type: abci.CheckTxType_New})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.Code)
+	require.Zero(t, decoded)
+
+	code = abci.CodeTypeOK
+	_, err = handler(nil, &abci.RequestCheckTx{Tx: bz, Type: abci.CheckTxType_New})
+	require.NoError(t, err)
+	require.Equal(t, f.cosmosSender.String(), (<-srv.sent).Sender)
+	require.Empty(t, srv.sent)
+
+	// the EVM txs are fed from the EVM pool
+	evmHandler := NewCheckTxHandler(feed, func([]byte) (sdk.Tx, error) {
+		msg := &evmvmtypes.MsgEthereumTx{}
+		msg.FromEthereumTx(evmTx(0))
+		return ethereumTx{msg: msg}, nil
+	}, next)
+	_, err = evmHandler(nil, &abci.RequestCheckTx{Tx: []byte{1}, Type: abci.CheckTxType_New})
+	require.NoError(t, err)
+	require.Empty(t, srv.sent)
+}
+
+func TestStreamEVMPool(t *testing.T) {
+	feed := newFeed(t, 8, 1)
+	pool := &evmPoolFeed{}
+	sub := feed.SubscribeEVMPool(pool)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	signTx := func(nonce uint64) *ethtypes.Transaction {
+		tx, err := ethtypes.SignTx(evmTx(nonce), ethtypes.LatestSignerForChainID(big.NewInt(9)), key)
+		require.NoError(t, err)
+		return tx
+	}
+
+	srv := newPendingTxsServer(context.Background())
+	runPendingTxs(t, feed, &PendingTxsRequest{Senders: []string{sender.Hex()}}, srv)
+
+	promoted := []*ethtypes.Transaction{signTx(0), signTx(1)}
+	pool.feed.Send(core.NewTxsEvent{Txs: promoted})
+	for _, tx := range promoted {
+		require.Equal(t, &PendingTxsResponse{
+			Hash:   tx.Hash().Hex(),
+			Type:   TxType_TX_TYPE_EVM,
+			Sender: sender.Hex(),
+			Nonce:  tx.Nonce(),
+		}, <-srv.sent)
+	}
+	require.Empty(t, srv.sent)
+
+	// the txs are no longer fed once unsubscribed
+	sub.Unsubscribe()
+	require.Zero(t, pool.feed.Send(core.NewTxsEvent{Txs: []*ethtypes.Transaction{signTx(2)}}))
+}
+
+func TestStreamConfigTemplate(t *testing.T) {
+	cfg := StreamConfig{Enable: true, BufferSize: 64, MaxSubscribers: 3}
+
+	tmpl, err := template.New("app").Parse(DefaultStreamConfigTemplate)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, struct{ MempoolStream StreamConfig }{cfg}))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+	parsed, err := StreamConfigFromAppOptions(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg, parsed)
+
+	v.Set(FlagStreamBufferSize, 0)
+	_, err = StreamConfigFromAppOptions(v)
+	require.Error(t, err)
+}
@@ -0,0 +1,258 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	evmanteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	feeabsante "github.com/TacBuild/tacchain/x/feeabs/ante"
+)
+
+const (
+	// EventTypeGasRefund is emitted when the unused-gas share of a cosmos tx
+	// fee is refunded.
+	EventTypeGasRefund = "gas_refund"
+
+	// AttributeKeyRefundRecipient is the account the refund is sent to.
+	AttributeKeyRefundRecipient = "recipient"
+	// AttributeKeyGasUnused is the gas wanted but not used by the tx.
+	AttributeKeyGasUnused = "gas_unused"
+)
+
+// GasRefundBankKeeper defines the bank keeper methods the gas refund needs.
+type GasRefundBankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// SupplyChangeKeeper defines the inflation keeper method recording the
+// supply changes of a tx.
+type SupplyChangeKeeper interface {
+	RecordPendingSupplyChanges(ctx context.Context) error
+}
+
+// PostHandlerOptions are the options required for constructing the app's
+// PostHandler.
+type PostHandlerOptions struct {
+	BankKeeper      GasRefundBankKeeper
+	FeeMarketKeeper evmanteinterfaces.FeeMarketKeeper
+	TxPolicyKeeper  RelayRefundKeeper
+	IBCKeeper       *ibckeeper.Keeper
+	FeeAbsKeeper    feeabsante.EVMFeeRefundKeeper
+	EVMKeeper       feeabsante.EVMGasUsedKeeper
+	InflationKeeper SupplyChangeKeeper
+}
+
+// NewPostHandler returns the post handler chain run after the messages of
+// every tx.
+func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
+	if options.BankKeeper == nil {
+		return nil, errors.New("bank keeper is required for post handler builder")
+	}
+	if options.FeeMarketKeeper == nil {
+		return nil, errors.New("fee market keeper is required for post handler builder")
+	}
+	if options.TxPolicyKeeper == nil {
+		return nil, errors.New("tx policy keeper is required for post handler builder")
+	}
+	if options.IBCKeeper == nil {
+		return nil, errors.New("ibc keeper is required for post handler builder")
+	}
+	if options.FeeAbsKeeper == nil {
+		return nil, errors.New("fee abstraction keeper is required for post handler builder")
+	}
+	if options.EVMKeeper == nil {
+		return nil, errors.New("evm keeper is required for post handler builder")
+	}
+	if options.InflationKeeper == nil {
+		return nil, errors.New("inflation keeper is required for post handler builder")
+	}
+
+	newFeeChecker := func(ctx sdk.Context) authante.TxFeeChecker {
+		feemarketParams := options.FeeMarketKeeper.GetParams(ctx)
+		return newCosmosTxFeeChecker(&feemarketParams)
+	}
+	minGasMultiplier := func(ctx sdk.Context) math.LegacyDec {
+		return options.FeeMarketKeeper.GetParams(ctx).MinGasMultiplier
+	}
+	return sdk.ChainPostDecorators(
+		NewGasRefundDecorator(options.BankKeeper, newFeeChecker, minGasMultiplier),
+		NewRelayRefundDecorator(options.BankKeeper, options.TxPolicyKeeper, options.IBCKeeper.ClientKeeper, newFeeChecker, minGasMultiplier),
+		feeabsante.NewEVMFeeRefundDecorator(options.FeeAbsKeeper, options.EVMKeeper),
+		NewSupplyChangeDecorator(options.InflationKeeper),
+	), nil
+}
+
+// SupplyChangeDecorator records the net supply changes the tx made to the
+// module accounts tracked by x/inflation. It has to run last, after every
+// burn and mint of the tx.
+type SupplyChangeDecorator struct {
+	keeper SupplyChangeKeeper
+}
+
+// NewSupplyChangeDecorator creates a SupplyChangeDecorator.
+func NewSupplyChangeDecorator(k SupplyChangeKeeper) SupplyChangeDecorator {
+	return SupplyChangeDecorator{keeper: k}
+}
+
+// PostHandle implements sdk.PostDecorator.
+func (d SupplyChangeDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// the bookkeeping is not charged to the tx, which may have no gas left
+	recordCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if err := d.keeper.RecordPendingSupplyChanges(recordCtx); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate, success)
+}
+
+// GasRefundDecorator refunds the share of a cosmos tx fee paid for gas that
+// was wanted but not used, from the fee collector to the account the fee was
+// deducted from: the feegrant granter if the fee was granted, the fee payer
+// otherwise. EVM txs are skipped as x/vm refunds them itself, and so are
+// fees paid in an x/feeabs fee token.
+//
+// The refunded fee is the one DeductFeeDecorator charged, which the dynamic
+// fee checker may have lowered to the effective gas price. As for EVM txs,
+// the gas used is charged at least the feemarket MinGasMultiplier share of
+// the gas wanted. The feegrant allowance spent on the refunded share is not
+// restored.
+type GasRefundDecorator struct {
+	bankKeeper       GasRefundBankKeeper
+	newFeeChecker    func(ctx sdk.Context) authante.TxFeeChecker
+	minGasMultiplier func(ctx sdk.Context) math.LegacyDec
+}
+
+// NewGasRefundDecorator creates a GasRefundDecorator. newFeeChecker must
+// return the fee checker the ante handler deducted the fee with, and
+// minGasMultiplier the feemarket MinGasMultiplier.
+func NewGasRefundDecorator(
+	bk GasRefundBankKeeper,
+	newFeeChecker func(ctx sdk.Context) authante.TxFeeChecker,
+	minGasMultiplier func(ctx sdk.Context) math.LegacyDec,
+) GasRefundDecorator {
+	return GasRefundDecorator{bankKeeper: bk, newFeeChecker: newFeeChecker, minGasMultiplier: minGasMultiplier}
+}
+
+// PostHandle implements sdk.PostDecorator. The refund is only paid for
+// successful txs during block execution, as the state of failed txs and of
+// CheckTx is discarded.
+func (d GasRefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if simulate || !success || ctx.IsCheckTx() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || isEthereumTx(tx) || !isEVMDenomFee(feeTx.GetFee()) {
+		return next(ctx, tx, simulate, success)
+	}
+
+	gasWanted := feeTx.GetGas()
+	gasUsed := effectiveGasUsed(ctx, gasWanted, d.minGasMultiplier(ctx))
+	if gasWanted == 0 || gasUsed >= gasWanted {
+		return next(ctx, tx, simulate, success)
+	}
+
+	fee, _, err := d.newFeeChecker(ctx)(ctx, tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	refund := refundForUnusedGas(fee, gasWanted, gasUsed)
+	if refund.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	recipient := feeRefundRecipient(feeTx)
+
+	// the refund is not charged to the tx, which may have no gas left for it
+	refundCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if err := d.bankKeeper.SendCoinsFromModuleToAccount(refundCtx, authtypes.FeeCollectorName, recipient, refund); err != nil {
+		return ctx, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeGasRefund,
+			sdk.NewAttribute(AttributeKeyRefundRecipient, recipient.String()),
+			sdk.NewAttribute(AttributeKeyGasUnused, math.NewIntFromUint64(gasWanted-gasUsed).String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, refund.String()),
+		),
+	)
+
+	return next(ctx, tx, simulate, success)
+}
+
+// feeRefundRecipient returns the account the fee of a tx was deducted from:
+// the feegrant granter if the fee was granted, the fee payer otherwise.
+func feeRefundRecipient(feeTx sdk.FeeTx) sdk.AccAddress {
+	recipient := sdk.AccAddress(feeTx.FeePayer())
+	if granter := feeTx.FeeGranter(); granter != nil && !bytes.Equal(granter, recipient) {
+		recipient = granter
+	}
+	return recipient
+}
+
+// effectiveGasUsed returns the gas a cosmos tx is charged for, the gas it
+// consumed but at least the minGasMultiplier share of the gas wanted, as x/vm
+// charges EVM txs.
+func effectiveGasUsed(ctx sdk.Context, gasWanted uint64, minGasMultiplier math.LegacyDec) uint64 {
+	return max(ctx.GasMeter().GasConsumed(), minGasUsed(gasWanted, minGasMultiplier))
+}
+
+// minGasUsed returns the gas a tx is charged for at least, the
+// minGasMultiplier share of the gas wanted, rounded down.
+func minGasUsed(gasWanted uint64, minGasMultiplier math.LegacyDec) uint64 {
+	if minGasMultiplier.IsNil() || !minGasMultiplier.IsPositive() {
+		return 0
+	}
+	minimum := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasWanted)).Mul(minGasMultiplier).TruncateInt()
+	if !minimum.IsUint64() {
+		return gasWanted
+	}
+	return minimum.Uint64()
+}
+
+// refundForUnusedGas returns the share of the fee paid for the unused gas,
+// rounded down.
+func refundForUnusedGas(fee sdk.Coins, gasWanted, gasUsed uint64) sdk.Coins {
+	unused := math.NewIntFromUint64(gasWanted - gasUsed)
+	wanted := math.NewIntFromUint64(gasWanted)
+
+	refund := sdk.NewCoins()
+	for _, coin := range fee {
+		refund = refund.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(unused).Quo(wanted)))
+	}
+	return refund
+}
+
+// isEVMDenomFee reports whether the fee is paid in the EVM denom, as opposed
+// to a fee token converted by x/feeabs.
+func isEVMDenomFee(fee sdk.Coins) bool {
+	for _, coin := range fee {
+		if coin.Denom != evmtypes.GetEVMCoinDenom() {
+			return false
+		}
+	}
+	return true
+}
+
+// isEthereumTx reports whether the tx carries an Ethereum tx.
+func isEthereumTx(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,230 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	appconfig "github.com/TacBuild/tacchain/app/config"
+)
+
+type refundTx struct {
+	msgs       []sdk.Msg
+	gas        uint64
+	fee        sdk.Coins
+	feePayer   sdk.AccAddress
+	feeGranter sdk.AccAddress
+}
+
+func (tx refundTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx refundTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx refundTx) GetGas() uint64                        { return tx.gas }
+func (tx refundTx) GetFee() sdk.Coins                     { return tx.fee }
+func (tx refundTx) FeePayer() []byte                      { return tx.feePayer }
+func (tx refundTx) FeeGranter() []byte                    { return tx.feeGranter }
+
+type refundBankKeeper struct {
+	recipient sdk.AccAddress
+	amount    sdk.Coins
+}
+
+func (bk *refundBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if senderModule != authtypes.FeeCollectorName {
+		panic("unexpected sender module " + senderModule)
+	}
+	bk.recipient = recipientAddr
+	bk.amount = amt
+	return nil
+}
+
+// halfFeeChecker mimics a dynamic fee checker charging half of the offered
+// fee.
+func halfFeeChecker(sdk.Context) authante.TxFeeChecker {
+	return func(_ sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		fee := tx.(sdk.FeeTx).GetFee()
+		return sdk.NewCoins(sdk.NewCoin(fee[0].Denom, fee[0].Amount.QuoRaw(2))), 0, nil
+	}
+}
+
+func TestGasRefundDecorator(t *testing.T) {
+	// the same fallback the EVM keeper sets
+	evmtypes.SetDefaultEvmCoinInfo(evmtypes.EvmCoinInfo{
+		Denom:         appconfig.BaseDenom,
+		ExtendedDenom: appconfig.BaseDenom,
+		DisplayDenom:  appconfig.DisplayDenom,
+		Decimals:      evmtypes.EighteenDecimals.Uint32(),
+	})
+
+	payer := sdk.AccAddress("payer")
+	granter := sdk.AccAddress("granter")
+	fee := sdk.NewCoins(sdk.NewInt64Coin("utac", 2_000))
+
+	testCases := []struct {
+		name             string
+		tx               refundTx
+		gasUsed          uint64
+		minGasMultiplier math.LegacyDec
+		simulate         bool
+		success          bool
+		checkTx          bool
+		recipient        sdk.AccAddress
+		refund           sdk.Coins
+	}{
+		{
+			name:      "refunds the unused share of the charged fee to the payer",
+			tx:        refundTx{gas: 100_000, fee: fee, feePayer: payer},
+			gasUsed:   40_000,
+			success:   true,
+			recipient: payer,
+			refund:    sdk.NewCoins(sdk.NewInt64Coin("utac", 600)),
+		},
+		{
+			name:             "charges at least the min gas multiplier share of the gas wanted",
+			tx:               refundTx{gas: 100_000, fee: fee, feePayer: payer},
+			gasUsed:          40_000,
+			minGasMultiplier: math.LegacyNewDecWithPrec(5, 1),
+			success:          true,
+			recipient:        payer,
+			refund:           sdk.NewCoins(sdk.NewInt64Coin("utac", 500)),
+		},
+		{
+			name:             "min gas multiplier below the gas used",
+			tx:               refundTx{gas: 100_000, fee: fee, feePayer: payer},
+			gasUsed:          40_000,
+			minGasMultiplier: math.LegacyNewDecWithPrec(2, 1),
+			success:          true,
+			recipient:        payer,
+			refund:           sdk.NewCoins(sdk.NewInt64Coin("utac", 600)),
+		},
+		{
+			name:             "min gas multiplier of one refunds nothing",
+			tx:               refundTx{gas: 100_000, fee: fee, feePayer: payer},
+			gasUsed:          40_000,
+			minGasMultiplier: math.LegacyOneDec(),
+			success:          true,
+		},
+		{
+			name:      "refunds the granter of a granted fee",
+			tx:        refundTx{gas: 100_000, fee: fee, feePayer: payer, feeGranter: granter},
+			gasUsed:   75_000,
+			success:   true,
+			recipient: granter,
+			refund:    sdk.NewCoins(sdk.NewInt64Coin("utac", 250)),
+		},
+		{
+			name:    "all gas used",
+			tx:      refundTx{gas: 100_000, fee: fee, feePayer: payer},
+			gasUsed: 100_000,
+			success: true,
+		},
+		{
+			name:    "failed tx",
+			tx:      refundTx{gas: 100_000, fee: fee, feePayer: payer},
+			gasUsed: 40_000,
+		},
+		{
+			name:     "simulation",
+			tx:       refundTx{gas: 100_000, fee: fee, feePayer: payer},
+			gasUsed:  40_000,
+			simulate: true,
+			success:  true,
+		},
+		{
+			name:    "check tx",
+			tx:      refundTx{gas: 100_000, fee: fee, feePayer: payer},
+			gasUsed: 40_000,
+			success: true,
+			checkTx: true,
+		},
+		{
+			name:    "fee paid in a fee token",
+			tx:      refundTx{gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("ibc/token", 2_000)), feePayer: payer},
+			gasUsed: 40_000,
+			success: true,
+		},
+		{
+			name:    "ethereum tx",
+			tx:      refundTx{msgs: []sdk.Msg{&evmtypes.MsgEthereumTx{}}, gas: 100_000, fee: fee, feePayer: payer},
+			gasUsed: 40_000,
+			success: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key := storetypes.NewKVStoreKey(banktypes.StoreKey)
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).
+				WithIsCheckTx(tc.checkTx).
+				WithGasMeter(storetypes.NewGasMeter(tc.tx.gas))
+			ctx.GasMeter().ConsumeGas(tc.gasUsed, "test")
+
+			bk := &refundBankKeeper{}
+			minGasMultiplier := func(sdk.Context) math.LegacyDec { return tc.minGasMultiplier }
+			decorator := NewGasRefundDecorator(bk, halfFeeChecker, minGasMultiplier)
+			postHandler := sdk.ChainPostDecorators(decorator)
+
+			_, err := postHandler(ctx, tc.tx, tc.simulate, tc.success)
+			require.NoError(t, err)
+			require.Equal(t, tc.recipient, bk.recipient)
+			require.Equal(t, tc.refund, bk.amount)
+			require.Equal(t, tc.gasUsed, ctx.GasMeter().GasConsumed())
+		})
+	}
+}
+
+func TestSupplyChangeDecoratorEVMTransfer(t *testing.T) {
+	tacApp := NewTacChainAppWithCustomOptions(t, false, SetupOptions{
+		Logger:  log.NewTestLogger(t),
+		DB:      dbm.NewMemDB(),
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+	ctx := tacApp.NewContextLegacy(false, cmtproto.Header{Height: 2}).WithTxBytes([]byte("tx"))
+
+	sender, recipient := ethcmn.BytesToAddress([]byte("sender")), ethcmn.BytesToAddress([]byte("recipient"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 1_000))
+	require.NoError(t, tacApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, tacApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender.Bytes(), funds))
+
+	// x/vm settles the transfer by burning from and minting into its module
+	// account through the burn tracking bank keeper
+	stateDB := statedb.New(ctx, tacApp.EVMKeeper, statedb.NewEmptyTxConfig())
+	core.Transfer(stateDB, sender, recipient, uint256.NewInt(400))
+	require.NoError(t, stateDB.Commit())
+	require.Equal(t, int64(400), tacApp.BankKeeper.GetBalance(ctx, recipient.Bytes(), evmtypes.GetEVMCoinDenom()).Amount.Int64())
+	pending, err := tacApp.InflationKeeper.PendingSupplyChanges.Get(ctx, evmtypes.ModuleName)
+	require.NoError(t, err)
+	require.False(t, pending.Burned.IsZero())
+
+	postHandler := sdk.ChainPostDecorators(NewSupplyChangeDecorator(tacApp.InflationKeeper))
+	_, err = postHandler(ctx, refundTx{}, false, true)
+	require.NoError(t, err)
+
+	changes, err := tacApp.InflationKeeper.ModuleSupplyChanges(ctx, evmtypes.ModuleName)
+	require.NoError(t, err)
+	require.True(t, changes[0].Change.IsEmpty())
+	records, err := tacApp.InflationKeeper.GetBurnRecords(ctx)
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
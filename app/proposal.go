@@ -0,0 +1,122 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmvmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// NewProcessProposalHandler returns the ProcessProposal handler rejecting
+// proposals whose txs cannot be decoded, exceed the block gas limit alone or
+// together, or fail the ante handler. The block gas limit is the lowest of
+// the EVM block gas limit read from genesis, which the EVM mempool assumes,
+// and the consensus max gas.
+//
+// The txs are decoded and their gas summed before any of them runs the ante
+// handler, so that malformed proposals are rejected cheaply.
+//
+// The validators only agree on the proposals they accept if they all run the
+// handler with the same EVM block gas limit, so it ships with the coordinated
+// v1.7.0 upgrade.
+func NewProcessProposalHandler(txVerifier baseapp.ProposalTxVerifier, evmBlockGasLimit uint64) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if err := validateProposal(ctx, txVerifier, evmBlockGasLimit, req.Txs); err != nil {
+			ctx.Logger().Error("rejecting proposal", "height", req.Height, "proposer", fmt.Sprintf("%X", req.ProposerAddress), "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+}
+
+func validateProposal(ctx sdk.Context, txVerifier baseapp.ProposalTxVerifier, evmBlockGasLimit uint64, txs [][]byte) error {
+	blockGasLimit := proposalBlockGasLimit(ctx, evmBlockGasLimit)
+
+	var totalTxGas uint64
+	for i, txBytes := range txs {
+		tx, err := txVerifier.TxDecode(txBytes)
+		if err != nil {
+			return fmt.Errorf("tx %d cannot be decoded: %w", i, err)
+		}
+
+		txGas := proposalTxGas(tx)
+		if txGas > blockGasLimit {
+			return fmt.Errorf("tx %d gas %d exceeds the block gas limit %d", i, txGas, blockGasLimit)
+		}
+		totalTxGas = addGas(totalTxGas, txGas)
+		if totalTxGas > blockGasLimit {
+			return fmt.Errorf("txs gas exceeds the block gas limit %d at tx %d", blockGasLimit, i)
+		}
+	}
+
+	for i, txBytes := range txs {
+		if _, err := txVerifier.ProcessProposalVerifyTx(txBytes); err != nil {
+			return fmt.Errorf("tx %d fails verification: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// blockGasLimitTxSelector caps the gas of the txs it selects to the block gas
+// limit of NewProcessProposalHandler.
+type blockGasLimitTxSelector struct {
+	baseapp.TxSelector
+
+	evmBlockGasLimit uint64
+}
+
+func newBlockGasLimitTxSelector(txSelector baseapp.TxSelector, evmBlockGasLimit uint64) baseapp.TxSelector {
+	return blockGasLimitTxSelector{TxSelector: txSelector, evmBlockGasLimit: evmBlockGasLimit}
+}
+
+func (ts blockGasLimitTxSelector) SelectTxForProposal(ctx context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	if sdkCtx, ok := ctx.(sdk.Context); ok {
+		maxBlockGas = proposalBlockGasLimit(sdkCtx, ts.evmBlockGasLimit)
+	}
+	return ts.TxSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx, txBz)
+}
+
+// proposalBlockGasLimit returns the gas limit of the txs of a proposal. A zero
+// EVM block gas limit, returned when genesis cannot be read, is ignored.
+func proposalBlockGasLimit(ctx sdk.Context, evmBlockGasLimit uint64) uint64 {
+	limit := uint64(math.MaxUint64)
+	if evmBlockGasLimit > 0 {
+		limit = evmBlockGasLimit
+	}
+	if b := ctx.ConsensusParams().Block; b != nil && b.MaxGas > 0 {
+		limit = min(limit, uint64(b.MaxGas))
+	}
+	return limit
+}
+
+// proposalTxGas returns the gas limit of a tx, the highest of its cosmos gas
+// limit and the sum of the gas limits of its EVM txs.
+func proposalTxGas(tx sdk.Tx) uint64 {
+	var gas uint64
+	if gasTx, ok := tx.(baseapp.GasTx); ok {
+		gas = gasTx.GetGas()
+	}
+
+	var evmGas uint64
+	for _, msg := range tx.GetMsgs() {
+		if ethMsg, ok := msg.(*evmvmtypes.MsgEthereumTx); ok {
+			evmGas = addGas(evmGas, ethMsg.GetGas())
+		}
+	}
+	return max(gas, evmGas)
+}
+
+// addGas adds gas amounts, saturating at the max uint64 rather than
+// overflowing on the gas limits set by a malicious proposer.
+func addGas(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+	return a + b
+}
@@ -0,0 +1,218 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmvmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/app/lanes"
+)
+
+type proposalTx struct {
+	refundTx
+	msgs []sdk.Msg
+}
+
+func (tx proposalTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+// proposalTxVerifier decodes the txs of a map and fails the verification of
+// the txs in failing.
+type proposalTxVerifier struct {
+	baseapp.ProposalTxVerifier
+
+	txs      map[string]sdk.Tx
+	failing  map[string]bool
+	verified []string
+}
+
+func (v *proposalTxVerifier) TxDecode(txBz []byte) (sdk.Tx, error) {
+	tx, ok := v.txs[string(txBz)]
+	if !ok {
+		return nil, errors.New("undecodable tx")
+	}
+	return tx, nil
+}
+
+func (v *proposalTxVerifier) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
+	v.verified = append(v.verified, string(txBz))
+	if v.failing[string(txBz)] {
+		return nil, errors.New("ante handler failure")
+	}
+	return v.TxDecode(txBz)
+}
+
+func newEthereumMsg(gas uint64) *evmvmtypes.MsgEthereumTx {
+	msg := &evmvmtypes.MsgEthereumTx{}
+	msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.LegacyTx{Gas: gas}))
+	return msg
+}
+
+func TestProcessProposalHandler(t *testing.T) {
+	txs := map[string]sdk.Tx{
+		"small":    refundTx{gas: 100},
+		"medium":   refundTx{gas: 600},
+		"large":    refundTx{gas: 1_200},
+		"failing":  refundTx{gas: 100},
+		"evm":      proposalTx{refundTx: refundTx{gas: 100}, msgs: []sdk.Msg{newEthereumMsg(700)}},
+		"evm bomb": proposalTx{refundTx: refundTx{gas: 100}, msgs: []sdk.Msg{newEthereumMsg(1 << 63), newEthereumMsg(1 << 63)}},
+	}
+
+	testCases := []struct {
+		name             string
+		txs              []string
+		evmBlockGasLimit uint64
+		consensusMaxGas  int64
+		accept           bool
+		verified         []string
+	}{
+		{
+			name:             "valid proposal",
+			txs:              []string{"small", "medium", "small"},
+			evmBlockGasLimit: 1_000,
+			consensusMaxGas:  -1,
+			accept:           true,
+			verified:         []string{"small", "medium", "small"},
+		},
+		{
+			name:             "empty proposal",
+			evmBlockGasLimit: 1_000,
+			accept:           true,
+		},
+		{
+			name:             "undecodable tx",
+			txs:              []string{"small", "garbage"},
+			evmBlockGasLimit: 1_000,
+		},
+		{
+			name:             "tx exceeding the EVM block gas limit",
+			txs:              []string{"large"},
+			evmBlockGasLimit: 1_000,
+		},
+		{
+			name:             "txs exceeding the EVM block gas limit",
+			txs:              []string{"medium", "small", "medium"},
+			evmBlockGasLimit: 1_000,
+		},
+		{
+			name:             "txs exceeding the consensus max gas",
+			txs:              []string{"medium", "medium"},
+			evmBlockGasLimit: 10_000,
+			consensusMaxGas:  1_000,
+		},
+		{
+			name:             "EVM gas counted over the cosmos gas limit",
+			txs:              []string{"evm", "medium"},
+			evmBlockGasLimit: 1_000,
+		},
+		{
+			name:             "overflowing EVM gas",
+			txs:              []string{"evm bomb"},
+			evmBlockGasLimit: 1_000,
+		},
+		{
+			name:             "unknown EVM block gas limit",
+			txs:              []string{"large"},
+			evmBlockGasLimit: 0,
+			accept:           true,
+			verified:         []string{"large"},
+		},
+		{
+			name:             "tx failing verification",
+			txs:              []string{"small", "failing", "small"},
+			evmBlockGasLimit: 1_000,
+			verified:         []string{"small", "failing"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			verifier := &proposalTxVerifier{txs: txs, failing: map[string]bool{"failing": true}}
+			ctx := sdk.Context{}.WithLogger(log.NewNopLogger()).WithConsensusParams(cmtproto.ConsensusParams{
+				Block: &cmtproto.BlockParams{MaxGas: tc.consensusMaxGas},
+			})
+
+			req := &abci.RequestProcessProposal{}
+			for _, tx := range tc.txs {
+				req.Txs = append(req.Txs, []byte(tx))
+			}
+			res, err := NewProcessProposalHandler(verifier, tc.evmBlockGasLimit)(ctx, req)
+			require.NoError(t, err)
+			if tc.accept {
+				require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+			} else {
+				require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+			}
+			require.Equal(t, tc.verified, verifier.verified)
+		})
+	}
+}
+
+type recordingTxSelector struct {
+	baseapp.TxSelector
+	maxBlockGas uint64
+}
+
+func (ts *recordingTxSelector) SelectTxForProposal(_ context.Context, _, maxBlockGas uint64, _ sdk.Tx, _ []byte) bool {
+	ts.maxBlockGas = maxBlockGas
+	return false
+}
+
+func TestBlockGasLimitTxSelector(t *testing.T) {
+	recorder := &recordingTxSelector{}
+	ts := newBlockGasLimitTxSelector(recorder, 1_000)
+
+	ctx := sdk.Context{}.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: -1}})
+	ts.SelectTxForProposal(ctx, 100, ^uint64(0), nil, nil)
+	require.Equal(t, uint64(1_000), recorder.maxBlockGas)
+
+	ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 500}})
+	ts.SelectTxForProposal(ctx, 100, 500, nil, nil)
+	require.Equal(t, uint64(500), recorder.maxBlockGas)
+}
+
+func TestPrepareProposalLanesSameSender(t *testing.T) {
+	c := newTestChain(t, simtestutil.AppOptionsMap{
+		lanes.FlagEnable:       true,
+		lanes.FlagDefaultShare: 0.5,
+	})
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := sdk.AccAddress(crypto.PubkeyToAddress(key.PublicKey).Bytes())
+	c.fund(sender, sdk.NewInt64Coin(evmvmtypes.GetEVMCoinDenom(), 1_000_000_000_000_000))
+	// the EVM mempool reads the state its latest block started from
+	c.nextBlock(nil)
+	c.nextBlock(nil)
+
+	// the third tx of the sender does not fit in what is left of the default
+	// lane, while its smaller fourth tx would
+	var txs [][]byte
+	for nonce, data := range [][]byte{make([]byte, 100), make([]byte, 100), make([]byte, 1_000), nil} {
+		tx, _ := c.evmTx(key, uint64(nonce), 100_000, data, nil, nil)
+		c.checkTx(tx)
+		txs = append(txs, tx)
+	}
+	laneTxBytes := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txs[0], txs[1], txs[3]})
+	c.maxTxBytes = 2 * laneTxBytes
+
+	// the proposal stops at the skipped tx rather than leaving a nonce gap,
+	// and is accepted and executed
+	c.proposeBlock(txs[0], txs[1])
+
+	c.maxTxBytes = 1 << 20
+	c.proposeBlock(txs[2], txs[3])
+}
@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	evmvmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// NewCheckTxHandler wraps a CheckTx handler, rejecting the new txs of
+// senders exceeding their rate with ErrSenderRateLimited. The senders of a tx
+// are its signers, the sender of an EVM tx being its from address. Rechecks
+// and undecodable txs, rejected by the wrapped handler, are not limited.
+//
+// An EVM tx is identified by its Ethereum hash rather than by its bytes: the
+// node re-encodes the EVM txs its mempool promotes before broadcasting them,
+// and those must not be charged again.
+func NewCheckTxHandler(limiter *Limiter, txDecoder sdk.TxDecoder, next sdk.CheckTxHandler) sdk.CheckTxHandler {
+	return func(runTx sdk.RunTx, req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+		if req.Type != abci.CheckTxType_New {
+			return next(runTx, req)
+		}
+
+		tx, err := txDecoder(req.Tx)
+		if err != nil {
+			return next(runTx, req)
+		}
+		sigTx, ok := tx.(authsigning.SigVerifiableTx)
+		if !ok {
+			return next(runTx, req)
+		}
+		signers, err := sigTx.GetSigners()
+		if err != nil {
+			return next(runTx, req)
+		}
+
+		if sender, ok := limiter.AllowTx(txKey(tx, req.Tx), signers); !ok {
+			err := errorsmod.Wrapf(ErrSenderRateLimited, "sender %s", sdk.AccAddress(sender))
+			return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, nil, false), nil
+		}
+		return next(runTx, req)
+	}
+}
+
+// txKey returns the bytes identifying a tx: the Ethereum hash of an EVM tx,
+// and the tx bytes of the other txs.
+func txKey(tx sdk.Tx, txBytes []byte) []byte {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return txBytes
+	}
+	ethMsg, ok := msgs[0].(*evmvmtypes.MsgEthereumTx)
+	if !ok {
+		return txBytes
+	}
+	return ethMsg.Hash().Bytes()
+}
@@ -0,0 +1,91 @@
+package ratelimit
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	evmvmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+type signedTx struct {
+	authsigning.SigVerifiableTx
+
+	signers [][]byte
+	msgs    []sdk.Msg
+}
+
+func (tx signedTx) GetSigners() ([][]byte, error) { return tx.signers, nil }
+func (tx signedTx) GetMsgs() []sdk.Msg            { return tx.msgs }
+
+// evmTx returns the EVM tx of sender with the given nonce.
+func evmTx(sender sdk.AccAddress, nonce uint64) signedTx {
+	msg := &evmvmtypes.MsgEthereumTx{}
+	msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: nonce, Gas: 21_000, GasPrice: big.NewInt(1)}))
+	msg.From = sender
+	return signedTx{signers: [][]byte{sender}, msgs: []sdk.Msg{msg}}
+}
+
+func TestCheckTxHandler(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SenderRate = 1
+	cfg.SenderBurst = 1
+	limiter, _ := newTestLimiter(t, cfg)
+
+	alice := sdk.AccAddress("alice")
+	bob := sdk.AccAddress("bob")
+	txs := map[string]sdk.Tx{
+		"alice 1": signedTx{signers: [][]byte{alice}},
+		"alice 2": signedTx{signers: [][]byte{alice}},
+		// the same EVM tx, re-encoded by the node
+		"bob 1":            evmTx(bob, 1),
+		"bob 1 re-encoded": evmTx(bob, 1),
+		"bob 2":            evmTx(bob, 2),
+	}
+	txDecoder := func(txBytes []byte) (sdk.Tx, error) {
+		tx, ok := txs[string(txBytes)]
+		if !ok {
+			return nil, errors.New("undecodable tx")
+		}
+		return tx, nil
+	}
+
+	var checked []string
+	next := func(_ sdk.RunTx, req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+		checked = append(checked, string(req.Tx))
+		return &abci.ResponseCheckTx{}, nil
+	}
+	handler := NewCheckTxHandler(limiter, txDecoder, next)
+
+	checkTx := func(tx string, checkTxType abci.CheckTxType) *abci.ResponseCheckTx {
+		res, err := handler(nil, &abci.RequestCheckTx{Tx: []byte(tx), Type: checkTxType})
+		require.NoError(t, err)
+		return res
+	}
+
+	require.True(t, checkTx("alice 1", abci.CheckTxType_New).IsOK())
+
+	res := checkTx("alice 2", abci.CheckTxType_New)
+	require.Equal(t, Codespace, res.Codespace)
+	require.Equal(t, ErrSenderRateLimited.ABCICode(), res.Code)
+	require.Contains(t, res.Log, alice.String())
+
+	// re-broadcasts, rechecks and undecodable txs are not limited
+	require.True(t, checkTx("alice 1", abci.CheckTxType_New).IsOK())
+	require.True(t, checkTx("alice 2", abci.CheckTxType_Recheck).IsOK())
+	require.True(t, checkTx("garbage", abci.CheckTxType_New).IsOK())
+
+	require.Equal(t, []string{"alice 1", "alice 1", "alice 2", "garbage"}, checked)
+
+	// an EVM tx is charged once whatever its encoding
+	require.True(t, checkTx("bob 1", abci.CheckTxType_New).IsOK())
+	require.True(t, checkTx("bob 1 re-encoded", abci.CheckTxType_New).IsOK())
+	require.Equal(t, ErrSenderRateLimited.ABCICode(), checkTx("bob 2", abci.CheckTxType_New).Code)
+}
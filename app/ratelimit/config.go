@@ -0,0 +1,184 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// app.toml keys of the CheckTx rate limiter
+const (
+	FlagEnable          = "rate-limit.enable"
+	FlagSenderRate      = "rate-limit.sender-rate"
+	FlagSenderBurst     = "rate-limit.sender-burst"
+	FlagIPRate          = "rate-limit.ip-rate"
+	FlagIPBurst         = "rate-limit.ip-burst"
+	FlagMaxTracked      = "rate-limit.max-tracked"
+	FlagExemptAddresses = "rate-limit.exempt-addresses"
+)
+
+// Config is the node-local configuration of the CheckTx rate limiter. Rates
+// are in txs per second, a zero rate disables the limit.
+type Config struct {
+	// Enable enables the rate limiter.
+	Enable bool `mapstructure:"enable"`
+	// SenderRate is the rate at which each sender may submit new txs.
+	SenderRate float64 `mapstructure:"sender-rate"`
+	// SenderBurst is the number of txs a sender may submit at once.
+	SenderBurst uint64 `mapstructure:"sender-burst"`
+	// IPRate is the rate at which each client IP may broadcast txs through
+	// the gRPC tx service, the REST server and the EVM JSON-RPC HTTP server.
+	IPRate float64 `mapstructure:"ip-rate"`
+	// IPBurst is the number of txs a client IP may broadcast at once.
+	IPBurst uint64 `mapstructure:"ip-burst"`
+	// MaxTracked is the maximum number of senders and of IPs tracked at once.
+	MaxTracked uint64 `mapstructure:"max-tracked"`
+	// ExemptAddresses are the bech32 or hex addresses exempt from the sender
+	// limit, e.g. relayers.
+	ExemptAddresses []string `mapstructure:"exempt-addresses"`
+}
+
+// DefaultConfig returns the default rate limiter configuration, disabled.
+func DefaultConfig() Config {
+	return Config{
+		Enable:          false,
+		SenderRate:      5,
+		SenderBurst:     20,
+		IPRate:          20,
+		IPBurst:         100,
+		MaxTracked:      100_000,
+		ExemptAddresses: []string{},
+	}
+}
+
+// ConfigFromAppOptions reads the rate limiter configuration from app.toml.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+	if appOpts == nil {
+		return cfg, nil
+	}
+
+	var err error
+	if v := appOpts.Get(FlagEnable); v != nil {
+		if cfg.Enable, err = cast.ToBoolE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagEnable, err)
+		}
+	}
+	if v := appOpts.Get(FlagSenderRate); v != nil {
+		if cfg.SenderRate, err = cast.ToFloat64E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagSenderRate, err)
+		}
+	}
+	if v := appOpts.Get(FlagSenderBurst); v != nil {
+		if cfg.SenderBurst, err = cast.ToUint64E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagSenderBurst, err)
+		}
+	}
+	if v := appOpts.Get(FlagIPRate); v != nil {
+		if cfg.IPRate, err = cast.ToFloat64E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagIPRate, err)
+		}
+	}
+	if v := appOpts.Get(FlagIPBurst); v != nil {
+		if cfg.IPBurst, err = cast.ToUint64E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagIPBurst, err)
+		}
+	}
+	if v := appOpts.Get(FlagMaxTracked); v != nil {
+		if cfg.MaxTracked, err = cast.ToUint64E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagMaxTracked, err)
+		}
+	}
+	if v := appOpts.Get(FlagExemptAddresses); v != nil {
+		if cfg.ExemptAddresses, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagExemptAddresses, err)
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// Validate validates the rate limiter configuration.
+func (c Config) Validate() error {
+	if c.SenderRate < 0 {
+		return fmt.Errorf("sender rate cannot be negative: %v", c.SenderRate)
+	}
+	if c.SenderRate > 0 && c.SenderBurst == 0 {
+		return errors.New("sender burst must be positive when the sender rate is set")
+	}
+	if c.IPRate < 0 {
+		return fmt.Errorf("IP rate cannot be negative: %v", c.IPRate)
+	}
+	if c.IPRate > 0 && c.IPBurst == 0 {
+		return errors.New("IP burst must be positive when the IP rate is set")
+	}
+	if c.MaxTracked == 0 {
+		return errors.New("max tracked must be positive")
+	}
+	_, err := c.exemptAddresses()
+	return err
+}
+
+// exemptAddresses returns the set of exempt addresses, keyed by their bytes.
+func (c Config) exemptAddresses() (map[string]struct{}, error) {
+	exempt := make(map[string]struct{}, len(c.ExemptAddresses))
+	for _, addr := range c.ExemptAddresses {
+		addr = strings.TrimSpace(addr)
+		if common.IsHexAddress(addr) {
+			exempt[string(common.HexToAddress(addr).Bytes())] = struct{}{}
+			continue
+		}
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exempt address %s: %w", addr, err)
+		}
+		exempt[string(accAddr)] = struct{}{}
+	}
+	return exempt, nil
+}
+
+// DefaultConfigTemplate is the app.toml template of the rate limiter
+// configuration.
+const DefaultConfigTemplate = `
+###############################################################################
+###                       CheckTx Rate Limit Configuration                  ###
+###############################################################################
+
+# The rate limiter is node-local: it only limits the txs this node accepts in
+# its mempool and broadcasts, never the txs of proposed blocks.
+[rate-limit]
+
+# Enable enables the rate limiter.
+enable = {{ .RateLimit.Enable }}
+
+# SenderRate is the rate, in txs per second, at which each EVM or cosmos sender may
+# submit new txs in CheckTx, whatever the peer or client they come from. 0 disables the limit.
+sender-rate = {{ .RateLimit.SenderRate }}
+
+# SenderBurst is the number of new txs a sender may submit at once.
+sender-burst = {{ .RateLimit.SenderBurst }}
+
+# IPRate is the rate, in txs per second, at which each client IP may broadcast txs through
+# the gRPC tx service, the REST server and the EVM JSON-RPC HTTP server, each call of a
+# JSON-RPC batch counting. The client IP is the address of the connection: behind a proxy,
+# all its clients share its IP. The EVM JSON-RPC websocket server and the CometBFT RPC are
+# not limited and should not be exposed, and the txs gossiped by peers are not either.
+# 0 disables the limit.
+ip-rate = {{ .RateLimit.IPRate }}
+
+# IPBurst is the number of txs a client IP may broadcast at once.
+ip-burst = {{ .RateLimit.IPBurst }}
+
+# MaxTracked is the maximum number of senders and of IPs tracked at once. Untracked
+# senders and IPs are not limited until idle ones are forgotten.
+max-tracked = {{ .RateLimit.MaxTracked }}
+
+# ExemptAddresses are the bech32 or 0x hex addresses exempt from the sender limit, e.g. relayers.
+exempt-addresses = [{{ range $i, $addr := .RateLimit.ExemptAddresses }}{{ if $i }}, {{ end }}"{{ $addr }}"{{ end }}]
+`
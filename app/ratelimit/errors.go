@@ -0,0 +1,14 @@
+package ratelimit
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Codespace is the codespace of the rate limiter errors.
+const Codespace = "ratelimit"
+
+// rate limiter errors
+var (
+	ErrSenderRateLimited = errorsmod.Register(Codespace, 2, "sender rate limit exceeded")
+	ErrIPRateLimited     = errorsmod.Register(Codespace, 3, "client IP rate limit exceeded")
+)
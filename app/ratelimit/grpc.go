@@ -0,0 +1,56 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+type txServer struct {
+	txtypes.ServiceServer
+
+	limiter *Limiter
+}
+
+// NewTxServer wraps the tx service, rejecting the broadcasts of client IPs
+// exceeding their rate with ErrIPRateLimited.
+func NewTxServer(server txtypes.ServiceServer, limiter *Limiter) txtypes.ServiceServer {
+	return txServer{ServiceServer: server, limiter: limiter}
+}
+
+// BroadcastTx implements the ServiceServer.BroadcastTx RPC method.
+func (s txServer) BroadcastTx(ctx context.Context, req *txtypes.BroadcastTxRequest) (*txtypes.BroadcastTxResponse, error) {
+	if ip, ok := clientIP(ctx); ok && !s.limiter.AllowIP(ip) {
+		return nil, status.Error(codes.ResourceExhausted, errorsmod.Wrapf(ErrIPRateLimited, "client %s", ip).Error())
+	}
+	return s.ServiceServer.BroadcastTx(ctx, req)
+}
+
+// clientIP returns the IP of the gRPC client.
+func clientIP(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "", false
+	}
+	return parseIP(p.Addr.String())
+}
+
+// parseIP returns the IP of a host:port or host address.
+func parseIP(addr string) (string, bool) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return "", false
+	}
+	return ip.String(), true
+}
@@ -0,0 +1,48 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+type mockTxServer struct {
+	txtypes.ServiceServer
+	broadcasts int
+}
+
+func (s *mockTxServer) BroadcastTx(context.Context, *txtypes.BroadcastTxRequest) (*txtypes.BroadcastTxResponse, error) {
+	s.broadcasts++
+	return &txtypes.BroadcastTxResponse{}, nil
+}
+
+func TestTxServer(t *testing.T) {
+	limiter := newIPTestLimiter(t, 1)
+	next := &mockTxServer{}
+	server := NewTxServer(next, limiter)
+
+	withPeer := func(addr string) context.Context {
+		tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+		require.NoError(t, err)
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: tcpAddr})
+	}
+
+	_, err := server.BroadcastTx(withPeer("10.0.0.1:1234"), &txtypes.BroadcastTxRequest{})
+	require.NoError(t, err)
+	_, err = server.BroadcastTx(withPeer("10.0.0.1:5678"), &txtypes.BroadcastTxRequest{})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// in-process calls have no peer and are not limited
+	for range 3 {
+		_, err = server.BroadcastTx(context.Background(), &txtypes.BroadcastTxRequest{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 4, next.broadcasts)
+}
@@ -0,0 +1,32 @@
+package ratelimit
+
+import (
+	"net/http"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// restBroadcastPath is the REST route of the tx service BroadcastTx method.
+const restBroadcastPath = "/cosmos/tx/v1beta1/txs"
+
+// NewRESTMiddleware returns a middleware of the REST server rejecting the tx
+// broadcasts of client IPs exceeding their rate with 429 Too Many Requests.
+func NewRESTMiddleware(limiter *Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost && r.URL.Path == restBroadcastPath {
+				if ip, ok := remoteIP(r); ok && !limiter.AllowIP(ip) {
+					http.Error(w, errorsmod.Wrapf(ErrIPRateLimited, "client %s", ip).Error(), http.StatusTooManyRequests)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// remoteIP returns the IP of the HTTP client. Forwarding headers are not
+// trusted.
+func remoteIP(r *http.Request) (string, bool) {
+	return parseIP(r.RemoteAddr)
+}
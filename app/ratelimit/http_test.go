@@ -0,0 +1,41 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newIPTestLimiter(t *testing.T, burst uint64) *Limiter {
+	t.Helper()
+	cfg := DefaultConfig()
+	cfg.IPRate = 1
+	cfg.IPBurst = burst
+	limiter, _ := newTestLimiter(t, cfg)
+	return limiter
+}
+
+func TestRESTMiddleware(t *testing.T) {
+	limiter := newIPTestLimiter(t, 1)
+	handler := NewRESTMiddleware(limiter)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(method, path, remoteAddr string) int {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusOK, serve(http.MethodPost, restBroadcastPath, "10.0.0.1:1234"))
+	require.Equal(t, http.StatusTooManyRequests, serve(http.MethodPost, restBroadcastPath, "10.0.0.1:5678"))
+	require.Equal(t, http.StatusOK, serve(http.MethodPost, restBroadcastPath, "10.0.0.2:1234"))
+
+	// queries are not limited
+	require.Equal(t, http.StatusOK, serve(http.MethodGet, restBroadcastPath+"/ABCD", "10.0.0.1:1234"))
+	require.Equal(t, http.StatusOK, serve(http.MethodPost, "/cosmos/tx/v1beta1/simulate", "10.0.0.1:1234"))
+}
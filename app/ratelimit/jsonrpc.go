@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	errorsmod "cosmossdk.io/errors"
+
+	evmvmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// JSONRPCNamespace is the EVM JSON-RPC namespace of the rate limiter. Its API
+// overrides the broadcast methods of the eth namespace, so it is enabled
+// after it.
+const JSONRPCNamespace = "ratelimit"
+
+// jsonRPCLimitExceeded is the EIP-1474 JSON-RPC error code of exceeded
+// limits.
+const jsonRPCLimitExceeded = -32005
+
+// EthBroadcaster is the part of the eth JSON-RPC API broadcasting txs.
+type EthBroadcaster interface {
+	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendTransaction(args evmvmtypes.TransactionArgs) (common.Hash, error)
+}
+
+// EthAPI is the API of the eth JSON-RPC namespace rejecting the
+// eth_sendRawTransaction and eth_sendTransaction calls of client IPs
+// exceeding their rate with ErrIPRateLimited. Each call of a batch takes a
+// token.
+type EthAPI struct {
+	limiter *Limiter
+	next    EthBroadcaster
+}
+
+// NewEthAPI returns the eth API broadcasting the txs the limiter allows with
+// next.
+func NewEthAPI(limiter *Limiter, next EthBroadcaster) *EthAPI {
+	return &EthAPI{limiter: limiter, next: next}
+}
+
+// SendRawTransaction implements the eth_sendRawTransaction method.
+func (api *EthAPI) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error) {
+	if err := api.allow(ctx); err != nil {
+		return common.Hash{}, err
+	}
+	return api.next.SendRawTransaction(data)
+}
+
+// SendTransaction implements the eth_sendTransaction method.
+func (api *EthAPI) SendTransaction(ctx context.Context, args evmvmtypes.TransactionArgs) (common.Hash, error) {
+	if err := api.allow(ctx); err != nil {
+		return common.Hash{}, err
+	}
+	return api.next.SendTransaction(args)
+}
+
+// allow takes a token of the client IP of the call, the address of its
+// connection.
+func (api *EthAPI) allow(ctx context.Context) error {
+	ip, ok := parseIP(ethrpc.PeerInfoFromContext(ctx).RemoteAddr)
+	if ok && !api.limiter.AllowIP(ip) {
+		return limitExceededError{errorsmod.Wrapf(ErrIPRateLimited, "client %s", ip)}
+	}
+	return nil
+}
+
+// limitExceededError is a JSON-RPC error of exceeded limits.
+type limitExceededError struct {
+	error
+}
+
+// ErrorCode implements the go-ethereum rpc.Error interface.
+func (limitExceededError) ErrorCode() int { return jsonRPCLimitExceeded }
@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	evmvmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// ethAPI is the eth JSON-RPC API the limited one broadcasts with.
+type ethAPI struct{}
+
+func (ethAPI) BlockNumber() hexutil.Uint64 { return 1 }
+
+func (ethAPI) SendRawTransaction(hexutil.Bytes) (common.Hash, error) {
+	return common.Hash{1}, nil
+}
+
+func (ethAPI) SendTransaction(evmvmtypes.TransactionArgs) (common.Hash, error) {
+	return common.Hash{2}, nil
+}
+
+func TestEthAPI(t *testing.T) {
+	limiter := newIPTestLimiter(t, 2)
+
+	// the limited API overrides the broadcast methods of the eth namespace
+	rpcServer := ethrpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("eth", ethAPI{}))
+	require.NoError(t, rpcServer.RegisterName("eth", NewEthAPI(limiter, ethAPI{})))
+	httpServer := httptest.NewServer(rpcServer)
+	defer httpServer.Close()
+	client, err := ethrpc.DialHTTP(httpServer.URL)
+	require.NoError(t, err)
+	defer client.Close()
+
+	var hash common.Hash
+	require.NoError(t, client.Call(&hash, "eth_sendRawTransaction", hexutil.Bytes{0}))
+	require.Equal(t, common.Hash{1}, hash)
+	require.NoError(t, client.Call(&hash, "eth_sendTransaction", evmvmtypes.TransactionArgs{}))
+	require.Equal(t, common.Hash{2}, hash)
+
+	err = client.Call(&hash, "eth_sendRawTransaction", hexutil.Bytes{0})
+	var rpcErr ethrpc.Error
+	require.True(t, errors.As(err, &rpcErr))
+	require.Equal(t, jsonRPCLimitExceeded, rpcErr.ErrorCode())
+	require.ErrorContains(t, err, "127.0.0.1")
+
+	// each call of a batch takes a token
+	batch := []ethrpc.BatchElem{{Method: "eth_sendTransaction", Args: []any{evmvmtypes.TransactionArgs{}}, Result: &hash}}
+	require.NoError(t, client.BatchCall(batch))
+	require.ErrorAs(t, batch[0].Error, &rpcErr)
+
+	// the other methods are not limited
+	var height hexutil.Uint64
+	require.NoError(t, client.Call(&height, "eth_blockNumber"))
+	require.Equal(t, hexutil.Uint64(1), height)
+}
@@ -0,0 +1,191 @@
+package ratelimit
+
+import (
+	"crypto/sha256"
+	"sync"
+	"time"
+)
+
+// bucket is a token bucket holding up to burst tokens, refilled at rate
+// tokens per second.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// buckets are the token buckets of one dimension, sender or IP.
+type buckets struct {
+	rate       float64
+	burst      float64
+	maxTracked int
+	buckets    map[string]*bucket
+}
+
+func newBuckets(rate float64, burst, maxTracked uint64) *buckets {
+	return &buckets{
+		rate:       rate,
+		burst:      float64(burst),
+		maxTracked: int(maxTracked), // #nosec G115 -- bounded by memory anyway
+		buckets:    make(map[string]*bucket),
+	}
+}
+
+// take takes a token of the bucket of key, and returns false if it is empty.
+func (b *buckets) take(key string, now time.Time) bool {
+	if b.rate == 0 {
+		return true
+	}
+
+	bk, ok := b.buckets[key]
+	if !ok {
+		if len(b.buckets) >= b.maxTracked {
+			b.prune(now)
+			// every tracked key is still limited: don't track one more
+			if len(b.buckets) >= b.maxTracked {
+				return true
+			}
+		}
+		bk = &bucket{tokens: b.burst, last: now}
+		b.buckets[key] = bk
+	}
+
+	if elapsed := now.Sub(bk.last).Seconds(); elapsed > 0 {
+		bk.tokens = min(b.burst, bk.tokens+elapsed*b.rate)
+		bk.last = now
+	}
+	if bk.tokens < 1 {
+		return false
+	}
+	bk.tokens--
+	return true
+}
+
+// prune forgets the buckets refilled to burst, they behave as new ones.
+func (b *buckets) prune(now time.Time) {
+	for key, bk := range b.buckets {
+		if bk.tokens+now.Sub(bk.last).Seconds()*b.rate >= b.burst {
+			delete(b.buckets, key)
+		}
+	}
+}
+
+// chargedTxsCap is the number of recently charged txs remembered, so that
+// txs re-broadcast by the node itself, e.g. queued EVM txs once their nonce
+// gap is filled, are not charged twice.
+const chargedTxsCap = 10_000
+
+// chargedTxs is a bounded FIFO set of the hashes of charged txs.
+type chargedTxs struct {
+	hashes map[[sha256.Size]byte]struct{}
+	order  [][sha256.Size]byte
+	next   int
+}
+
+func newChargedTxs() *chargedTxs {
+	return &chargedTxs{
+		hashes: make(map[[sha256.Size]byte]struct{}, chargedTxsCap),
+		order:  make([][sha256.Size]byte, 0, chargedTxsCap),
+	}
+}
+
+func (c *chargedTxs) has(hash [sha256.Size]byte) bool {
+	_, ok := c.hashes[hash]
+	return ok
+}
+
+func (c *chargedTxs) add(hash [sha256.Size]byte) {
+	if len(c.order) < chargedTxsCap {
+		c.order = append(c.order, hash)
+	} else {
+		delete(c.hashes, c.order[c.next])
+		c.order[c.next] = hash
+		c.next = (c.next + 1) % chargedTxsCap
+	}
+	c.hashes[hash] = struct{}{}
+}
+
+// Limiter limits the rate of txs per sender and per client IP with token
+// buckets. It is safe for concurrent use.
+type Limiter struct {
+	mu      sync.Mutex
+	senders *buckets
+	ips     *buckets
+	charged *chargedTxs
+	exempt  map[string]struct{}
+	now     func() time.Time
+}
+
+// NewLimiter creates a new Limiter from a validated config.
+func NewLimiter(cfg Config) (*Limiter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	exempt, err := cfg.exemptAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Limiter{
+		senders: newBuckets(cfg.SenderRate, cfg.SenderBurst, cfg.MaxTracked),
+		ips:     newBuckets(cfg.IPRate, cfg.IPBurst, cfg.MaxTracked),
+		charged: newChargedTxs(),
+		exempt:  exempt,
+		now:     time.Now,
+	}, nil
+}
+
+// AllowTx takes a token of each distinct sender of the tx identified by
+// txKey, and returns the first sender that exceeded its rate, if any. No
+// token is taken if a sender exceeded its rate, nor if the tx was recently
+// allowed.
+func (l *Limiter) AllowTx(txKey []byte, senders [][]byte) ([]byte, bool) {
+	hash := sha256.Sum256(txKey)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.charged.has(hash) {
+		return nil, true
+	}
+
+	now := l.now()
+	limited := make([][]byte, 0, len(senders))
+	for _, sender := range uniqueSenders(senders) {
+		if _, ok := l.exempt[string(sender)]; ok {
+			continue
+		}
+		if !l.senders.take(string(sender), now) {
+			// give back the tokens taken
+			for _, taken := range limited {
+				if bk, ok := l.senders.buckets[string(taken)]; ok {
+					bk.tokens = min(l.senders.burst, bk.tokens+1)
+				}
+			}
+			return sender, false
+		}
+		limited = append(limited, sender)
+	}
+	l.charged.add(hash)
+	return nil, true
+}
+
+// AllowIP takes a token of the client IP, and returns false if the IP
+// exceeded its rate.
+func (l *Limiter) AllowIP(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.ips.take(ip, l.now())
+}
+
+func uniqueSenders(signers [][]byte) [][]byte {
+	seen := make(map[string]struct{}, len(signers))
+	senders := make([][]byte, 0, len(signers))
+	for _, signer := range signers {
+		if _, ok := seen[string(signer)]; ok {
+			continue
+		}
+		seen[string(signer)] = struct{}{}
+		senders = append(senders, signer)
+	}
+	return senders
+}
@@ -0,0 +1,144 @@
+package ratelimit
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newTestLimiter(t *testing.T, cfg Config) (*Limiter, *time.Time) {
+	t.Helper()
+	limiter, err := NewLimiter(cfg)
+	require.NoError(t, err)
+	now := time.Unix(1_700_000_000, 0)
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func TestAllowTx(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SenderRate = 2
+	cfg.SenderBurst = 3
+	exempt := sdk.AccAddress("exempt______________")
+	cfg.ExemptAddresses = []string{exempt.String()}
+	limiter, now := newTestLimiter(t, cfg)
+
+	alice := sdk.AccAddress("alice")
+	bob := sdk.AccAddress("bob")
+	tx := func(i int) []byte { return []byte{byte(i)} }
+
+	// the burst is allowed at once
+	for i := range 3 {
+		_, ok := limiter.AllowTx(tx(i), [][]byte{alice})
+		require.True(t, ok)
+	}
+	sender, ok := limiter.AllowTx(tx(3), [][]byte{alice})
+	require.False(t, ok)
+	require.Equal(t, []byte(alice), sender)
+
+	// a recently allowed tx is not charged again
+	_, ok = limiter.AllowTx(tx(0), [][]byte{alice})
+	require.True(t, ok)
+
+	// other and exempt senders are not limited
+	_, ok = limiter.AllowTx(tx(4), [][]byte{bob})
+	require.True(t, ok)
+	for i := range 10 {
+		_, ok = limiter.AllowTx(tx(10+i), [][]byte{exempt})
+		require.True(t, ok)
+	}
+
+	// a tx of a limited sender doesn't take the tokens of its other senders
+	_, ok = limiter.AllowTx(tx(5), [][]byte{bob, alice})
+	require.False(t, ok)
+	require.Equal(t, 2.0, limiter.senders.buckets[string(bob)].tokens)
+
+	// tokens are refilled at the sender rate
+	*now = now.Add(500 * time.Millisecond)
+	_, ok = limiter.AllowTx(tx(6), [][]byte{alice})
+	require.True(t, ok)
+	_, ok = limiter.AllowTx(tx(7), [][]byte{alice})
+	require.False(t, ok)
+}
+
+func TestAllowIP(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.IPRate = 1
+	cfg.IPBurst = 2
+	cfg.MaxTracked = 2
+	limiter, now := newTestLimiter(t, cfg)
+
+	require.True(t, limiter.AllowIP("10.0.0.1"))
+	require.True(t, limiter.AllowIP("10.0.0.1"))
+	require.False(t, limiter.AllowIP("10.0.0.1"))
+	require.True(t, limiter.AllowIP("10.0.0.2"))
+
+	// no more IPs are tracked while the tracked ones are limited
+	for range 5 {
+		require.True(t, limiter.AllowIP("10.0.0.3"))
+	}
+	require.Len(t, limiter.ips.buckets, 2)
+
+	// refilled buckets are forgotten to track new IPs
+	*now = now.Add(2 * time.Second)
+	require.True(t, limiter.AllowIP("10.0.0.3"))
+	require.True(t, limiter.AllowIP("10.0.0.3"))
+	require.False(t, limiter.AllowIP("10.0.0.3"))
+	require.Len(t, limiter.ips.buckets, 1)
+}
+
+func TestConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*Config)
+		success  bool
+	}{
+		{"default", func(*Config) {}, true},
+		{"limits disabled", func(c *Config) { c.SenderRate, c.SenderBurst, c.IPRate, c.IPBurst = 0, 0, 0, 0 }, true},
+		{"hex exempt address", func(c *Config) { c.ExemptAddresses = []string{"0x1111111111111111111111111111111111111111"} }, true},
+		{"negative sender rate", func(c *Config) { c.SenderRate = -1 }, false},
+		{"zero sender burst", func(c *Config) { c.SenderBurst = 0 }, false},
+		{"negative IP rate", func(c *Config) { c.IPRate = -1 }, false},
+		{"zero IP burst", func(c *Config) { c.IPBurst = 0 }, false},
+		{"zero max tracked", func(c *Config) { c.MaxTracked = 0 }, false},
+		{"invalid exempt address", func(c *Config) { c.ExemptAddresses = []string{"tac1invalid"} }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tc.malleate(&cfg)
+			err := cfg.Validate()
+			if tc.success {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestConfigTemplate(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Enable = true
+	cfg.SenderRate = 0.5
+	cfg.ExemptAddresses = []string{"0x1111111111111111111111111111111111111111", sdk.AccAddress("relayer").String()}
+
+	tmpl, err := template.New("app").Parse(DefaultConfigTemplate)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, struct{ RateLimit Config }{cfg}))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+	parsed, err := ConfigFromAppOptions(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg, parsed)
+}
@@ -0,0 +1,306 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	txpolicytypes "github.com/TacBuild/tacchain/x/txpolicy/types"
+)
+
+// EventTypeRelayRefund is emitted when the fee of a tx relaying IBC client
+// updates and packets first is refunded.
+const EventTypeRelayRefund = "relay_refund"
+
+// RelayChannelKeeper defines the IBC channel keeper methods telling whether a
+// packet was already relayed.
+type RelayChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+	GetRecvStartSequence(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceRecv(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetPacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) (string, bool)
+	HasPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) bool
+}
+
+// RelayChannelKeeperV2 defines the IBC v2 channel keeper methods telling
+// whether a packet was already relayed.
+type RelayChannelKeeperV2 interface {
+	HasPacketReceipt(ctx sdk.Context, clientID string, sequence uint64) bool
+	GetPacketCommitment(ctx sdk.Context, clientID string, sequence uint64) []byte
+}
+
+// RelayClientKeeper defines the IBC client keeper methods telling whether a
+// client was updated.
+type RelayClientKeeper interface {
+	GetClientLatestHeight(ctx sdk.Context, clientID string) clienttypes.Height
+	GetClientStatus(ctx sdk.Context, clientID string) exported.Status
+}
+
+// RelayRefundKeeper defines the txpolicy keeper methods capping the relay
+// refunds of a block.
+type RelayRefundKeeper interface {
+	RemainingRelayRefunds(ctx context.Context) (math.Int, error)
+	AddRelayRefund(ctx context.Context, amount math.Int) error
+}
+
+// relayWorkKey is the context key of the relayWork of a tx.
+type relayWorkKey struct{}
+
+// relayWork is the IBC state a relay tx found before its msgs ran.
+type relayWork struct {
+	packets int
+	// redundantPackets is true if a packet of the tx was already relayed
+	redundantPackets bool
+	// clients are the states of the clients the tx updates
+	clients map[string]relayClientState
+}
+
+type relayClientState struct {
+	height clienttypes.Height
+	status exported.Status
+}
+
+// RelayWorkDecorator records, before the msgs of a tx made only of IBC client
+// update, recv, acknowledgement and timeout msgs run, whether its packets were
+// already relayed and the state of the clients it updates, for the
+// RelayRefundDecorator to refund the relays that did useful work. It only
+// runs during block execution, while relay refunds are enabled.
+type RelayWorkDecorator struct {
+	channelKeeper   RelayChannelKeeper
+	channelKeeperV2 RelayChannelKeeperV2
+	clientKeeper    RelayClientKeeper
+	txPolicyParams  *txpolicytypes.Params
+}
+
+// NewRelayWorkDecorator creates a RelayWorkDecorator.
+func NewRelayWorkDecorator(
+	channelKeeper RelayChannelKeeper,
+	channelKeeperV2 RelayChannelKeeperV2,
+	clientKeeper RelayClientKeeper,
+	txPolicyParams *txpolicytypes.Params,
+) RelayWorkDecorator {
+	return RelayWorkDecorator{
+		channelKeeper:   channelKeeper,
+		channelKeeperV2: channelKeeperV2,
+		clientKeeper:    clientKeeper,
+		txPolicyParams:  txPolicyParams,
+	}
+}
+
+func (d RelayWorkDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if simulate || ctx.IsCheckTx() || !d.txPolicyParams.MaxRelayRefundPerBlock.IsPositive() {
+		return next(ctx, tx, simulate)
+	}
+
+	// the bookkeeping of the refund is not charged to the tx
+	work, ok := d.relayWork(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), tx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+	return next(ctx.WithValue(relayWorkKey{}, work), tx, simulate)
+}
+
+// relayWork returns the relay work of the tx, and false if the tx has msgs
+// other than IBC relay msgs.
+func (d RelayWorkDecorator) relayWork(ctx sdk.Context, tx sdk.Tx) (*relayWork, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, false
+	}
+
+	work := &relayWork{clients: make(map[string]relayClientState)}
+	// the packets relayed by the previous msgs of the tx
+	relayed := make(map[string]bool)
+	for _, msg := range msgs {
+		var (
+			packet  string
+			pending bool
+		)
+		switch msg := msg.(type) {
+		case *clienttypes.MsgUpdateClient:
+			work.clients[msg.ClientId] = relayClientState{
+				height: d.clientKeeper.GetClientLatestHeight(ctx, msg.ClientId),
+				status: d.clientKeeper.GetClientStatus(ctx, msg.ClientId),
+			}
+			continue
+		case *channeltypes.MsgRecvPacket:
+			p := msg.Packet
+			packet = fmt.Sprintf("recv/%s/%s/%d", p.DestinationPort, p.DestinationChannel, p.Sequence)
+			pending = d.isPacketPendingRecv(ctx, p)
+		case *channeltypes.MsgAcknowledgement:
+			p := msg.Packet
+			packet = fmt.Sprintf("commitment/%s/%s/%d", p.SourcePort, p.SourceChannel, p.Sequence)
+			pending = d.channelKeeper.HasPacketCommitment(ctx, p.SourcePort, p.SourceChannel, p.Sequence)
+		case *channeltypes.MsgTimeout:
+			p := msg.Packet
+			packet = fmt.Sprintf("commitment/%s/%s/%d", p.SourcePort, p.SourceChannel, p.Sequence)
+			pending = d.channelKeeper.HasPacketCommitment(ctx, p.SourcePort, p.SourceChannel, p.Sequence)
+		case *channeltypes.MsgTimeoutOnClose:
+			p := msg.Packet
+			packet = fmt.Sprintf("commitment/%s/%s/%d", p.SourcePort, p.SourceChannel, p.Sequence)
+			pending = d.channelKeeper.HasPacketCommitment(ctx, p.SourcePort, p.SourceChannel, p.Sequence)
+		case *channeltypesv2.MsgRecvPacket:
+			p := msg.Packet
+			packet = fmt.Sprintf("v2/recv/%s/%d", p.DestinationClient, p.Sequence)
+			pending = !d.channelKeeperV2.HasPacketReceipt(ctx, p.DestinationClient, p.Sequence)
+		case *channeltypesv2.MsgAcknowledgement:
+			p := msg.Packet
+			packet = fmt.Sprintf("v2/commitment/%s/%d", p.SourceClient, p.Sequence)
+			pending = len(d.channelKeeperV2.GetPacketCommitment(ctx, p.SourceClient, p.Sequence)) > 0
+		case *channeltypesv2.MsgTimeout:
+			p := msg.Packet
+			packet = fmt.Sprintf("v2/commitment/%s/%d", p.SourceClient, p.Sequence)
+			pending = len(d.channelKeeperV2.GetPacketCommitment(ctx, p.SourceClient, p.Sequence)) > 0
+		default:
+			return nil, false
+		}
+
+		work.packets++
+		if !pending || relayed[packet] {
+			work.redundantPackets = true
+		}
+		relayed[packet] = true
+	}
+	return work, true
+}
+
+// isPacketPendingRecv reports whether a packet wasn't received yet, following
+// the replay protection of the IBC channel keeper.
+func (d RelayWorkDecorator) isPacketPendingRecv(ctx sdk.Context, packet channeltypes.Packet) bool {
+	channel, found := d.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		return false
+	}
+	if recvStartSequence, _ := d.channelKeeper.GetRecvStartSequence(ctx, packet.DestinationPort, packet.DestinationChannel); packet.Sequence < recvStartSequence {
+		return false
+	}
+
+	if channel.Ordering == channeltypes.ORDERED {
+		nextSequenceRecv, found := d.channelKeeper.GetNextSequenceRecv(ctx, packet.DestinationPort, packet.DestinationChannel)
+		return found && packet.Sequence >= nextSequenceRecv
+	}
+	_, found = d.channelKeeper.GetPacketReceipt(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	return !found
+}
+
+// RelayRefundDecorator refunds the fee of the successful IBC relay txs that
+// did useful work, recorded by the RelayWorkDecorator, from the fee collector
+// to the account the fee was deducted from. A relay tx did useful work if
+// none of its packets was already relayed and, for a tx without packets, if
+// it advanced or froze a client. Only the fees paid in the EVM denom are
+// refunded, up to the max_relay_refund_per_block txpolicy param per block.
+//
+// The refund is the share of the fee paid for the gas used, as the
+// GasRefundDecorator refunds the unused share. The gas used is the gas the
+// GasRefundDecorator charges, at least the feemarket MinGasMultiplier share
+// of the gas wanted.
+type RelayRefundDecorator struct {
+	bankKeeper       GasRefundBankKeeper
+	txPolicyKeeper   RelayRefundKeeper
+	clientKeeper     RelayClientKeeper
+	newFeeChecker    func(ctx sdk.Context) authante.TxFeeChecker
+	minGasMultiplier func(ctx sdk.Context) math.LegacyDec
+}
+
+// NewRelayRefundDecorator creates a RelayRefundDecorator. newFeeChecker must
+// return the fee checker the ante handler deducted the fee with, and
+// minGasMultiplier the feemarket MinGasMultiplier.
+func NewRelayRefundDecorator(
+	bk GasRefundBankKeeper,
+	txPolicyKeeper RelayRefundKeeper,
+	clientKeeper RelayClientKeeper,
+	newFeeChecker func(ctx sdk.Context) authante.TxFeeChecker,
+	minGasMultiplier func(ctx sdk.Context) math.LegacyDec,
+) RelayRefundDecorator {
+	return RelayRefundDecorator{
+		bankKeeper:       bk,
+		txPolicyKeeper:   txPolicyKeeper,
+		clientKeeper:     clientKeeper,
+		newFeeChecker:    newFeeChecker,
+		minGasMultiplier: minGasMultiplier,
+	}
+}
+
+// PostHandle implements sdk.PostDecorator.
+func (d RelayRefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if simulate || !success || ctx.IsCheckTx() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// the refund is not charged to the tx, which may have no gas left for it
+	refundCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	work, ok := ctx.Value(relayWorkKey{}).(*relayWork)
+	if !ok || !d.isUsefulWork(refundCtx, work) {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || !isEVMDenomFee(feeTx.GetFee()) {
+		return next(ctx, tx, simulate, success)
+	}
+
+	fee, _, err := d.newFeeChecker(ctx)(ctx, tx)
+	if err != nil {
+		return ctx, err
+	}
+	gasWanted := feeTx.GetGas()
+	if gasUsed := effectiveGasUsed(ctx, gasWanted, d.minGasMultiplier(ctx)); gasUsed < gasWanted {
+		fee = fee.Sub(refundForUnusedGas(fee, gasWanted, gasUsed)...)
+	}
+
+	remaining, err := d.txPolicyKeeper.RemainingRelayRefunds(refundCtx)
+	if err != nil {
+		return ctx, err
+	}
+	amount := math.MinInt(fee.AmountOf(evmtypes.GetEVMCoinDenom()), remaining)
+	if !amount.IsPositive() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	refund := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amount))
+	recipient := feeRefundRecipient(feeTx)
+	if err := d.bankKeeper.SendCoinsFromModuleToAccount(refundCtx, authtypes.FeeCollectorName, recipient, refund); err != nil {
+		return ctx, err
+	}
+	if err := d.txPolicyKeeper.AddRelayRefund(refundCtx, amount); err != nil {
+		return ctx, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeRelayRefund,
+			sdk.NewAttribute(AttributeKeyRefundRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, refund.String()),
+		),
+	)
+
+	return next(ctx, tx, simulate, success)
+}
+
+// isUsefulWork reports whether a successful relay tx did useful work.
+func (d RelayRefundDecorator) isUsefulWork(ctx sdk.Context, work *relayWork) bool {
+	if work.packets > 0 {
+		return !work.redundantPackets
+	}
+	for clientID, before := range work.clients {
+		if d.clientKeeper.GetClientLatestHeight(ctx, clientID).GT(before.height) ||
+			d.clientKeeper.GetClientStatus(ctx, clientID) != before.status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,317 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	appconfig "github.com/TacBuild/tacchain/app/config"
+	txpolicytypes "github.com/TacBuild/tacchain/x/txpolicy/types"
+)
+
+// relayIBCKeeper mocks the IBC channel, channel v2 and client keepers.
+type relayIBCKeeper struct {
+	channels         map[string]channeltypes.Channel
+	nextSequenceRecv uint64
+	receipts         map[string]bool
+	commitments      map[string]bool
+	clientHeights    map[string]clienttypes.Height
+}
+
+func (k *relayIBCKeeper) GetChannel(_ sdk.Context, portID, channelID string) (channeltypes.Channel, bool) {
+	channel, found := k.channels[portID+"/"+channelID]
+	return channel, found
+}
+
+func (k *relayIBCKeeper) GetRecvStartSequence(sdk.Context, string, string) (uint64, bool) {
+	return 0, false
+}
+
+func (k *relayIBCKeeper) GetNextSequenceRecv(sdk.Context, string, string) (uint64, bool) {
+	return k.nextSequenceRecv, true
+}
+
+func (k *relayIBCKeeper) GetPacketReceipt(_ sdk.Context, portID, channelID string, sequence uint64) (string, bool) {
+	return "", k.receipts[fmt.Sprintf("%s/%s/%d", portID, channelID, sequence)]
+}
+
+func (k *relayIBCKeeper) HasPacketCommitment(_ sdk.Context, portID, channelID string, sequence uint64) bool {
+	return k.commitments[fmt.Sprintf("%s/%s/%d", portID, channelID, sequence)]
+}
+
+func (k *relayIBCKeeper) HasPacketReceipt(_ sdk.Context, clientID string, sequence uint64) bool {
+	return k.receipts[fmt.Sprintf("%s/%d", clientID, sequence)]
+}
+
+func (k *relayIBCKeeper) GetPacketCommitment(_ sdk.Context, clientID string, sequence uint64) []byte {
+	if k.commitments[fmt.Sprintf("%s/%d", clientID, sequence)] {
+		return []byte{1}
+	}
+	return nil
+}
+
+func (k *relayIBCKeeper) GetClientLatestHeight(_ sdk.Context, clientID string) clienttypes.Height {
+	return k.clientHeights[clientID]
+}
+
+func (k *relayIBCKeeper) GetClientStatus(sdk.Context, string) exported.Status {
+	return exported.Active
+}
+
+type relayRefundKeeper struct {
+	remaining math.Int
+	paid      math.Int
+}
+
+func (k *relayRefundKeeper) RemainingRelayRefunds(context.Context) (math.Int, error) {
+	return k.remaining, nil
+}
+
+func (k *relayRefundKeeper) AddRelayRefund(_ context.Context, amount math.Int) error {
+	k.paid = k.paid.Add(amount)
+	return nil
+}
+
+func TestRelayRefund(t *testing.T) {
+	// the same fallback the EVM keeper sets
+	evmtypes.SetDefaultEvmCoinInfo(evmtypes.EvmCoinInfo{
+		Denom:         appconfig.BaseDenom,
+		ExtendedDenom: appconfig.BaseDenom,
+		DisplayDenom:  appconfig.DisplayDenom,
+		Decimals:      evmtypes.EighteenDecimals.Uint32(),
+	})
+
+	const clientID = "07-tendermint-0"
+	relayer := sdk.AccAddress("relayer")
+	fee := sdk.NewCoins(sdk.NewInt64Coin("utac", 2_000))
+
+	updateClient := &clienttypes.MsgUpdateClient{ClientId: clientID}
+	recv := func(port, channel string, sequence uint64) sdk.Msg {
+		return &channeltypes.MsgRecvPacket{Packet: channeltypes.Packet{Sequence: sequence, DestinationPort: port, DestinationChannel: channel}}
+	}
+	ack := func(sequence uint64) sdk.Msg {
+		return &channeltypes.MsgAcknowledgement{Packet: channeltypes.Packet{Sequence: sequence, SourcePort: "transfer", SourceChannel: "channel-0"}}
+	}
+	timeout := func(sequence uint64) sdk.Msg {
+		return &channeltypes.MsgTimeout{Packet: channeltypes.Packet{Sequence: sequence, SourcePort: "transfer", SourceChannel: "channel-0"}}
+	}
+	recvV2 := func(sequence uint64) sdk.Msg {
+		return &channeltypesv2.MsgRecvPacket{Packet: channeltypesv2.Packet{Sequence: sequence, DestinationClient: clientID}}
+	}
+
+	testCases := []struct {
+		name string
+		msgs []sdk.Msg
+		fee  sdk.Coins
+		// disabled sets the max_relay_refund_per_block param to zero
+		disabled  bool
+		remaining int64
+		// advancesClient advances the client when the msgs run
+		advancesClient   bool
+		failed           bool
+		checkTx          bool
+		minGasMultiplier math.LegacyDec
+		refund           int64
+	}{
+		{
+			name:      "refunds the used share of the charged fee of a first recv",
+			msgs:      []sdk.Msg{updateClient, recv("transfer", "channel-0", 2)},
+			remaining: 1_000,
+			refund:    400,
+		},
+		{
+			name:             "refunds the min gas multiplier share of the charged fee",
+			msgs:             []sdk.Msg{recv("transfer", "channel-0", 2)},
+			remaining:        1_000,
+			minGasMultiplier: math.LegacyNewDecWithPrec(5, 1),
+			refund:           500,
+		},
+		{
+			name:      "recv already received",
+			msgs:      []sdk.Msg{updateClient, recv("transfer", "channel-0", 1)},
+			remaining: 1_000,
+		},
+		{
+			name:      "recv of the same packet twice",
+			msgs:      []sdk.Msg{recv("transfer", "channel-0", 2), recv("transfer", "channel-0", 2)},
+			remaining: 1_000,
+		},
+		{
+			name:      "one of the recvs already received",
+			msgs:      []sdk.Msg{recv("transfer", "channel-0", 2), recv("transfer", "channel-0", 1)},
+			remaining: 1_000,
+		},
+		{
+			name:      "recv on an unknown channel",
+			msgs:      []sdk.Msg{recv("transfer", "channel-9", 2)},
+			remaining: 1_000,
+		},
+		{
+			name:      "first recv on an ordered channel",
+			msgs:      []sdk.Msg{recv("ordered", "channel-1", 5)},
+			remaining: 1_000,
+			refund:    400,
+		},
+		{
+			name:      "recv already received on an ordered channel",
+			msgs:      []sdk.Msg{recv("ordered", "channel-1", 4)},
+			remaining: 1_000,
+		},
+		{
+			name:      "first ack",
+			msgs:      []sdk.Msg{updateClient, ack(3)},
+			remaining: 1_000,
+			refund:    400,
+		},
+		{
+			name:      "ack already relayed",
+			msgs:      []sdk.Msg{ack(4)},
+			remaining: 1_000,
+		},
+		{
+			name:      "first timeout",
+			msgs:      []sdk.Msg{timeout(3)},
+			remaining: 1_000,
+			refund:    400,
+		},
+		{
+			name:      "ack and timeout of the same packet",
+			msgs:      []sdk.Msg{ack(3), timeout(3)},
+			remaining: 1_000,
+		},
+		{
+			name:      "first v2 recv",
+			msgs:      []sdk.Msg{recvV2(2)},
+			remaining: 1_000,
+			refund:    400,
+		},
+		{
+			name:      "v2 recv already received",
+			msgs:      []sdk.Msg{recvV2(1)},
+			remaining: 1_000,
+		},
+		{
+			name:           "client update advancing the client",
+			msgs:           []sdk.Msg{updateClient},
+			remaining:      1_000,
+			advancesClient: true,
+			refund:         400,
+		},
+		{
+			name:      "client update not advancing the client",
+			msgs:      []sdk.Msg{updateClient},
+			remaining: 1_000,
+		},
+		{
+			name:      "relay with other msgs",
+			msgs:      []sdk.Msg{recv("transfer", "channel-0", 2), &banktypes.MsgSend{}},
+			remaining: 1_000,
+		},
+		{
+			name:      "refund capped by the block remaining refunds",
+			msgs:      []sdk.Msg{recv("transfer", "channel-0", 2)},
+			remaining: 150,
+			refund:    150,
+		},
+		{
+			name: "block refunds exhausted",
+			msgs: []sdk.Msg{recv("transfer", "channel-0", 2)},
+		},
+		{
+			name:      "refunds disabled",
+			msgs:      []sdk.Msg{recv("transfer", "channel-0", 2)},
+			disabled:  true,
+			remaining: 1_000,
+		},
+		{
+			name:      "failed tx",
+			msgs:      []sdk.Msg{recv("transfer", "channel-0", 2)},
+			remaining: 1_000,
+			failed:    true,
+		},
+		{
+			name:      "check tx",
+			msgs:      []sdk.Msg{recv("transfer", "channel-0", 2)},
+			remaining: 1_000,
+			checkTx:   true,
+		},
+		{
+			name:      "fee paid in a fee token",
+			msgs:      []sdk.Msg{recv("transfer", "channel-0", 2)},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("ibc/token", 2_000)),
+			remaining: 1_000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ibcKeeper := &relayIBCKeeper{
+				channels: map[string]channeltypes.Channel{
+					"transfer/channel-0": {Ordering: channeltypes.UNORDERED},
+					"ordered/channel-1":  {Ordering: channeltypes.ORDERED},
+				},
+				nextSequenceRecv: 5,
+				receipts:         map[string]bool{"transfer/channel-0/1": true, clientID + "/1": true},
+				commitments:      map[string]bool{"transfer/channel-0/3": true},
+				clientHeights:    map[string]clienttypes.Height{clientID: clienttypes.NewHeight(1, 10)},
+			}
+			params := txpolicytypes.DefaultParams()
+			if !tc.disabled {
+				params.MaxRelayRefundPerBlock = math.NewInt(tc.remaining + 1)
+			}
+			refundKeeper := &relayRefundKeeper{remaining: math.NewInt(tc.remaining), paid: math.ZeroInt()}
+			bk := &refundBankKeeper{}
+
+			txFee := fee
+			if tc.fee != nil {
+				txFee = tc.fee
+			}
+			tx := refundTx{msgs: tc.msgs, gas: 100_000, fee: txFee, feePayer: relayer}
+
+			key := storetypes.NewKVStoreKey(banktypes.StoreKey)
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).
+				WithIsCheckTx(tc.checkTx).
+				WithGasMeter(storetypes.NewGasMeter(tx.gas))
+
+			anteHandler := sdk.ChainAnteDecorators(NewRelayWorkDecorator(ibcKeeper, ibcKeeper, ibcKeeper, &params))
+			ctx, err := anteHandler(ctx, tx, false)
+			require.NoError(t, err)
+
+			// run the msgs
+			ctx.GasMeter().ConsumeGas(40_000, "test")
+			if tc.advancesClient {
+				ibcKeeper.clientHeights[clientID] = clienttypes.NewHeight(1, 11)
+			}
+
+			minGasMultiplier := func(sdk.Context) math.LegacyDec { return tc.minGasMultiplier }
+			postHandler := sdk.ChainPostDecorators(NewRelayRefundDecorator(bk, refundKeeper, ibcKeeper, halfFeeChecker, minGasMultiplier))
+			_, err = postHandler(ctx, tx, false, !tc.failed)
+			require.NoError(t, err)
+			require.Equal(t, uint64(40_000), ctx.GasMeter().GasConsumed())
+
+			if tc.refund == 0 {
+				require.Nil(t, bk.amount)
+				require.True(t, refundKeeper.paid.IsZero())
+				return
+			}
+			require.Equal(t, relayer, bk.recipient)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utac", tc.refund)), bk.amount)
+			require.Equal(t, math.NewInt(tc.refund), refundKeeper.paid)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package v170
+
+// Upgrade adding the tacchain x/inflation, x/txpolicy and x/feeabs modules
+//
+// The binary of the upgrade also ships the ProcessProposal validation:
+// validators reject the proposals with undecodable txs, txs failing the ante
+// handler, or txs whose gas exceeds the block gas limit, the lowest of the EVM
+// block gas limit read from the genesis file and the consensus max gas. A
+// validator running the rule while the others don't votes differently on the
+// same proposals, so the rule must only be rolled out by this coordinated
+// upgrade, with every validator switching binaries at the upgrade height, and
+// never as a binary swap on a running chain. The EVM block gas limit must be
+// the same in the genesis file of every validator.
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/TacBuild/tacchain/app/upgrades"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	feeabstypes "github.com/TacBuild/tacchain/x/feeabs/types"
+	inflationtypes "github.com/TacBuild/tacchain/x/inflation/types"
+	txpolicytypes "github.com/TacBuild/tacchain/x/txpolicy/types"
+)
+
+// UpgradeName defines the on-chain upgrade name
+const UpgradeName = "v1.7.0"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added:   []string{inflationtypes.StoreKey, txpolicytypes.StoreKey, feeabstypes.StoreKey},
+		Deleted: []string{},
+	},
+}
+
+func CreateUpgradeHandler(
+	mm upgrades.ModuleManager,
+	configurator module.Configurator,
+	_ *upgrades.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// x/inflation is initialized from its default genesis, which keeps the
+		// linear curve the chain has been minting with. x/txpolicy starts
+		// without gas price overrides and with the authz deny list that used
+		// to be hard-coded in the ante handler. x/feeabs starts without fee
+		// tokens.
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
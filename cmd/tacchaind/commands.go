@@ -2,6 +2,7 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
@@ -26,9 +27,12 @@ import (
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/TacBuild/tacchain/app"
 	appconfig "github.com/TacBuild/tacchain/app/config"
+	tacmempool "github.com/TacBuild/tacchain/app/mempool"
+	inflationcli "github.com/TacBuild/tacchain/x/inflation/client/cli"
 
 	evmclient "github.com/cosmos/evm/client"
 	evmdebug "github.com/cosmos/evm/client/debug"
@@ -51,13 +55,19 @@ func initRootCmd(appInstance *app.TacChainApp, rootCmd *cobra.Command) {
 	)
 
 	// add Cosmos EVM' flavored TM commands to start server, etc.
+	startOpts := evmserver.NewDefaultStartOptions(newApp, appconfig.DefaultNodeHome)
 	evmserver.AddCommands(
 		rootCmd,
-		evmserver.NewDefaultStartOptions(newApp, appconfig.DefaultNodeHome),
+		startOpts,
 		appExport,
 		addModuleInitFlags,
 	)
 
+	// start the node-local services of the app along with the node
+	start := startCmd(startOpts)
+	addModuleInitFlags(start)
+	replaceStartCmd(rootCmd, start)
+
 	// add Cosmos EVM key commands
 	rootCmd.AddCommand(
 		evmclient.KeyCommands(appconfig.DefaultNodeHome, true),
@@ -68,6 +78,7 @@ func initRootCmd(appInstance *app.TacChainApp, rootCmd *cobra.Command) {
 		server.StatusCommand(),
 		queryCommand(),
 		txCommand(),
+		tacmempool.Cmd(),
 	)
 
 	// add general tx flags to the root command
@@ -78,6 +89,21 @@ func initRootCmd(appInstance *app.TacChainApp, rootCmd *cobra.Command) {
 	}
 }
 
+// addMintProjectionCommand mounts the x/inflation projection query under the
+// autocli generated x/mint query command.
+func addMintProjectionCommand(rootCmd *cobra.Command) error {
+	mintCmd, _, err := rootCmd.Find([]string{"query", minttypes.ModuleName})
+	if err != nil {
+		return err
+	}
+	if mintCmd.Name() != minttypes.ModuleName {
+		return fmt.Errorf("%s query command not found", minttypes.ModuleName)
+	}
+
+	mintCmd.AddCommand(inflationcli.GetMintProjectionCmd())
+	return nil
+}
+
 func addModuleInitFlags(cmd *cobra.Command) {
 	crisis.AddModuleInitFlags(cmd)
 }
@@ -123,6 +149,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetSimulateCmd(),
+		eip712Command(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/ethereum/eip712"
+
+	"github.com/TacBuild/tacchain/app"
+)
+
+// FlagEVMChainID is the EVM chain ID of the EIP-712 typed data domain.
+const FlagEVMChainID = "evm-chain-id"
+
+// eip712Command returns the commands signing cosmos txs as EIP-712 typed
+// data, e.g. with MetaMask.
+func eip712Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "eip712",
+		Short: "Sign cosmos txs as EIP-712 typed data with an Ethereum wallet",
+		Long: `Sign cosmos txs as EIP-712 typed data with an Ethereum wallet such as MetaMask.
+Generate the unsigned tx with --generate-only, print its typed data with typed-data, sign
+the typed data with eth_signTypedData_v4 and attach the signature with attach-signature.`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		eip712TypedDataCmd(),
+		eip712AttachSignatureCmd(),
+	)
+
+	return cmd
+}
+
+func eip712TypedDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "typed-data [tx-file]",
+		Short:   "Print the EIP-712 typed data the signer of an unsigned tx signs",
+		Example: fmt.Sprintf("%s tx eip712 typed-data unsigned.json --chain-id tacchain_239-1", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, typedData, err := eip712TypedDataFromFile(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(typedData, "", "  ")
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(bz) + "\n")
+		},
+	}
+
+	addEIP712Flags(cmd)
+	return cmd
+}
+
+func eip712AttachSignatureCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attach-signature [tx-file] [signature]",
+		Short: "Attach the 0x hex EIP-712 signature of its typed data to an unsigned tx",
+		Long: `Attach the 0x hex EIP-712 signature of its typed data to an unsigned tx, and print the signed tx.
+The account number, sequence and chain IDs must be the ones the typed data was printed with.`,
+		Example: fmt.Sprintf("%s tx eip712 attach-signature unsigned.json 0x... --chain-id tacchain_239-1 > signed.json", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signingData, typedData, err := eip712TypedDataFromFile(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			sig, err := hexutil.Decode(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid signature: %w", err)
+			}
+			pubKey, err := recoverEIP712Signer(typedData, sig)
+			if err != nil {
+				return err
+			}
+			if signer := sdk.AccAddress(pubKey.Address()); !signer.Equals(signingData.signer) {
+				return fmt.Errorf("signature of %s, expected the tx signer %s", signer, signingData.signer)
+			}
+
+			txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(signingData.tx)
+			if err != nil {
+				return err
+			}
+			extBuilder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+			if !ok {
+				return errors.New("tx builder does not support extension options")
+			}
+
+			option, err := codectypes.NewAnyWithValue(&eip712.ExtensionOptionsWeb3Tx{
+				TypedDataChainID: signingData.evmChainID,
+				FeePayer:         signingData.signer.String(),
+				FeePayerSig:      sig,
+			})
+			if err != nil {
+				return err
+			}
+			extBuilder.SetExtensionOptions(option)
+
+			// the signature is carried by the extension option
+			if err := txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey:   pubKey,
+				Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+				Sequence: signingData.sequence,
+			}); err != nil {
+				return err
+			}
+
+			bz, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(bz) + "\n")
+		},
+	}
+
+	addEIP712Flags(cmd)
+	return cmd
+}
+
+func addEIP712Flags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flags.FlagAccountNumber, 0, "The account number of the signer, queried unless --offline is set")
+	cmd.Flags().Uint64(flags.FlagSequence, 0, "The sequence of the signer, queried unless --offline is set")
+	cmd.Flags().Bool(flags.FlagOffline, false, "Don't query the account number and sequence of the signer")
+	cmd.Flags().Uint64(FlagEVMChainID, 0, "The EVM chain ID, defaults to the one embedded in --chain-id")
+	flags.AddQueryFlagsToCmd(cmd)
+}
+
+// eip712SigningData is what the EIP-712 typed data of a tx depends on.
+type eip712SigningData struct {
+	tx                      authsigning.Tx
+	signer                  sdk.AccAddress
+	accountNumber, sequence uint64
+	evmChainID              uint64
+}
+
+func eip712TypedDataFromFile(cmd *cobra.Command, clientCtx client.Context, filename string) (eip712SigningData, apitypes.TypedData, error) {
+	signingData, err := eip712SigningDataFromFile(cmd, clientCtx, filename)
+	if err != nil {
+		return eip712SigningData{}, apitypes.TypedData{}, err
+	}
+
+	typedData, err := app.EIP712TypedData(
+		clientCtx.InterfaceRegistry,
+		signingData.tx,
+		clientCtx.ChainID,
+		signingData.accountNumber,
+		signingData.sequence,
+		signingData.evmChainID,
+		signingData.signer,
+	)
+	return signingData, typedData, err
+}
+
+func eip712SigningDataFromFile(cmd *cobra.Command, clientCtx client.Context, filename string) (eip712SigningData, error) {
+	if clientCtx.ChainID == "" {
+		return eip712SigningData{}, errors.New("the chain ID is required, set --chain-id")
+	}
+
+	stdTx, err := authclient.ReadTxFromFile(clientCtx, filename)
+	if err != nil {
+		return eip712SigningData{}, err
+	}
+	tx, ok := stdTx.(authsigning.Tx)
+	if !ok {
+		return eip712SigningData{}, fmt.Errorf("cannot sign tx of type %T", stdTx)
+	}
+
+	signers, err := tx.GetSigners()
+	if err != nil {
+		return eip712SigningData{}, err
+	}
+	if len(signers) != 1 {
+		return eip712SigningData{}, fmt.Errorf("EIP-712 signed txs must have exactly one signer, got %d", len(signers))
+	}
+	data := eip712SigningData{tx: tx, signer: signers[0]}
+
+	if data.evmChainID, err = cmd.Flags().GetUint64(FlagEVMChainID); err != nil {
+		return eip712SigningData{}, err
+	}
+	if data.evmChainID == 0 {
+		if data.evmChainID, err = app.EVMChainIDFromChainID(clientCtx.ChainID); err != nil {
+			return eip712SigningData{}, fmt.Errorf("%w, set --%s", err, FlagEVMChainID)
+		}
+	}
+
+	offline, err := cmd.Flags().GetBool(flags.FlagOffline)
+	if err != nil {
+		return eip712SigningData{}, err
+	}
+	if offline {
+		if data.accountNumber, err = cmd.Flags().GetUint64(flags.FlagAccountNumber); err != nil {
+			return eip712SigningData{}, err
+		}
+		if data.sequence, err = cmd.Flags().GetUint64(flags.FlagSequence); err != nil {
+			return eip712SigningData{}, err
+		}
+		return data, nil
+	}
+
+	data.accountNumber, data.sequence, err = clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, data.signer)
+	return data, err
+}
+
+// recoverEIP712Signer returns the public key that produced the [R||S||V]
+// signature of the typed data.
+func recoverEIP712Signer(typedData apitypes.TypedData, sig []byte) (*ethsecp256k1.PubKey, error) {
+	if len(sig) != ethcrypto.SignatureLength {
+		return nil, fmt.Errorf("signature must be %d bytes [R||S||V], got %d", ethcrypto.SignatureLength, len(sig))
+	}
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	// wallets such as MetaMask use 27 and 28 as recovery IDs
+	recoverySig := append([]byte{}, sig...)
+	if v := recoverySig[ethcrypto.RecoveryIDOffset]; v == 27 || v == 28 {
+		recoverySig[ethcrypto.RecoveryIDOffset] -= 27
+	}
+
+	ecPubKey, err := ethcrypto.SigToPub(sigHash, recoverySig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover the signer: %w", err)
+	}
+	return &ethsecp256k1.PubKey{Key: ethcrypto.CompressPubkey(ecPubKey)}, nil
+}
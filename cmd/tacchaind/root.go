@@ -23,7 +23,12 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/TacBuild/tacchain/app"
+	"github.com/TacBuild/tacchain/app/broadcast"
 	appconfig "github.com/TacBuild/tacchain/app/config"
+	"github.com/TacBuild/tacchain/app/journal"
+	"github.com/TacBuild/tacchain/app/lanes"
+	tacmempool "github.com/TacBuild/tacchain/app/mempool"
+	"github.com/TacBuild/tacchain/app/ratelimit"
 
 	evmkeyring "github.com/cosmos/evm/crypto/keyring"
 	evmserverconfig "github.com/cosmos/evm/server/config"
@@ -137,6 +142,10 @@ func NewRootCmd() *cobra.Command {
 		panic(err)
 	}
 
+	if err := addMintProjectionCommand(rootCmd); err != nil {
+		panic(err)
+	}
+
 	return rootCmd
 }
 
@@ -167,6 +176,14 @@ func initAppConfig() (string, interface{}) {
 		EVM     evmserverconfig.EVMConfig
 		JSONRPC evmserverconfig.JSONRPCConfig
 		TLS     evmserverconfig.TLSConfig
+
+		// TacChain node-local configs
+		RateLimit     ratelimit.Config        `mapstructure:"rate-limit"`
+		Lanes         lanes.Config            `mapstructure:"lanes"`
+		EVMBroadcast  broadcast.Config        `mapstructure:"evm-broadcast"`
+		EVMJournal    journal.Config          `mapstructure:"evm-journal"`
+		MempoolAdmin  tacmempool.AdminConfig  `mapstructure:"mempool-admin"`
+		MempoolStream tacmempool.StreamConfig `mapstructure:"mempool-stream"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -193,10 +210,23 @@ func initAppConfig() (string, interface{}) {
 		EVM:     *evmserverconfig.DefaultEVMConfig(),
 		JSONRPC: *evmserverconfig.DefaultJSONRPCConfig(),
 		TLS:     *evmserverconfig.DefaultTLSConfig(),
+
+		RateLimit:     ratelimit.DefaultConfig(),
+		Lanes:         lanes.DefaultConfig(),
+		EVMBroadcast:  broadcast.DefaultConfig(),
+		EVMJournal:    journal.DefaultConfig(),
+		MempoolAdmin:  tacmempool.DefaultAdminConfig(),
+		MempoolStream: tacmempool.DefaultStreamConfig(),
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate +
-		evmserverconfig.DefaultEVMConfigTemplate
+		evmserverconfig.DefaultEVMConfigTemplate +
+		ratelimit.DefaultConfigTemplate +
+		lanes.DefaultConfigTemplate +
+		broadcast.DefaultConfigTemplate +
+		journal.DefaultConfigTemplate +
+		tacmempool.DefaultAdminConfigTemplate +
+		tacmempool.DefaultStreamConfigTemplate
 
 	return customAppTemplate, customAppConfig
 }
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sync/atomic"
+
+	dbm "github.com/cosmos/cosmos-db"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/TacBuild/tacchain/app/ratelimit"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+	evmrpc "github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth"
+	"github.com/cosmos/evm/rpc/stream"
+	evmserver "github.com/cosmos/evm/server"
+	evmserverconfig "github.com/cosmos/evm/server/config"
+	evmsrvflags "github.com/cosmos/evm/server/flags"
+	evmservertypes "github.com/cosmos/evm/server/types"
+)
+
+// nodeApp is the application started by the start command, serving the
+// node-local services the Cosmos EVM servers don't start.
+type nodeApp interface {
+	RateLimiter() *ratelimit.Limiter
+	StartMempoolAdmin() error
+}
+
+// jsonRPCLimiter is the rate limiter of the app started by the start command,
+// limiting the EVM JSON-RPC broadcasts.
+var jsonRPCLimiter atomic.Pointer[ratelimit.Limiter]
+
+func init() {
+	if err := evmrpc.RegisterAPINamespace(ratelimit.JSONRPCNamespace, newRateLimitAPIs); err != nil {
+		panic(err)
+	}
+}
+
+// startCmd returns the Cosmos EVM start command, starting the mempool admin
+// gRPC service of the app it creates and rate limiting the EVM JSON-RPC
+// broadcasts with its rate limiter. The app inserts the promoted EVM txs into
+// the mempool of the in-process node once the command sets its local client.
+func startCmd(opts evmserver.StartOptions) *cobra.Command {
+	appCreator := opts.AppCreator
+	opts.AppCreator = func(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+		app := appCreator(logger, db, traceStore, appOpts)
+		if tacApp, ok := app.(nodeApp); ok {
+			jsonRPCLimiter.Store(tacApp.RateLimiter())
+			if err := tacApp.StartMempoolAdmin(); err != nil {
+				panic(fmt.Errorf("failed to start mempool admin gRPC service: %w", err))
+			}
+		}
+		return app
+	}
+
+	cmd := evmserver.StartCmd(opts)
+	preRunE := cmd.PreRunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := preRunE(cmd, args); err != nil {
+			return err
+		}
+		return enableRateLimitNamespace(server.GetServerContextFromCmd(cmd).Viper)
+	}
+	return cmd
+}
+
+// replaceStartCmd replaces the start command of rootCmd.
+func replaceStartCmd(rootCmd, startCmd *cobra.Command) {
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == startCmd.Name() {
+			rootCmd.RemoveCommand(cmd)
+		}
+	}
+	rootCmd.AddCommand(startCmd)
+}
+
+// enableRateLimitNamespace enables the rate limiter JSON-RPC namespace last,
+// after the eth namespace whose broadcast methods it overrides, when the
+// rate limiter is enabled.
+func enableRateLimitNamespace(v *viper.Viper) error {
+	cfg, err := ratelimit.ConfigFromAppOptions(v)
+	if err != nil || !cfg.Enable {
+		return err
+	}
+	config, err := evmserverconfig.GetConfig(v)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(config.JSONRPC.API, evmrpc.EthNamespace) {
+		return nil
+	}
+
+	namespaces := slices.DeleteFunc(slices.Clone(config.JSONRPC.API), func(ns string) bool {
+		return ns == ratelimit.JSONRPCNamespace
+	})
+	v.Set(evmsrvflags.JSONRPCAPI, append(namespaces, ratelimit.JSONRPCNamespace))
+	return nil
+}
+
+// newRateLimitAPIs returns the API of the rate limiter JSON-RPC namespace,
+// overriding the broadcast methods of the eth namespace to limit them by
+// client IP.
+func newRateLimitAPIs(
+	ctx *server.Context,
+	clientCtx client.Context,
+	_ *stream.RPCStream,
+	allowUnprotectedTxs bool,
+	indexer evmservertypes.EVMTxIndexer,
+	mempool *evmmempool.ExperimentalEVMMempool,
+) []ethrpc.API {
+	limiter := jsonRPCLimiter.Load()
+	if limiter == nil {
+		return nil
+	}
+
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+	return []ethrpc.API{{
+		Namespace: evmrpc.EthNamespace,
+		Version:   "1.0",
+		Service:   ratelimit.NewEthAPI(limiter, eth.NewPublicAPI(ctx.Logger, evmBackend)),
+		Public:    true,
+	}}
+}
@@ -0,0 +1,21 @@
+#!/usr/bin/env bash
+
+# How to run manually:
+# docker run --rm -v $(pwd):/workspace --workdir /workspace ghcr.io/cosmos/proto-builder:0.16.0 sh ./scripts/protocgen.sh
+
+set -e
+
+echo "Generating gogo proto code"
+cd proto
+proto_dirs=$(find ./tacchain -name '*.proto' -print0 | xargs -0 -n1 dirname | sort | uniq)
+for dir in $proto_dirs; do
+  for file in $(find "${dir}" -maxdepth 1 -name '*.proto'); do
+    buf generate --template buf.gen.gogo.yaml "$file"
+  done
+done
+
+cd ..
+
+# move proto files to the right places
+cp -r github.com/TacBuild/tacchain/* ./
+rm -rf github.com
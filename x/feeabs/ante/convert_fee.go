@@ -0,0 +1,104 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+// FeeAbsKeeper defines the feeabs keeper methods the fee decorators need.
+type FeeAbsKeeper interface {
+	ConvertFee(ctx context.Context, account sdk.AccAddress, fee sdk.Coin) (sdk.Coin, error)
+	ConvertFeeExact(ctx context.Context, account sdk.AccAddress, denom string, fee math.Int) (sdk.Coin, error)
+	SetEVMFeePayment(ctx context.Context, txHash string, payment types.EVMFeePayment) error
+}
+
+// ConvertFeeDecorator lets cosmos txs pay their fee in a fee token. The fee
+// is swapped for the EVM denom it is worth before running the wrapped fee
+// decorators, which check and deduct the converted fee as if the tx had paid
+// it. Only fees in the EVM denom are passed through unchanged: a fee in any
+// other denom must be paid in a fee token of the params.
+//
+// The fee tokens are taken from the account the fee is deducted from: the
+// feegrant granter if the fee is granted, the fee payer otherwise.
+// CONTRACT: Tx must implement FeeTx to use ConvertFeeDecorator
+type ConvertFeeDecorator struct {
+	keeper     FeeAbsKeeper
+	feeHandler sdk.AnteHandler
+}
+
+// NewConvertFeeDecorator creates a new ConvertFeeDecorator wrapping the
+// decorators checking and deducting the fee.
+func NewConvertFeeDecorator(keeper FeeAbsKeeper, feeDecorators ...sdk.AnteDecorator) ConvertFeeDecorator {
+	return ConvertFeeDecorator{keeper: keeper, feeHandler: sdk.ChainAnteDecorators(feeDecorators...)}
+}
+
+func (cfd ConvertFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
+	}
+
+	fee := feeTx.GetFee()
+	if fee.IsZero() || (len(fee) == 1 && fee[0].Denom == evmtypes.GetEVMCoinDenom()) {
+		if ctx, err = cfd.feeHandler(ctx, tx, simulate); err != nil {
+			return ctx, err
+		}
+		return next(ctx, tx, simulate)
+	}
+	if len(fee) != 1 {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidCoins, "fee %s must be paid in a single denom", fee)
+	}
+
+	account := feeTx.FeePayer()
+	if granter := feeTx.FeeGranter(); granter != nil {
+		account = granter
+	}
+
+	converted, err := cfd.keeper.ConvertFee(ctx, account, fee[0])
+	if err != nil {
+		return ctx, err
+	}
+
+	if ctx, err = cfd.feeHandler(ctx, convertedFeeTx{FeeTx: feeTx, fee: sdk.NewCoins(converted)}, simulate); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// convertedFeeTx is a FeeTx whose fee is replaced by its converted value. It
+// keeps the extension options the dynamic fee checker reads the priority tip
+// from.
+type convertedFeeTx struct {
+	sdk.FeeTx
+
+	fee sdk.Coins
+}
+
+var _ authante.HasExtensionOptionsTx = convertedFeeTx{}
+
+func (tx convertedFeeTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx convertedFeeTx) GetExtensionOptions() []*codectypes.Any {
+	if extTx, ok := tx.FeeTx.(authante.HasExtensionOptionsTx); ok {
+		return extTx.GetExtensionOptions()
+	}
+	return nil
+}
+
+func (tx convertedFeeTx) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	if extTx, ok := tx.FeeTx.(authante.HasExtensionOptionsTx); ok {
+		return extTx.GetNonCriticalExtensionOptions()
+	}
+	return nil
+}
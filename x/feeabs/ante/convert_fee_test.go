@@ -0,0 +1,148 @@
+package ante_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/ante"
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+func TestMain(m *testing.M) {
+	if err := evmtypes.NewEVMConfigurator().WithEVMCoinInfo(evmtypes.EvmCoinInfo{
+		Denom:         "utac",
+		ExtendedDenom: "utac",
+		DisplayDenom:  "tac",
+		Decimals:      evmtypes.EighteenDecimals.Uint32(),
+	}).Configure(); err != nil {
+		panic(err)
+	}
+	if err := evmtypes.SetChainConfig(nil); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+type feeTx struct {
+	fee        sdk.Coins
+	feePayer   sdk.AccAddress
+	feeGranter sdk.AccAddress
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg                    { return nil }
+func (tx feeTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx feeTx) GetGas() uint64                        { return 100_000 }
+func (tx feeTx) GetFee() sdk.Coins                     { return tx.fee }
+func (tx feeTx) FeePayer() []byte                      { return tx.feePayer }
+func (tx feeTx) FeeGranter() []byte                    { return tx.feeGranter }
+
+// mockKeeper converts fee tokens at a rate of 2.
+type mockKeeper struct {
+	account  sdk.AccAddress
+	payments map[string]types.EVMFeePayment
+}
+
+func (k *mockKeeper) ConvertFee(_ context.Context, account sdk.AccAddress, fee sdk.Coin) (sdk.Coin, error) {
+	k.account = account
+	return sdk.NewCoin("utac", fee.Amount.MulRaw(2)), nil
+}
+
+func (k *mockKeeper) ConvertFeeExact(_ context.Context, account sdk.AccAddress, denom string, fee math.Int) (sdk.Coin, error) {
+	k.account = account
+	return sdk.NewCoin(denom, fee.QuoRaw(2)), nil
+}
+
+func (k *mockKeeper) SetEVMFeePayment(_ context.Context, txHash string, payment types.EVMFeePayment) error {
+	k.payments[txHash] = payment
+	return nil
+}
+
+// feeRecorder records the fee the wrapped fee decorators see.
+type feeRecorder struct {
+	fee *sdk.Coins
+}
+
+func (r feeRecorder) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*r.fee = tx.(sdk.FeeTx).GetFee()
+	return next(ctx, tx, simulate)
+}
+
+func TestConvertFeeDecorator(t *testing.T) {
+	payer := sdk.AccAddress("payer")
+	granter := sdk.AccAddress("granter")
+
+	testCases := []struct {
+		name      string
+		tx        feeTx
+		converted sdk.Coins
+		account   sdk.AccAddress
+	}{
+		{
+			name:      "fee in the EVM denom",
+			tx:        feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("utac", 100)), feePayer: payer},
+			converted: sdk.NewCoins(sdk.NewInt64Coin("utac", 100)),
+		},
+		{
+			name:      "fee in a fee token",
+			tx:        feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("ibc/token", 100)), feePayer: payer},
+			converted: sdk.NewCoins(sdk.NewInt64Coin("utac", 200)),
+			account:   payer,
+		},
+		{
+			name:      "fee in the bond denom",
+			tx:        feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), feePayer: payer},
+			converted: sdk.NewCoins(sdk.NewInt64Coin("utac", 200)),
+			account:   payer,
+		},
+		{
+			name:      "granted fee in a fee token",
+			tx:        feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("ibc/token", 100)), feePayer: payer, feeGranter: granter},
+			converted: sdk.NewCoins(sdk.NewInt64Coin("utac", 200)),
+			account:   granter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keeper := &mockKeeper{}
+			var fee sdk.Coins
+			decorator := ante.NewConvertFeeDecorator(keeper, feeRecorder{fee: &fee})
+
+			var nextTx sdk.Tx
+			next := func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+				nextTx = tx
+				return ctx, nil
+			}
+
+			_, err := decorator.AnteHandle(sdk.Context{}, tc.tx, false, next)
+			require.NoError(t, err)
+			require.Equal(t, tc.converted, fee)
+			require.Equal(t, tc.account, keeper.account)
+			// the rest of the chain sees the original tx
+			require.Equal(t, tc.tx, nextTx)
+		})
+	}
+}
+
+func TestConvertFeeDecoratorMultipleDenoms(t *testing.T) {
+	keeper := &mockKeeper{}
+	var fee sdk.Coins
+	decorator := ante.NewConvertFeeDecorator(keeper, feeRecorder{fee: &fee})
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	tx := feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("utac", 100), sdk.NewInt64Coin("ibc/token", 100)), feePayer: sdk.AccAddress("payer")}
+	_, err := decorator.AnteHandle(sdk.Context{}, tx, false, next)
+	require.ErrorContains(t, err, "must be paid in a single denom")
+	require.Nil(t, fee)
+	require.Nil(t, keeper.account)
+}
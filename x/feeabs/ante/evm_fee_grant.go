@@ -0,0 +1,121 @@
+package ante
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+// BankKeeper defines the bank keeper methods the fee grant decorator needs.
+type BankKeeper interface {
+	SendCoins(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error
+}
+
+// EVMFeeGrantDecorator lets the feegrant granter named by the
+// ExtensionOptionFeeGrant option of a tx pay the fees of its MsgEthereumTxs.
+// The fee each sender is about to be charged is spent from the allowance the
+// granter gave the sender and sent from the granter to the sender, from whom
+// the EVM mono decorator then deducts it as usual. As for cosmos txs, the
+// allowance is spent for the gas limit; the EVMFeeRefundDecorator returns the
+// fee x/vm refunds for unused gas to the granter.
+//
+// The EVM signature does not cover the option, so anyone can wrap a sender's
+// tx with it, e.g. the relayer of a dApp. The sender consents to the granter
+// paying its fee by naming the granter in the signed access list of the tx,
+// which legacy txs do not have.
+type EVMFeeGrantDecorator struct {
+	feegrantKeeper  authante.FeegrantKeeper
+	bankKeeper      BankKeeper
+	keeper          FeeAbsKeeper
+	feemarketParams *feemarkettypes.Params
+}
+
+// NewEVMFeeGrantDecorator creates a new EVMFeeGrantDecorator.
+func NewEVMFeeGrantDecorator(fk authante.FeegrantKeeper, bk BankKeeper, k FeeAbsKeeper, feemarketParams *feemarkettypes.Params) EVMFeeGrantDecorator {
+	return EVMFeeGrantDecorator{feegrantKeeper: fk, bankKeeper: bk, keeper: k, feemarketParams: feemarketParams}
+}
+
+func (efd EVMFeeGrantDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if efd.feegrantKeeper == nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	option, err := feeGrantOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+	granter, err := sdk.AccAddressFromBech32(option.FeeGranter)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid fee granter address: %s", err)
+	}
+
+	baseFee := evmtypes.GetBaseFee(ctx.BlockHeight(), evmtypes.GetEthChainConfig(), efd.feemarketParams)
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		sender := sdk.AccAddress(ethMsg.GetFrom())
+		if !accessListNames(ethMsg, granter) {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "the access list of the tx of %s does not name the fee granter %s", sender, granter)
+		}
+
+		fee := ethereumTxFee(ethMsg, baseFee)
+		if fee.IsZero() {
+			continue
+		}
+
+		fees := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), fee))
+		if err := efd.feegrantKeeper.UseGrantedFees(ctx, granter, sender, fees, []sdk.Msg{msg}); err != nil {
+			return ctx, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", granter, sender)
+		}
+		if err := efd.bankKeeper.SendCoins(ctx, granter, sender, fees); err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to pay fees for %s", sender)
+		}
+		payment := types.EVMFeePayment{
+			Fee:        fees[0],
+			GasLimit:   ethMsg.GetGas(),
+			FeeToken:   sdk.Coin{Amount: math.ZeroInt()},
+			FeeGranter: granter.String(),
+		}
+		if err := efd.keeper.SetEVMFeePayment(ctx, ethMsg.Hash().Hex(), payment); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// accessListNames reports whether the access list of the Ethereum tx names
+// the address.
+func accessListNames(ethMsg *evmtypes.MsgEthereumTx, addr sdk.AccAddress) bool {
+	for _, tuple := range ethMsg.AsTransaction().AccessList() {
+		if bytes.Equal(tuple.Address.Bytes(), addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func feeGrantOption(tx sdk.Tx) (*types.ExtensionOptionFeeGrant, error) {
+	if extTx, ok := tx.(authante.HasExtensionOptionsTx); ok {
+		if opts := extTx.GetExtensionOptions(); len(opts) == 1 {
+			if option, ok := opts[0].GetCachedValue().(*types.ExtensionOptionFeeGrant); ok {
+				return option, nil
+			}
+		}
+	}
+	return nil, errorsmod.Wrapf(errortypes.ErrUnknownExtensionOptions, "expected a single %T extension option", (*types.ExtensionOptionFeeGrant)(nil))
+}
@@ -0,0 +1,122 @@
+package ante_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/ante"
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+type extensionTx struct {
+	msgs []sdk.Msg
+	opts []*codectypes.Any
+}
+
+func (tx extensionTx) GetMsgs() []sdk.Msg                                { return tx.msgs }
+func (tx extensionTx) GetMsgsV2() ([]protov2.Message, error)             { return nil, nil }
+func (tx extensionTx) GetExtensionOptions() []*codectypes.Any            { return tx.opts }
+func (tx extensionTx) GetNonCriticalExtensionOptions() []*codectypes.Any { return nil }
+
+type mockFeegrantKeeper struct {
+	allowance sdk.Coins
+}
+
+func (k *mockFeegrantKeeper) UseGrantedFees(_ context.Context, _, _ sdk.AccAddress, fee sdk.Coins, _ []sdk.Msg) error {
+	allowance, hasNeg := k.allowance.SafeSub(fee...)
+	if hasNeg {
+		return errors.New("fee limit exceeded")
+	}
+	k.allowance = allowance
+	return nil
+}
+
+type mockBankKeeper struct {
+	sent map[string]sdk.Coins
+}
+
+func (bk *mockBankKeeper) SendCoins(_ context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	bk.sent[from.String()+">"+to.String()] = bk.sent[from.String()+">"+to.String()].Add(amt...)
+	return nil
+}
+
+// feeGrantTx returns a tx whose EVM txs, sent by senders, are paid by
+// granter, naming accessed in their access list.
+func feeGrantTx(t *testing.T, granter, accessed sdk.AccAddress, senders ...sdk.AccAddress) extensionTx {
+	t.Helper()
+
+	option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionFeeGrant{FeeGranter: granter.String()})
+	require.NoError(t, err)
+
+	tx := extensionTx{opts: []*codectypes.Any{option}}
+	for _, sender := range senders {
+		msg := &evmtypes.MsgEthereumTx{}
+		msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.AccessListTx{
+			Gas:        21_000,
+			GasPrice:   big.NewInt(10),
+			To:         &common.Address{},
+			AccessList: ethtypes.AccessList{{Address: common.BytesToAddress(accessed)}},
+		}))
+		msg.From = sender
+		tx.msgs = append(tx.msgs, msg)
+	}
+	return tx
+}
+
+func TestEVMFeeGrantDecorator(t *testing.T) {
+	granter := sdk.AccAddress("granter_____________")
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	feemarketParams := feemarkettypes.DefaultParams()
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	feegrantKeeper := &mockFeegrantKeeper{allowance: sdk.NewCoins(sdk.NewInt64Coin("utac", 500_000))}
+	bankKeeper := &mockBankKeeper{sent: map[string]sdk.Coins{}}
+	keeper := &mockKeeper{payments: map[string]types.EVMFeePayment{}}
+	decorator := ante.NewEVMFeeGrantDecorator(feegrantKeeper, bankKeeper, keeper, &feemarketParams)
+
+	tx := feeGrantTx(t, granter, granter, alice, bob)
+	_, err := decorator.AnteHandle(sdk.Context{}, tx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, "210000utac", bankKeeper.sent[granter.String()+">"+alice.String()].String())
+	require.Equal(t, "210000utac", bankKeeper.sent[granter.String()+">"+bob.String()].String())
+	require.Equal(t, "80000utac", feegrantKeeper.allowance.String())
+
+	// the payments are recorded for the unused gas to be refunded to the granter
+	payment := keeper.payments[tx.msgs[0].(*evmtypes.MsgEthereumTx).Hash().Hex()]
+	require.Equal(t, granter.String(), payment.FeeGranter)
+	require.Equal(t, "210000utac", payment.Fee.String())
+	require.Equal(t, uint64(21_000), payment.GasLimit)
+
+	// the sender did not name the granter in its signed access list
+	_, err = decorator.AnteHandle(sdk.Context{}, feeGrantTx(t, granter, bob, alice), false, next)
+	require.ErrorContains(t, err, "does not name the fee granter")
+	require.Equal(t, "80000utac", feegrantKeeper.allowance.String())
+
+	// the allowance no longer covers the fee
+	_, err = decorator.AnteHandle(sdk.Context{}, feeGrantTx(t, granter, granter, alice), false, next)
+	require.ErrorContains(t, err, "fee limit exceeded")
+
+	// fee grants are disabled without a feegrant keeper
+	decorator = ante.NewEVMFeeGrantDecorator(nil, bankKeeper, keeper, &feemarketParams)
+	_, err = decorator.AnteHandle(sdk.Context{}, feeGrantTx(t, granter, granter, alice), false, next)
+	require.ErrorContains(t, err, "fee grants are not enabled")
+
+	// a tx without the option
+	decorator = ante.NewEVMFeeGrantDecorator(feegrantKeeper, bankKeeper, keeper, &feemarketParams)
+	_, err = decorator.AnteHandle(sdk.Context{}, extensionTx{}, false, next)
+	require.ErrorContains(t, err, "expected a single")
+}
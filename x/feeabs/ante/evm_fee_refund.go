@@ -0,0 +1,61 @@
+package ante
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// EVMFeeRefundKeeper defines the feeabs keeper method the EVM fee refund
+// decorator needs.
+type EVMFeeRefundKeeper interface {
+	RefundEVMFee(ctx context.Context, txHash string, sender sdk.AccAddress, gasUsed uint64) error
+}
+
+// EVMGasUsedKeeper defines the x/vm keeper method the EVM fee refund
+// decorator needs.
+type EVMGasUsedKeeper interface {
+	GetTransientGasUsed(ctx sdk.Context) uint64
+}
+
+// EVMFeeRefundDecorator is a post decorator settling the fee of a
+// MsgEthereumTx paid through an extension option, once x/vm has refunded the
+// fee paid for its unused gas to the sender. The gas used is the one x/vm
+// refunded the unused gas against, recorded for the tx in its transient
+// store, a tx carrying a single MsgEthereumTx.
+type EVMFeeRefundDecorator struct {
+	keeper    EVMFeeRefundKeeper
+	evmKeeper EVMGasUsedKeeper
+}
+
+// NewEVMFeeRefundDecorator creates a new EVMFeeRefundDecorator.
+func NewEVMFeeRefundDecorator(keeper EVMFeeRefundKeeper, evmKeeper EVMGasUsedKeeper) EVMFeeRefundDecorator {
+	return EVMFeeRefundDecorator{keeper: keeper, evmKeeper: evmKeeper}
+}
+
+// PostHandle implements sdk.PostDecorator. Failed txs are not refunded by
+// x/vm, and the msgs of the tx are only executed when it is finalized or
+// simulated: CheckTx and the proposal verifications run the post handlers
+// without them.
+func (d EVMFeeRefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	msgs := tx.GetMsgs()
+	executed := ctx.ExecMode() == sdk.ExecModeFinalize || ctx.ExecMode() == sdk.ExecModeSimulate
+	if !success || !executed || len(msgs) != 1 {
+		return next(ctx, tx, simulate, success)
+	}
+	ethMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// the settlement is not charged to the tx, whose gas x/vm already set
+	refundCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if err := d.keeper.RefundEVMFee(refundCtx, ethMsg.Hash().Hex(), ethMsg.GetFrom(), d.evmKeeper.GetTransientGasUsed(ctx)); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate, success)
+}
@@ -0,0 +1,64 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/ante"
+)
+
+type mockRefundKeeper struct {
+	gasUsed map[string]uint64
+}
+
+func (k *mockRefundKeeper) RefundEVMFee(_ context.Context, txHash string, _ sdk.AccAddress, gasUsed uint64) error {
+	k.gasUsed[txHash] = gasUsed
+	return nil
+}
+
+// mockEVMKeeper reports the gas used x/vm refunded the unused gas against.
+type mockEVMKeeper struct {
+	gasUsed uint64
+}
+
+func (k mockEVMKeeper) GetTransientGasUsed(sdk.Context) uint64 { return k.gasUsed }
+
+func TestEVMFeeRefundDecorator(t *testing.T) {
+	next := func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) { return ctx, nil }
+	tx := feeTokenTx(t, "ibc/token", 10, sdk.AccAddress("alice_______________"))
+	txHash := tx.msgs[0].(*evmtypes.MsgEthereumTx).Hash().Hex()
+
+	// the refund is settled against the gas used x/vm refunded, not the gas
+	// left on the meter
+	gasMeter := storetypes.NewGasMeter(100_000)
+	gasMeter.ConsumeGas(60_000, "x/vm")
+	ctx := sdk.Context{}.WithGasMeter(gasMeter).WithExecMode(sdk.ExecModeFinalize)
+
+	keeper := &mockRefundKeeper{gasUsed: map[string]uint64{}}
+	decorator := ante.NewEVMFeeRefundDecorator(keeper, mockEVMKeeper{gasUsed: 50_000})
+	_, err := decorator.PostHandle(ctx, tx, false, true, next)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{txHash: 50_000}, keeper.gasUsed)
+
+	// the msgs are not executed by CheckTx and the proposal verifications
+	for _, mode := range []sdk.ExecMode{sdk.ExecModeCheck, sdk.ExecModePrepareProposal, sdk.ExecModeProcessProposal} {
+		keeper = &mockRefundKeeper{gasUsed: map[string]uint64{}}
+		decorator = ante.NewEVMFeeRefundDecorator(keeper, mockEVMKeeper{gasUsed: 50_000})
+		_, err = decorator.PostHandle(ctx.WithExecMode(mode), tx, false, true, next)
+		require.NoError(t, err)
+		require.Empty(t, keeper.gasUsed)
+	}
+
+	// failed txs are not refunded by x/vm
+	_, err = decorator.PostHandle(ctx, tx, false, false, next)
+	require.NoError(t, err)
+	require.Empty(t, keeper.gasUsed)
+}
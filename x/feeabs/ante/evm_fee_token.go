@@ -0,0 +1,95 @@
+package ante
+
+import (
+	"math/big"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+// EVMFeeTokenDecorator lets the MsgEthereumTxs of a tx carrying the
+// ExtensionOptionFeeToken option pay their fee in a fee token. It swaps the
+// fee tokens worth the fee each sender is about to be charged for the EVM
+// denom, which the EVM mono decorator then deducts as usual. The
+// EVMFeeRefundDecorator swaps the fee x/vm refunds for unused gas back to the
+// fee token, so the rate only applies to the gas used.
+//
+// The fee is charged before the EVM signature is verified; the swap is
+// reverted with the rest of the ante state if the verification fails.
+type EVMFeeTokenDecorator struct {
+	keeper          FeeAbsKeeper
+	feemarketParams *feemarkettypes.Params
+}
+
+// NewEVMFeeTokenDecorator creates a new EVMFeeTokenDecorator.
+func NewEVMFeeTokenDecorator(keeper FeeAbsKeeper, feemarketParams *feemarkettypes.Params) EVMFeeTokenDecorator {
+	return EVMFeeTokenDecorator{keeper: keeper, feemarketParams: feemarketParams}
+}
+
+func (efd EVMFeeTokenDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	option, err := feeTokenOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	baseFee := evmtypes.GetBaseFee(ctx.BlockHeight(), evmtypes.GetEthChainConfig(), efd.feemarketParams)
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		fee := ethereumTxFee(ethMsg, baseFee)
+		if fee.IsZero() {
+			continue
+		}
+
+		spent, err := efd.keeper.ConvertFeeExact(ctx, ethMsg.GetFrom(), option.Denom, fee)
+		if err != nil {
+			return ctx, err
+		}
+		payment := types.EVMFeePayment{
+			Fee:      sdk.NewCoin(evmtypes.GetEVMCoinDenom(), fee),
+			GasLimit: ethMsg.GetGas(),
+			FeeToken: spent,
+		}
+		if err := efd.keeper.SetEVMFeePayment(ctx, ethMsg.Hash().Hex(), payment); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ethereumTxFee returns the fee the EVM mono decorator deducts from the
+// sender of the tx. The EVM denom has 18 decimals, so the fee in wei is an
+// amount of the EVM denom.
+func ethereumTxFee(ethMsg *evmtypes.MsgEthereumTx, baseFee *big.Int) math.Int {
+	fee := ethMsg.GetFee()
+	if ethMsg.AsTransaction().Type() >= ethtypes.DynamicFeeTxType && baseFee != nil {
+		fee = ethMsg.GetEffectiveFee(baseFee)
+	}
+	return math.NewIntFromBigInt(fee)
+}
+
+func feeTokenOption(tx sdk.Tx) (*types.ExtensionOptionFeeToken, error) {
+	if extTx, ok := tx.(authante.HasExtensionOptionsTx); ok {
+		if opts := extTx.GetExtensionOptions(); len(opts) == 1 {
+			if option, ok := opts[0].GetCachedValue().(*types.ExtensionOptionFeeToken); ok {
+				return option, nil
+			}
+		}
+	}
+	return nil, errorsmod.Wrapf(errortypes.ErrUnknownExtensionOptions, "expected a single %T extension option", (*types.ExtensionOptionFeeToken)(nil))
+}
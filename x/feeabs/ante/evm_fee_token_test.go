@@ -0,0 +1,85 @@
+package ante_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/ante"
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+// feeTokenTx returns a tx whose EVM txs, sent by senders at gasPrice, pay
+// their fee in denom.
+func feeTokenTx(t *testing.T, denom string, gasPrice int64, senders ...sdk.AccAddress) extensionTx {
+	t.Helper()
+
+	option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionFeeToken{Denom: denom})
+	require.NoError(t, err)
+
+	tx := extensionTx{opts: []*codectypes.Any{option}}
+	for _, sender := range senders {
+		msg := &evmtypes.MsgEthereumTx{}
+		msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.LegacyTx{
+			Gas:      21_000,
+			GasPrice: big.NewInt(gasPrice),
+			To:       &common.Address{},
+		}))
+		msg.From = sender
+		tx.msgs = append(tx.msgs, msg)
+	}
+	return tx
+}
+
+func TestEVMFeeTokenDecorator(t *testing.T) {
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	feemarketParams := feemarkettypes.DefaultParams()
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	keeper := &mockKeeper{payments: map[string]types.EVMFeePayment{}}
+	decorator := ante.NewEVMFeeTokenDecorator(keeper, &feemarketParams)
+
+	tx := feeTokenTx(t, "ibc/token", 10, alice, bob)
+	_, err := decorator.AnteHandle(sdk.Context{}, tx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, bob, keeper.account)
+
+	// the payments are recorded for the unused gas to be refunded in the fee
+	// token
+	for _, msg := range tx.msgs {
+		payment := keeper.payments[msg.(*evmtypes.MsgEthereumTx).Hash().Hex()]
+		require.Equal(t, "210000utac", payment.Fee.String())
+		require.Equal(t, uint64(21_000), payment.GasLimit)
+		require.Equal(t, "105000ibc/token", payment.FeeToken.String())
+		require.Empty(t, payment.FeeGranter)
+	}
+
+	// nothing is swapped for a tx without fee
+	keeper = &mockKeeper{payments: map[string]types.EVMFeePayment{}}
+	decorator = ante.NewEVMFeeTokenDecorator(keeper, &feemarketParams)
+	_, err = decorator.AnteHandle(sdk.Context{}, feeTokenTx(t, "ibc/token", 0, alice), false, next)
+	require.NoError(t, err)
+	require.Nil(t, keeper.account)
+	require.Empty(t, keeper.payments)
+
+	// a tx without the option
+	_, err = decorator.AnteHandle(sdk.Context{}, extensionTx{}, false, next)
+	require.ErrorContains(t, err, "expected a single")
+
+	// a tx with other msgs
+	tx = feeTokenTx(t, "ibc/token", 10)
+	tx.msgs = []sdk.Msg{&banktypes.MsgSend{}}
+	_, err = decorator.AnteHandle(sdk.Context{}, tx, false, next)
+	require.ErrorContains(t, err, "invalid message type")
+}
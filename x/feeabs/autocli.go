@@ -0,0 +1,61 @@
+package feeabs
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current fee abstraction parameters",
+				},
+				{
+					RpcMethod:      "FeeTokenRate",
+					Use:            "fee-token-rate [denom]",
+					Short:          "Query the amount of the EVM denom one unit of a fee token is currently worth",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update feeabs module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update feeabs module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query feeabs params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx feeabs update-params-proposal '{ "fee_tokens": [{ "denom": "ibc/...", "rate_source": "RATE_SOURCE_STATIC", "static_rate": "1000000000000" }], "twap_window": "1800", "max_price_age": "600", "max_price_deviation": "0.2" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SubmitPrice",
+					Use:            "submit-price [denom] [price]",
+					Short:          "Submit the price of a fee token with a TWAP rate, as the amount of the EVM denom one unit is worth",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "price"}},
+				},
+				{
+					RpcMethod:      "WithdrawFeeTokens",
+					Use:            "withdraw-fee-tokens-proposal [recipient] [amount]",
+					Short:          "Submit a proposal to send the fee tokens collected by the feeabs module account",
+					Example:        fmt.Sprintf(`%s tx feeabs withdraw-fee-tokens-proposal tac1... 1000000ibc/...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "amount", Varargs: true}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
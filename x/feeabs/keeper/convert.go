@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+// ConvertFee swaps a fee paid in a fee token for the EVM denom it is worth,
+// rounded down. The fee tokens are sent from the account to the module
+// account, which pays the EVM denom out of its reserve. It returns the
+// converted fee.
+func (k Keeper) ConvertFee(ctx context.Context, account sdk.AccAddress, fee sdk.Coin) (sdk.Coin, error) {
+	rate, _, err := k.Rate(ctx, fee.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	converted := sdk.NewCoin(evmtypes.GetEVMCoinDenom(), rate.MulInt(fee.Amount).TruncateInt())
+	if !converted.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "fee %s is worth no %s", fee, converted.Denom)
+	}
+
+	return converted, k.swap(ctx, account, fee, converted)
+}
+
+// ConvertFeeExact swaps the amount of a fee token worth at least the given
+// fee in the EVM denom for that fee. It returns the amount of fee tokens
+// spent.
+func (k Keeper) ConvertFeeExact(ctx context.Context, account sdk.AccAddress, denom string, fee math.Int) (sdk.Coin, error) {
+	rate, _, err := k.Rate(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	spent := sdk.NewCoin(denom, math.LegacyNewDecFromInt(fee).Quo(rate).Ceil().TruncateInt())
+	return spent, k.swap(ctx, account, spent, sdk.NewCoin(evmtypes.GetEVMCoinDenom(), fee))
+}
+
+func (k Keeper) swap(ctx context.Context, account sdk.AccAddress, feeToken, fee sdk.Coin) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, account, types.ModuleName, sdk.NewCoins(feeToken)); err != nil {
+		return errorsmod.Wrapf(err, "failed to pay fee in %s", feeToken.Denom)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, account, sdk.NewCoins(fee)); err != nil {
+		return errorsmod.Wrapf(err, "insufficient %s reserve to convert fee", fee.Denom)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertFee,
+			sdk.NewAttribute(types.AttributeKeyAccount, account.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeToken, feeToken.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+	return nil
+}
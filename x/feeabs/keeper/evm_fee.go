@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+// SetEVMFeePayment records the fee of the EVM tx with the given hash, paid
+// through an extension option, until RefundEVMFee settles it.
+func (k Keeper) SetEVMFeePayment(ctx context.Context, txHash string, payment types.EVMFeePayment) error {
+	return k.EVMFeePayments.Set(ctx, txHash, payment)
+}
+
+// RefundEVMFee settles the fee of the EVM tx with the given hash once x/vm
+// has charged it gasUsed gas, at least its min gas multiplier share of the
+// gas limit, and refunded the fee paid for the rest of the gas limit to the
+// sender in the EVM denom. If the fee was granted, that refund is returned to
+// the granter. Otherwise that share of the fee tokens swapped for the fee,
+// rounded down, is swapped back so that only the gas used is paid in the fee
+// token. Txs without a recorded fee payment are ignored.
+func (k Keeper) RefundEVMFee(ctx context.Context, txHash string, sender sdk.AccAddress, gasUsed uint64) error {
+	payment, err := k.EVMFeePayments.Get(ctx, txHash)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.EVMFeePayments.Remove(ctx, txHash); err != nil {
+		return err
+	}
+
+	if gasUsed >= payment.GasLimit {
+		return nil
+	}
+	unused := math.NewIntFromUint64(payment.GasLimit - gasUsed)
+	gasLimit := math.NewIntFromUint64(payment.GasLimit)
+	refund := sdk.NewCoin(payment.Fee.Denom, payment.Fee.Amount.Mul(unused).Quo(gasLimit))
+	if !refund.IsPositive() {
+		return nil
+	}
+
+	if payment.FeeGranter != "" {
+		return k.refundGranter(ctx, sender, payment.FeeGranter, refund)
+	}
+
+	feeTokenRefund := sdk.NewCoin(payment.FeeToken.Denom, payment.FeeToken.Amount.Mul(unused).Quo(gasLimit))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(refund)); err != nil {
+		return errorsmod.Wrapf(err, "failed to take back the refunded %s", refund)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(feeTokenRefund)); err != nil {
+		return errorsmod.Wrapf(err, "failed to refund %s", feeTokenRefund)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundFee,
+			sdk.NewAttribute(types.AttributeKeyAccount, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeToken, feeTokenRefund.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, refund.String()),
+		),
+	)
+	return nil
+}
+
+// refundGranter returns the refund of a granted EVM fee from the sender to the
+// granter.
+func (k Keeper) refundGranter(ctx context.Context, sender sdk.AccAddress, feeGranter string, refund sdk.Coin) error {
+	granter, err := sdk.AccAddressFromBech32(feeGranter)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, sender, granter, sdk.NewCoins(refund)); err != nil {
+		return errorsmod.Wrapf(err, "failed to refund %s to %s", refund, granter)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundFee,
+			sdk.NewAttribute(types.AttributeKeyAccount, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, refund.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+// InitGenesis initializes the feeabs module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, observation := range data.PriceObservations {
+		if err := k.PriceObservations.Set(ctx, collections.Join(observation.Denom, observation.Time), observation.Price); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var observations []types.PriceObservation
+	err = k.PriceObservations.Walk(ctx, nil, func(key collections.Pair[string, int64], price math.LegacyDec) (bool, error) {
+		observations = append(observations, types.PriceObservation{Denom: key.K1(), Time: key.K2(), Price: price})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, observations)
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+// Keeper of the feeabs store
+type Keeper struct {
+	cdc                   codec.BinaryCodec
+	storeService          storetypes.KVStoreService
+	transientStoreService storetypes.TransientStoreService
+	bankKeeper            types.BankKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// PriceObservations are the submitted prices by fee token denom and unix
+	// time in seconds.
+	PriceObservations collections.Map[collections.Pair[string, int64], math.LegacyDec]
+
+	// EVMFeePayments are the fees of the EVM txs of the current block paid
+	// through an extension option, by tx hash.
+	EVMFeePayments collections.Map[string, types.EVMFeePayment]
+}
+
+// NewKeeper creates a new feeabs Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	transientStoreService storetypes.TransientStoreService,
+	bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	tsb := collections.NewSchemaBuilderFromAccessor(transientStoreService.OpenTransientStore)
+	k := Keeper{
+		cdc:                   cdc,
+		storeService:          storeService,
+		transientStoreService: transientStoreService,
+		bankKeeper:            bankKeeper,
+		authority:             authority,
+		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		PriceObservations: collections.NewMap(
+			sb,
+			types.PriceObservationsKey,
+			"price_observations",
+			collections.PairKeyCodec(collections.StringKey, collections.Int64Key),
+			sdk.LegacyDecValue,
+		),
+		EVMFeePayments: collections.NewMap(
+			tsb, types.EVMFeePaymentsKey, "evm_fee_payments", collections.StringKey, codec.CollValue[types.EVMFeePayment](cdc),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	if _, err := tsb.Build(); err != nil {
+		panic(err)
+	}
+
+	return k
+}
+
+// GetAuthority returns the x/feeabs module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
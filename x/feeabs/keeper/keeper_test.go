@@ -0,0 +1,304 @@
+package keeper_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs"
+	"github.com/TacBuild/tacchain/x/feeabs/keeper"
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+const (
+	evmDenom    = "utac"
+	staticToken = "ibc/static"
+	twapToken   = "erc20/twap"
+)
+
+var feeder = sdk.AccAddress("feeder______________")
+
+func TestMain(m *testing.M) {
+	if err := evmtypes.NewEVMConfigurator().WithEVMCoinInfo(evmtypes.EvmCoinInfo{
+		Denom:         evmDenom,
+		ExtendedDenom: evmDenom,
+		DisplayDenom:  "tac",
+		Decimals:      evmtypes.EighteenDecimals.Uint32(),
+	}).Configure(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// mockBankKeeper keeps the balances of accounts and module accounts by
+// address.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (bk *mockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := bk.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s < %s", bk.balances[from.String()], amt)
+	}
+	bk.balances[from.String()] = balance
+	bk.balances[to.String()] = bk.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (bk *mockBankKeeper) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return bk.send(fromAddr, toAddr, amt)
+}
+
+func (bk *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return bk.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (bk *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return bk.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+type fixture struct {
+	ctx        sdk.Context
+	keeper     keeper.Keeper
+	bankKeeper *mockBankKeeper
+	authority  string
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(feeabs.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey(types.TransientStoreKey)
+	ctx := testutil.DefaultContext(key, tkey).
+		WithBlockTime(time.Unix(1_000_000, 0))
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	bankKeeper := &mockBankKeeper{balances: map[string]sdk.Coins{}}
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), runtime.NewTransientStoreService(tkey), bankKeeper, authority)
+
+	params := types.NewParams([]types.FeeToken{
+		{Denom: staticToken, RateSource: types.RATE_SOURCE_STATIC, StaticRate: math.LegacyMustNewDecFromStr("2.5")},
+		{Denom: twapToken, RateSource: types.RATE_SOURCE_TWAP, StaticRate: math.LegacyZeroDec(), PriceFeeder: feeder.String()},
+	}, 100, 100, math.LegacyZeroDec())
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	return &fixture{ctx: ctx, keeper: k, bankKeeper: bankKeeper, authority: authority}
+}
+
+// submitPriceAt submits a price of the TWAP token at the unix time.
+func (f *fixture) submitPriceAt(t *testing.T, time int64, price string) {
+	t.Helper()
+	ctx := f.ctx.WithBlockTime(unix(time))
+	require.NoError(t, f.keeper.SubmitPrice(ctx, feeder, twapToken, math.LegacyMustNewDecFromStr(price)))
+}
+
+func unix(sec int64) time.Time { return time.Unix(sec, 0) }
+
+func TestRate(t *testing.T) {
+	f := newFixture(t)
+
+	rate, source, err := f.keeper.Rate(f.ctx, staticToken)
+	require.NoError(t, err)
+	require.Equal(t, types.RATE_SOURCE_STATIC, source)
+	require.Equal(t, math.LegacyMustNewDecFromStr("2.5"), rate)
+
+	_, _, err = f.keeper.Rate(f.ctx, "unknown")
+	require.ErrorIs(t, err, types.ErrUnsupportedFeeToken)
+
+	_, _, err = f.keeper.Rate(f.ctx, twapToken)
+	require.ErrorIs(t, err, types.ErrStaleRate)
+}
+
+func TestTWAP(t *testing.T) {
+	f := newFixture(t)
+
+	// a single price is the rate right away
+	f.submitPriceAt(t, 1_000, "4")
+	rate, _, err := f.keeper.Rate(f.ctx.WithBlockTime(unix(1_000)), twapToken)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(4), rate)
+
+	// 4 over [1000, 1040), 10 over [1040, 1050]: (4*40 + 10*10) / 50
+	f.submitPriceAt(t, 1_040, "10")
+	rate, _, err = f.keeper.Rate(f.ctx.WithBlockTime(unix(1_050)), twapToken)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("5.2"), rate)
+
+	// the window [1100, 1200] starts with the price submitted at 1040:
+	// 10 over [1100, 1150), 20 over [1150, 1200]
+	f.submitPriceAt(t, 1_150, "20")
+	rate, _, err = f.keeper.Rate(f.ctx.WithBlockTime(unix(1_200)), twapToken)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(15), rate)
+
+	// pruning kept the price in effect at the window start and dropped the older one
+	f.submitPriceAt(t, 1_200, "20")
+	has, err := f.keeper.PriceObservations.Has(f.ctx, collections.Join(twapToken, int64(1_000)))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.PriceObservations.Has(f.ctx, collections.Join(twapToken, int64(1_040)))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// no price within the window
+	_, _, err = f.keeper.Rate(f.ctx.WithBlockTime(unix(1_301)), twapToken)
+	require.ErrorIs(t, err, types.ErrStaleRate)
+}
+
+func TestSubmitPrice(t *testing.T) {
+	f := newFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	_, err := msgServer.SubmitPrice(f.ctx, &types.MsgSubmitPrice{Feeder: sdk.AccAddress("other_______________").String(), Denom: twapToken, Price: math.LegacyOneDec()})
+	require.ErrorIs(t, err, types.ErrUnauthorizedFeeder)
+
+	_, err = msgServer.SubmitPrice(f.ctx, &types.MsgSubmitPrice{Feeder: feeder.String(), Denom: staticToken, Price: math.LegacyOneDec()})
+	require.ErrorIs(t, err, types.ErrUnsupportedFeeToken)
+
+	_, err = msgServer.SubmitPrice(f.ctx, &types.MsgSubmitPrice{Feeder: feeder.String(), Denom: twapToken, Price: math.LegacyZeroDec()})
+	require.ErrorContains(t, err, "price must be positive")
+
+	_, err = msgServer.SubmitPrice(f.ctx, &types.MsgSubmitPrice{Feeder: feeder.String(), Denom: twapToken, Price: math.LegacyOneDec()})
+	require.NoError(t, err)
+
+	// dropping the TWAP token removes its prices
+	params := types.DefaultParams()
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.authority, Params: params})
+	require.NoError(t, err)
+	has, err := f.keeper.PriceObservations.Has(f.ctx, collections.Join(twapToken, f.ctx.BlockTime().Unix()))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestConvertFee(t *testing.T) {
+	f := newFixture(t)
+	account := sdk.AccAddress("account_____________")
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	f.bankKeeper.balances[account.String()] = sdk.NewCoins(sdk.NewInt64Coin(staticToken, 1_000))
+	f.bankKeeper.balances[moduleAddr.String()] = sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 1_000))
+
+	// 101 tokens at 2.5 are worth 252.5, rounded down
+	converted, err := f.keeper.ConvertFee(f.ctx, account, sdk.NewInt64Coin(staticToken, 101))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(evmDenom, 252), converted)
+
+	// 101 utac cost 40.4 tokens, rounded up
+	spent, err := f.keeper.ConvertFeeExact(f.ctx, account, staticToken, math.NewInt(101))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(staticToken, 41), spent)
+
+	require.Equal(t, "858ibc/static,353utac", f.bankKeeper.balances[account.String()].String())
+	require.Equal(t, "142ibc/static,647utac", f.bankKeeper.balances[moduleAddr.String()].String())
+
+	// the reserve cannot cover the fee
+	_, err = f.keeper.ConvertFee(f.ctx, account, sdk.NewInt64Coin(staticToken, 800))
+	require.ErrorContains(t, err, "insufficient utac reserve")
+
+	// the TWAP token has no price yet
+	_, err = f.keeper.ConvertFee(f.ctx, account, sdk.NewInt64Coin(twapToken, 1))
+	require.ErrorIs(t, err, types.ErrStaleRate)
+}
+
+func TestMaxPriceAge(t *testing.T) {
+	f := newFixture(t)
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.MaxPriceAge = 30
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	f.submitPriceAt(t, 1_000, "4")
+	_, _, err = f.keeper.Rate(f.ctx.WithBlockTime(unix(1_030)), twapToken)
+	require.NoError(t, err)
+
+	// the price is still within the TWAP window but older than the max age
+	_, _, err = f.keeper.Rate(f.ctx.WithBlockTime(unix(1_031)), twapToken)
+	require.ErrorIs(t, err, types.ErrStaleRate)
+}
+
+func TestPriceDeviation(t *testing.T) {
+	f := newFixture(t)
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.MaxPriceDeviation = math.LegacyMustNewDecFromStr("0.2")
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// the first price sets the rate
+	f.submitPriceAt(t, 1_000, "10")
+
+	err = f.keeper.SubmitPrice(f.ctx.WithBlockTime(unix(1_010)), feeder, twapToken, math.LegacyMustNewDecFromStr("12.1"))
+	require.ErrorIs(t, err, types.ErrPriceDeviation)
+	err = f.keeper.SubmitPrice(f.ctx.WithBlockTime(unix(1_010)), feeder, twapToken, math.LegacyMustNewDecFromStr("7.9"))
+	require.ErrorIs(t, err, types.ErrPriceDeviation)
+	f.submitPriceAt(t, 1_010, "12")
+
+	// once the rate is stale, any price is accepted
+	f.submitPriceAt(t, 1_200, "100")
+}
+
+func TestRefundEVMFee(t *testing.T) {
+	f := newFixture(t)
+	sender := sdk.AccAddress("sender______________")
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	txHash := "0xabcd"
+
+	// 100,000 gas at 3 utac paid with 120,000 fee tokens, of which x/vm
+	// refunded the 3 * 25,000 utac of the unused gas
+	f.bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 75_000))
+	f.bankKeeper.balances[moduleAddr.String()] = sdk.NewCoins(sdk.NewInt64Coin(staticToken, 120_000))
+	payment := types.EVMFeePayment{
+		Fee:      sdk.NewInt64Coin(evmDenom, 300_000),
+		GasLimit: 100_000,
+		FeeToken: sdk.NewInt64Coin(staticToken, 120_000),
+	}
+	require.NoError(t, f.keeper.SetEVMFeePayment(f.ctx, txHash, payment))
+
+	require.NoError(t, f.keeper.RefundEVMFee(f.ctx, txHash, sender, 75_000))
+	require.Equal(t, "30000ibc/static", f.bankKeeper.balances[sender.String()].String())
+	require.Equal(t, "90000ibc/static,75000utac", f.bankKeeper.balances[moduleAddr.String()].String())
+
+	// the payment is settled once
+	has, err := f.keeper.EVMFeePayments.Has(f.ctx, txHash)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.NoError(t, f.keeper.RefundEVMFee(f.ctx, txHash, sender, 0))
+	require.Equal(t, "30000ibc/static", f.bankKeeper.balances[sender.String()].String())
+
+	// all the gas was used
+	require.NoError(t, f.keeper.SetEVMFeePayment(f.ctx, txHash, payment))
+	require.NoError(t, f.keeper.RefundEVMFee(f.ctx, txHash, sender, 100_000))
+	require.Equal(t, "30000ibc/static", f.bankKeeper.balances[sender.String()].String())
+
+	// the refund of a granted fee goes back to the granter
+	granter := sdk.AccAddress("granter_____________")
+	f.bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 75_000))
+	payment = types.EVMFeePayment{
+		Fee:        sdk.NewInt64Coin(evmDenom, 300_000),
+		GasLimit:   100_000,
+		FeeToken:   sdk.Coin{Amount: math.ZeroInt()},
+		FeeGranter: granter.String(),
+	}
+	require.NoError(t, f.keeper.SetEVMFeePayment(f.ctx, txHash, payment))
+	require.NoError(t, f.keeper.RefundEVMFee(f.ctx, txHash, sender, 75_000))
+	require.True(t, f.bankKeeper.balances[sender.String()].IsZero())
+	require.Equal(t, "75000utac", f.bankKeeper.balances[granter.String()].String())
+}
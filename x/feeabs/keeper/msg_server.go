@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/feeabs MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// UpdateParams updates the params. The prices of the fee tokens no longer
+// using a TWAP rate are removed.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	oldParams, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, feeToken := range oldParams.FeeTokens {
+		if newFeeToken, ok := msg.Params.FeeToken(feeToken.Denom); ok && newFeeToken.RateSource == types.RATE_SOURCE_TWAP {
+			continue
+		}
+		if err := ms.clearPriceObservations(ctx, feeToken.Denom); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SubmitPrice records a price of a fee token with a TWAP rate.
+func (ms msgServer) SubmitPrice(ctx context.Context, msg *types.MsgSubmitPrice) (*types.MsgSubmitPriceResponse, error) {
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid feeder address: %s", err)
+	}
+
+	if msg.Price.IsNil() || !msg.Price.IsPositive() {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "price must be positive: %s", msg.Price)
+	}
+
+	if err := ms.Keeper.SubmitPrice(ctx, feeder, msg.Denom, msg.Price); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitPriceResponse{}, nil
+}
+
+// WithdrawFeeTokens sends tokens held by the module account to the
+// recipient.
+func (ms msgServer) WithdrawFeeTokens(ctx context.Context, msg *types.MsgWithdrawFeeTokens) (*types.MsgWithdrawFeeTokensResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawFeeTokensResponse{}, nil
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+// Rate returns the amount of the EVM denom one unit of the fee token is
+// worth.
+func (k Keeper) Rate(ctx context.Context, denom string) (math.LegacyDec, types.RateSource, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, types.RATE_SOURCE_UNSPECIFIED, err
+	}
+
+	feeToken, ok := params.FeeToken(denom)
+	if !ok {
+		return math.LegacyDec{}, types.RATE_SOURCE_UNSPECIFIED, errorsmod.Wrap(types.ErrUnsupportedFeeToken, denom)
+	}
+
+	if feeToken.RateSource == types.RATE_SOURCE_STATIC {
+		return feeToken.StaticRate, feeToken.RateSource, nil
+	}
+
+	rate, err := k.twapRate(ctx, params, denom)
+	return rate, feeToken.RateSource, err
+}
+
+// twapRate returns the TWAP rate of the fee token at the block time, unless
+// its last price is older than the max price age.
+func (k Keeper) twapRate(ctx context.Context, params types.Params, denom string) (math.LegacyDec, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	last, err := k.lastObservationAtOrBefore(ctx, denom, now)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if last == nil || now-last.Time > int64(params.MaxPriceAge) {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrStaleRate, "no price of %s submitted in the last %d seconds", denom, params.MaxPriceAge)
+	}
+
+	return k.twap(ctx, denom, now-int64(params.TwapWindow), now)
+}
+
+// twap returns the time-weighted average of the prices of the fee token
+// between start and now. The price in effect at start is the last one
+// submitted before it. At least one price must have been submitted in the
+// window.
+func (k Keeper) twap(ctx context.Context, denom string, start, now int64) (math.LegacyDec, error) {
+	var (
+		prevTime  int64
+		prevPrice math.LegacyDec
+		weighted  = math.LegacyZeroDec()
+		inWindow  bool
+	)
+
+	before, err := k.lastObservationAtOrBefore(ctx, denom, start)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if before != nil {
+		prevTime, prevPrice = start, before.Price
+	}
+
+	rng := collections.NewPrefixedPairRange[string, int64](denom).StartExclusive(start).EndInclusive(now)
+	err = k.PriceObservations.Walk(ctx, rng, func(key collections.Pair[string, int64], price math.LegacyDec) (bool, error) {
+		if !prevPrice.IsNil() {
+			weighted = weighted.Add(prevPrice.MulInt64(key.K2() - prevTime))
+		} else {
+			// no price before the window: average from the first one
+			start = key.K2()
+		}
+		prevTime, prevPrice = key.K2(), price
+		inWindow = true
+		return false, nil
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if !inWindow {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrStaleRate, "no price of %s submitted since %d", denom, start)
+	}
+
+	if now == start {
+		return prevPrice, nil
+	}
+	weighted = weighted.Add(prevPrice.MulInt64(now - prevTime))
+	return weighted.QuoInt64(now - start), nil
+}
+
+// lastObservationAtOrBefore returns the last price of the fee token submitted
+// at or before the time, or nil if there is none.
+func (k Keeper) lastObservationAtOrBefore(ctx context.Context, denom string, time int64) (*types.PriceObservation, error) {
+	rng := collections.NewPrefixedPairRange[string, int64](denom).EndInclusive(time).Descending()
+	iter, err := k.PriceObservations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, nil
+	}
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return nil, err
+	}
+	return &types.PriceObservation{Denom: denom, Time: kv.Key.K2(), Price: kv.Value}, nil
+}
+
+// SubmitPrice records a price of a fee token at the block time, and prunes
+// the prices no longer needed to compute its TWAP. Unless the rate is stale,
+// the price may not deviate from it by more than the max price deviation.
+func (k Keeper) SubmitPrice(ctx context.Context, feeder sdk.AccAddress, denom string, price math.LegacyDec) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	feeToken, ok := params.FeeToken(denom)
+	if !ok || feeToken.RateSource != types.RATE_SOURCE_TWAP {
+		return errorsmod.Wrapf(types.ErrUnsupportedFeeToken, "%s does not have a TWAP rate", denom)
+	}
+	if feeToken.PriceFeeder != feeder.String() {
+		return errorsmod.Wrapf(types.ErrUnauthorizedFeeder, "expected %s, got %s", feeToken.PriceFeeder, feeder)
+	}
+
+	if err := k.checkPriceDeviation(ctx, params, denom, price); err != nil {
+		return err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	if err := k.PriceObservations.Set(ctx, collections.Join(denom, now), price); err != nil {
+		return err
+	}
+
+	return k.prunePriceObservations(ctx, denom, now-int64(params.TwapWindow))
+}
+
+// checkPriceDeviation checks that the price does not deviate from the TWAP
+// rate of the fee token by more than the max price deviation.
+func (k Keeper) checkPriceDeviation(ctx context.Context, params types.Params, denom string, price math.LegacyDec) error {
+	if !params.MaxPriceDeviation.IsPositive() {
+		return nil
+	}
+
+	rate, err := k.twapRate(ctx, params, denom)
+	if errors.Is(err, types.ErrStaleRate) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if deviation := price.Sub(rate).Abs().Quo(rate); deviation.GT(params.MaxPriceDeviation) {
+		return errorsmod.Wrapf(types.ErrPriceDeviation, "price %s deviates from the rate %s by more than %s", price, rate, params.MaxPriceDeviation)
+	}
+	return nil
+}
+
+// prunePriceObservations removes the prices of the fee token submitted before
+// the window start, except the last one which is still in effect at start.
+func (k Keeper) prunePriceObservations(ctx context.Context, denom string, start int64) error {
+	last, err := k.lastObservationAtOrBefore(ctx, denom, start)
+	if err != nil || last == nil {
+		return err
+	}
+
+	rng := collections.NewPrefixedPairRange[string, int64](denom).EndExclusive(last.Time)
+	return k.PriceObservations.Clear(ctx, rng)
+}
+
+// clearPriceObservations removes all the prices of the fee token.
+func (k Keeper) clearPriceObservations(ctx context.Context, denom string) error {
+	return k.PriceObservations.Clear(ctx, collections.NewPrefixedPairRange[string, int64](denom))
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(Params{}, "tacchain/x/feeabs/Params", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "tacchain/x/feeabs/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitPrice{}, "tacchain/x/feeabs/MsgSubmitPrice")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawFeeTokens{}, "tacchain/x/feeabs/MsgWithdrawFeeTokens")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgSubmitPrice{},
+		&MsgWithdrawFeeTokens{},
+	)
+	registry.RegisterImplementations(
+		(*tx.TxExtensionOptionI)(nil),
+		&ExtensionOptionFeeToken{},
+		&ExtensionOptionFeeGrant{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
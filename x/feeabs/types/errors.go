@@ -0,0 +1,11 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/feeabs module sentinel errors
+var (
+	ErrUnsupportedFeeToken = errorsmod.Register(ModuleName, 2, "unsupported fee token")
+	ErrStaleRate           = errorsmod.Register(ModuleName, 3, "stale fee token rate")
+	ErrUnauthorizedFeeder  = errorsmod.Register(ModuleName, 4, "unauthorized price feeder")
+	ErrPriceDeviation      = errorsmod.Register(ModuleName, 5, "price deviates too much from the rate")
+)
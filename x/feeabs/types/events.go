@@ -0,0 +1,11 @@
+package types
+
+// x/feeabs module event types
+const (
+	EventTypeConvertFee = "convert_fee"
+	EventTypeRefundFee  = "refund_fee"
+
+	AttributeKeyAccount  = "account"
+	AttributeKeyFeeToken = "fee_token"
+	AttributeKeyFee      = "fee"
+)
@@ -0,0 +1,1761 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tacchain/feeabs/v1/feeabs.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RateSource enumerates where the conversion rate of a fee token comes from.
+type RateSource int32
+
+const (
+	// RATE_SOURCE_UNSPECIFIED is an invalid source.
+	RATE_SOURCE_UNSPECIFIED RateSource = 0
+	// RATE_SOURCE_STATIC uses the static_rate of the fee token.
+	RATE_SOURCE_STATIC RateSource = 1
+	// RATE_SOURCE_TWAP uses the time-weighted average of the prices submitted
+	// by the price_feeder of the fee token over the twap_window.
+	RATE_SOURCE_TWAP RateSource = 2
+)
+
+var RateSource_name = map[int32]string{
+	0: "RATE_SOURCE_UNSPECIFIED",
+	1: "RATE_SOURCE_STATIC",
+	2: "RATE_SOURCE_TWAP",
+}
+
+var RateSource_value = map[string]int32{
+	"RATE_SOURCE_UNSPECIFIED": 0,
+	"RATE_SOURCE_STATIC":      1,
+	"RATE_SOURCE_TWAP":        2,
+}
+
+func (x RateSource) String() string {
+	return proto.EnumName(RateSource_name, int32(x))
+}
+
+func (RateSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_07fd3deb68c667af, []int{0}
+}
+
+// Params defines the parameters for the feeabs module.
+type Params struct {
+	// fee_tokens are the denoms, besides the EVM denom, fees can be paid in.
+	FeeTokens []FeeToken `protobuf:"bytes,1,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+	// twap_window is the number of seconds TWAP rates average the submitted
+	// prices over.
+	TwapWindow uint64 `protobuf:"varint,2,opt,name=twap_window,json=twapWindow,proto3" json:"twap_window,omitempty"`
+	// max_price_age is the number of seconds after the last submitted price a
+	// TWAP rate is stale and cannot be used. It cannot exceed the twap_window.
+	MaxPriceAge uint64 `protobuf:"varint,3,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+	// max_price_deviation is the largest relative change a submitted price may
+	// make to the TWAP rate in effect, zero allowing any. Prices submitted while
+	// the rate is stale are not bound.
+	MaxPriceDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_deviation"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07fd3deb68c667af, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+func (m *Params) GetTwapWindow() uint64 {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPriceAge() uint64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+// FeeToken is a denom accepted for fees, e.g. an IBC denom or the bank denom
+// of an ERC20 token pair, and how it converts to the EVM denom.
+type FeeToken struct {
+	// denom is the bank denom of the token.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate_source is where the conversion rate comes from.
+	RateSource RateSource `protobuf:"varint,2,opt,name=rate_source,json=rateSource,proto3,enum=tacchain.feeabs.v1.RateSource" json:"rate_source,omitempty"`
+	// static_rate is the amount of the EVM denom one unit of the token is worth,
+	// used with RATE_SOURCE_STATIC.
+	StaticRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=static_rate,json=staticRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"static_rate"`
+	// price_feeder is the account allowed to submit the prices of the token,
+	// used with RATE_SOURCE_TWAP.
+	PriceFeeder string `protobuf:"bytes,4,opt,name=price_feeder,json=priceFeeder,proto3" json:"price_feeder,omitempty"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07fd3deb68c667af, []int{1}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeToken) GetRateSource() RateSource {
+	if m != nil {
+		return m.RateSource
+	}
+	return RATE_SOURCE_UNSPECIFIED
+}
+
+func (m *FeeToken) GetPriceFeeder() string {
+	if m != nil {
+		return m.PriceFeeder
+	}
+	return ""
+}
+
+// PriceObservation is a price submitted by the price feeder of a fee token.
+type PriceObservation struct {
+	// denom is the fee token denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// time is the unix time, in seconds, of the block the price was submitted in.
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// price is the amount of the EVM denom one unit of the token is worth.
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+}
+
+func (m *PriceObservation) Reset()         { *m = PriceObservation{} }
+func (m *PriceObservation) String() string { return proto.CompactTextString(m) }
+func (*PriceObservation) ProtoMessage()    {}
+func (*PriceObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07fd3deb68c667af, []int{2}
+}
+func (m *PriceObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceObservation.Merge(m, src)
+}
+func (m *PriceObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceObservation proto.InternalMessageInfo
+
+func (m *PriceObservation) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PriceObservation) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// ExtensionOptionFeeToken is the extension option of cosmos txs carrying
+// MsgEthereumTxs whose fees are paid in a fee token. It replaces the
+// ExtensionOptionsEthereumTx option.
+type ExtensionOptionFeeToken struct {
+	// denom is the fee token the EVM fees are paid in.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *ExtensionOptionFeeToken) Reset()         { *m = ExtensionOptionFeeToken{} }
+func (m *ExtensionOptionFeeToken) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionFeeToken) ProtoMessage()    {}
+func (*ExtensionOptionFeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07fd3deb68c667af, []int{3}
+}
+func (m *ExtensionOptionFeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionFeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionFeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionFeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionFeeToken.Merge(m, src)
+}
+func (m *ExtensionOptionFeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionFeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionFeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionFeeToken proto.InternalMessageInfo
+
+func (m *ExtensionOptionFeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// ExtensionOptionFeeGrant is the extension option of cosmos txs carrying
+// MsgEthereumTxs whose fees are paid by a feegrant granter of their senders.
+// It replaces the ExtensionOptionsEthereumTx option. As the option is not
+// covered by the Ethereum signature, the access list of each MsgEthereumTx
+// must name the granter for the sender to consent to it.
+type ExtensionOptionFeeGrant struct {
+	// fee_granter is the account whose feegrant allowance covers the EVM fees.
+	FeeGranter string `protobuf:"bytes,1,opt,name=fee_granter,json=feeGranter,proto3" json:"fee_granter,omitempty"`
+}
+
+func (m *ExtensionOptionFeeGrant) Reset()         { *m = ExtensionOptionFeeGrant{} }
+func (m *ExtensionOptionFeeGrant) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionFeeGrant) ProtoMessage()    {}
+func (*ExtensionOptionFeeGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07fd3deb68c667af, []int{4}
+}
+func (m *ExtensionOptionFeeGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionFeeGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionFeeGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionFeeGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionFeeGrant.Merge(m, src)
+}
+func (m *ExtensionOptionFeeGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionFeeGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionFeeGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionFeeGrant proto.InternalMessageInfo
+
+func (m *ExtensionOptionFeeGrant) GetFeeGranter() string {
+	if m != nil {
+		return m.FeeGranter
+	}
+	return ""
+}
+
+// EVMFeePayment is the fee of a MsgEthereumTx paid through an extension
+// option, kept until the tx is executed to settle the share x/vm refunds for
+// unused gas.
+type EVMFeePayment struct {
+	// fee is the fee in the EVM denom the sender was provided with.
+	Fee types.Coin `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	// gas_limit is the gas limit of the tx the fee pays for.
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// fee_token is the amount of the fee token swapped for the fee, if it was
+	// paid in a fee token.
+	FeeToken types.Coin `protobuf:"bytes,3,opt,name=fee_token,json=feeToken,proto3" json:"fee_token"`
+	// fee_granter is the feegrant granter who paid the fee, if it was granted.
+	FeeGranter string `protobuf:"bytes,4,opt,name=fee_granter,json=feeGranter,proto3" json:"fee_granter,omitempty"`
+}
+
+func (m *EVMFeePayment) Reset()         { *m = EVMFeePayment{} }
+func (m *EVMFeePayment) String() string { return proto.CompactTextString(m) }
+func (*EVMFeePayment) ProtoMessage()    {}
+func (*EVMFeePayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07fd3deb68c667af, []int{5}
+}
+func (m *EVMFeePayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EVMFeePayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EVMFeePayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EVMFeePayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMFeePayment.Merge(m, src)
+}
+func (m *EVMFeePayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *EVMFeePayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMFeePayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMFeePayment proto.InternalMessageInfo
+
+func (m *EVMFeePayment) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *EVMFeePayment) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *EVMFeePayment) GetFeeToken() types.Coin {
+	if m != nil {
+		return m.FeeToken
+	}
+	return types.Coin{}
+}
+
+func (m *EVMFeePayment) GetFeeGranter() string {
+	if m != nil {
+		return m.FeeGranter
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("tacchain.feeabs.v1.RateSource", RateSource_name, RateSource_value)
+	proto.RegisterType((*Params)(nil), "tacchain.feeabs.v1.Params")
+	proto.RegisterType((*FeeToken)(nil), "tacchain.feeabs.v1.FeeToken")
+	proto.RegisterType((*PriceObservation)(nil), "tacchain.feeabs.v1.PriceObservation")
+	proto.RegisterType((*ExtensionOptionFeeToken)(nil), "tacchain.feeabs.v1.ExtensionOptionFeeToken")
+	proto.RegisterType((*ExtensionOptionFeeGrant)(nil), "tacchain.feeabs.v1.ExtensionOptionFeeGrant")
+	proto.RegisterType((*EVMFeePayment)(nil), "tacchain.feeabs.v1.EVMFeePayment")
+}
+
+func init() { proto.RegisterFile("tacchain/feeabs/v1/feeabs.proto", fileDescriptor_07fd3deb68c667af) }
+
+var fileDescriptor_07fd3deb68c667af = []byte{
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x4e, 0x23, 0x47,
+	0x10, 0xf6, 0x60, 0x83, 0x70, 0x4d, 0x88, 0x4c, 0xc7, 0x0a, 0x03, 0x24, 0x63, 0xe4, 0x13, 0x22,
+	0x62, 0x46, 0x26, 0x12, 0x52, 0x92, 0x43, 0xe4, 0xdf, 0x08, 0x89, 0x04, 0x6b, 0x6c, 0x82, 0x14,
+	0x29, 0x1a, 0xb5, 0x67, 0xca, 0x43, 0x0b, 0x66, 0xda, 0x9a, 0x6e, 0x8c, 0x79, 0x83, 0x88, 0x53,
+	0x1e, 0x20, 0xb7, 0x5c, 0xf6, 0xc8, 0x81, 0x87, 0xe0, 0x88, 0x38, 0xad, 0xf6, 0x80, 0x56, 0xe6,
+	0xc0, 0x23, 0xec, 0x75, 0x35, 0x3f, 0x06, 0x76, 0x61, 0xff, 0xc4, 0xc5, 0xea, 0xfa, 0xea, 0xab,
+	0xea, 0xfa, 0xbe, 0x9e, 0x32, 0x94, 0x24, 0x75, 0x9c, 0x7d, 0xca, 0x02, 0xb3, 0x8f, 0x48, 0x7b,
+	0xc2, 0x1c, 0x56, 0xd2, 0x93, 0x31, 0x08, 0xb9, 0xe4, 0x84, 0x4c, 0x08, 0x46, 0x0a, 0x0f, 0x2b,
+	0x4b, 0x45, 0x8f, 0x7b, 0x3c, 0x4e, 0x9b, 0xd1, 0x29, 0x61, 0x2e, 0xcd, 0x53, 0x9f, 0x05, 0xdc,
+	0x8c, 0x7f, 0x53, 0x68, 0xd1, 0xe1, 0xc2, 0xe7, 0xc2, 0x4e, 0xb8, 0x49, 0x90, 0xa6, 0xf4, 0x24,
+	0x32, 0x7b, 0x54, 0xa0, 0x39, 0xac, 0xf4, 0x50, 0xd2, 0x8a, 0xe9, 0x70, 0x16, 0x24, 0xf9, 0xf2,
+	0x7f, 0x53, 0x30, 0xd3, 0xa6, 0x21, 0xf5, 0x05, 0x69, 0x01, 0xf4, 0x11, 0x6d, 0xc9, 0x0f, 0x30,
+	0x10, 0x9a, 0xb2, 0x92, 0x5d, 0x55, 0x37, 0xbe, 0x33, 0x1e, 0xcf, 0x65, 0xb4, 0x10, 0xbb, 0x11,
+	0xa9, 0x96, 0xbf, 0xb8, 0x2e, 0x65, 0x5e, 0xdc, 0x9e, 0xad, 0x29, 0x56, 0xbe, 0x9f, 0x82, 0x82,
+	0x94, 0x40, 0x95, 0xc7, 0x74, 0x60, 0x1f, 0xb3, 0xc0, 0xe5, 0xc7, 0xda, 0xd4, 0x8a, 0xb2, 0x9a,
+	0xb3, 0x20, 0x82, 0xf6, 0x62, 0x84, 0x94, 0x61, 0xce, 0xa7, 0x23, 0x7b, 0x10, 0x32, 0x07, 0x6d,
+	0xea, 0xa1, 0x96, 0x8d, 0x29, 0xaa, 0x4f, 0x47, 0xed, 0x08, 0xab, 0x7a, 0x48, 0xfa, 0xf0, 0xcd,
+	0x3d, 0xc7, 0xc5, 0x21, 0xa3, 0x92, 0xf1, 0x40, 0xcb, 0xad, 0x28, 0xab, 0xf9, 0xda, 0x66, 0x74,
+	0xef, 0xab, 0xeb, 0xd2, 0x72, 0x22, 0x4e, 0xb8, 0x07, 0x06, 0xe3, 0xa6, 0x4f, 0xe5, 0xbe, 0xb1,
+	0x8d, 0x1e, 0x75, 0x4e, 0x1a, 0xe8, 0x5c, 0x9d, 0xaf, 0x43, 0xea, 0x44, 0x03, 0x9d, 0x64, 0xc8,
+	0xf9, 0xc9, 0x0d, 0x8d, 0x49, 0xc3, 0x9f, 0xbf, 0x3f, 0xbd, 0x3d, 0x5b, 0xd3, 0xee, 0x5e, 0x67,
+	0x34, 0x79, 0x9f, 0xc4, 0x93, 0xf2, 0x1b, 0x05, 0x66, 0x27, 0x72, 0x49, 0x11, 0xa6, 0x5d, 0x0c,
+	0xb8, 0xaf, 0x29, 0xd1, 0x14, 0x56, 0x12, 0x90, 0x5f, 0x41, 0x0d, 0xa9, 0x44, 0x5b, 0xf0, 0xa3,
+	0xd0, 0xc1, 0x58, 0xee, 0xd7, 0x1b, 0xfa, 0x53, 0xbe, 0x59, 0x54, 0x62, 0x27, 0x66, 0x59, 0x10,
+	0xde, 0x9d, 0xc9, 0x1e, 0xa8, 0x42, 0x52, 0xc9, 0x1c, 0x3b, 0x02, 0xb5, 0xec, 0xb3, 0x24, 0x42,
+	0xd2, 0x2a, 0xba, 0x8a, 0xfc, 0x02, 0x5f, 0x25, 0xfe, 0xf5, 0x11, 0x5d, 0x0c, 0x53, 0xf3, 0xb4,
+	0xab, 0xf3, 0xf5, 0x62, 0x5a, 0x56, 0x75, 0xdd, 0x10, 0x85, 0xe8, 0xc8, 0x90, 0x05, 0x9e, 0xa5,
+	0xc6, 0xec, 0x56, 0x4c, 0x2e, 0x9f, 0x2a, 0x50, 0x88, 0xbd, 0xda, 0xe9, 0x09, 0x0c, 0x87, 0xb1,
+	0x5b, 0x1f, 0x70, 0x80, 0x40, 0x4e, 0x32, 0x3f, 0x91, 0x9e, 0xb5, 0xe2, 0x33, 0xd9, 0x86, 0xe9,
+	0xb8, 0xdb, 0x33, 0xe5, 0x24, 0x4d, 0xca, 0x26, 0x2c, 0x34, 0x47, 0x12, 0x03, 0xc1, 0x78, 0xb0,
+	0x33, 0x88, 0x46, 0xf9, 0xf8, 0xa3, 0x94, 0xbb, 0x4f, 0x15, 0xfc, 0x16, 0xd2, 0x40, 0x92, 0x9f,
+	0x40, 0x8d, 0x3e, 0x73, 0x2f, 0x0a, 0x30, 0xd4, 0x94, 0x4f, 0x98, 0x02, 0xfd, 0xb4, 0x10, 0xc3,
+	0xf2, 0x58, 0x81, 0xb9, 0xe6, 0x9f, 0xbf, 0xb7, 0x10, 0xdb, 0xf4, 0xc4, 0xc7, 0x40, 0x92, 0x4d,
+	0xc8, 0xf6, 0x11, 0xe3, 0x26, 0xea, 0xc6, 0xa2, 0x91, 0x76, 0x88, 0x96, 0xcd, 0x48, 0x97, 0xcd,
+	0xa8, 0x73, 0xf6, 0xce, 0xa6, 0x44, 0x05, 0x64, 0x19, 0xf2, 0x1e, 0x15, 0xf6, 0x21, 0xf3, 0x99,
+	0x4c, 0x37, 0x64, 0xd6, 0xa3, 0x62, 0x3b, 0x8a, 0x49, 0x15, 0xf2, 0x77, 0x8b, 0xa8, 0x65, 0xbf,
+	0xa0, 0xf5, 0xec, 0x64, 0x09, 0xdf, 0x17, 0x99, 0xfb, 0x7c, 0x91, 0x6b, 0x7f, 0x03, 0xdc, 0x7f,
+	0xa8, 0x64, 0x19, 0x16, 0xac, 0x6a, 0xb7, 0x69, 0x77, 0x76, 0x76, 0xad, 0x7a, 0xd3, 0xde, 0xfd,
+	0xa3, 0xd3, 0x6e, 0xd6, 0xb7, 0x5a, 0x5b, 0xcd, 0x46, 0x21, 0x43, 0xbe, 0x05, 0xf2, 0x30, 0xd9,
+	0xe9, 0x56, 0xbb, 0x5b, 0xf5, 0x82, 0x42, 0x8a, 0x50, 0x78, 0x88, 0x77, 0xf7, 0xaa, 0xed, 0xc2,
+	0xd4, 0x52, 0xee, 0x9f, 0xff, 0xf5, 0x4c, 0xad, 0x79, 0x31, 0xd6, 0x95, 0xcb, 0xb1, 0xae, 0xbc,
+	0x1e, 0xeb, 0xca, 0xbf, 0x37, 0x7a, 0xe6, 0xf2, 0x46, 0xcf, 0xbc, 0xbc, 0xd1, 0x33, 0x7f, 0xfd,
+	0xe0, 0x31, 0xb9, 0x7f, 0xd4, 0x33, 0x1c, 0xee, 0x9b, 0x5d, 0xea, 0xd4, 0x8e, 0xd8, 0xa1, 0x6b,
+	0x3e, 0xde, 0x4c, 0x79, 0x32, 0x40, 0xd1, 0x9b, 0x89, 0xff, 0xbe, 0x7e, 0x7c, 0x3b, 0x00, 0xae,
+	0x2c, 0x70, 0xb1, 0x59, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintFeeabs(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TwapWindow != 0 {
+		i = encodeVarintFeeabs(dAtA, i, uint64(m.TwapWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeabs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceFeeder) > 0 {
+		i -= len(m.PriceFeeder)
+		copy(dAtA[i:], m.PriceFeeder)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.PriceFeeder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.StaticRate.Size()
+		i -= size
+		if _, err := m.StaticRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RateSource != 0 {
+		i = encodeVarintFeeabs(dAtA, i, uint64(m.RateSource))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Time != 0 {
+		i = encodeVarintFeeabs(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionFeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionFeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionFeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionFeeGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionFeeGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionFeeGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EVMFeePayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMFeePayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMFeePayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.FeeToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GasLimit != 0 {
+		i = encodeVarintFeeabs(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeabs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeabs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovFeeabs(uint64(l))
+		}
+	}
+	if m.TwapWindow != 0 {
+		n += 1 + sovFeeabs(uint64(m.TwapWindow))
+	}
+	if m.MaxPriceAge != 0 {
+		n += 1 + sovFeeabs(uint64(m.MaxPriceAge))
+	}
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	return n
+}
+
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	if m.RateSource != 0 {
+		n += 1 + sovFeeabs(uint64(m.RateSource))
+	}
+	l = m.StaticRate.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	l = len(m.PriceFeeder)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovFeeabs(uint64(m.Time))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	return n
+}
+
+func (m *ExtensionOptionFeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtensionOptionFeeGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	return n
+}
+
+func (m *EVMFeePayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	if m.GasLimit != 0 {
+		n += 1 + sovFeeabs(uint64(m.GasLimit))
+	}
+	l = m.FeeToken.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	return n
+}
+
+func sovFeeabs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeabs(x uint64) (n int) {
+	return sovFeeabs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			m.TwapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSource", wireType)
+			}
+			m.RateSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateSource |= RateSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StaticRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFeeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceFeeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionFeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionFeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionFeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionFeeGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionFeeGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionFeeGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMFeePayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMFeePayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMFeePayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeabs(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeabs
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeabs
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeabs
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeabs        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeabs          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeabs = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,45 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(params Params, priceObservations []PriceObservation) *GenesisState {
+	return &GenesisState{
+		Params:            params,
+		PriceObservations: priceObservations,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.PriceObservations))
+	for _, observation := range data.PriceObservations {
+		if err := observation.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%d", observation.Denom, observation.Time)
+		if seen[key] {
+			return fmt.Errorf("duplicate price observation of %s at %d", observation.Denom, observation.Time)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Validate validates the price observation.
+func (o PriceObservation) Validate() error {
+	if err := validateDenom(o.Denom); err != nil {
+		return err
+	}
+	return validatePrice(o.Price)
+}
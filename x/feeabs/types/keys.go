@@ -0,0 +1,26 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feeabs"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// TransientStoreKey defines the transient store key
+	TransientStoreKey = "transient_" + ModuleName
+)
+
+var (
+	// ParamsKey is the prefix under which the module params are stored.
+	ParamsKey = collections.NewPrefix(0)
+	// PriceObservationsKey is the prefix under which the submitted prices are
+	// stored by fee token denom and time.
+	PriceObservationsKey = collections.NewPrefix(1)
+)
+
+// EVMFeePaymentsKey is the transient store prefix under which the fees of the
+// EVM txs paid through an extension option are stored by tx hash.
+var EVMFeePaymentsKey = collections.NewPrefix(0)
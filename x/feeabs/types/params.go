@@ -0,0 +1,117 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultTWAPWindow is the default number of seconds TWAP rates average
+	// over.
+	DefaultTWAPWindow uint64 = 30 * 60
+	// DefaultMaxPriceAge is the default number of seconds after the last
+	// submitted price a TWAP rate is stale.
+	DefaultMaxPriceAge uint64 = 10 * 60
+)
+
+// DefaultMaxPriceDeviation is the default largest relative change a
+// submitted price may make to the TWAP rate.
+var DefaultMaxPriceDeviation = math.LegacyNewDecWithPrec(2, 1)
+
+// NewParams creates a new Params instance.
+func NewParams(feeTokens []FeeToken, twapWindow, maxPriceAge uint64, maxPriceDeviation math.LegacyDec) Params {
+	return Params{
+		FeeTokens:         feeTokens,
+		TwapWindow:        twapWindow,
+		MaxPriceAge:       maxPriceAge,
+		MaxPriceDeviation: maxPriceDeviation,
+	}
+}
+
+// DefaultParams returns a default set of parameters. No fee token is
+// accepted by default.
+func DefaultParams() Params {
+	return NewParams(nil, DefaultTWAPWindow, DefaultMaxPriceAge, DefaultMaxPriceDeviation)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if p.TwapWindow == 0 {
+		return fmt.Errorf("twap window must be positive")
+	}
+	if p.MaxPriceAge == 0 {
+		return fmt.Errorf("max price age must be positive")
+	}
+	if p.MaxPriceAge > p.TwapWindow {
+		return fmt.Errorf("max price age %d exceeds the twap window %d", p.MaxPriceAge, p.TwapWindow)
+	}
+	if p.MaxPriceDeviation.IsNil() || p.MaxPriceDeviation.IsNegative() {
+		return fmt.Errorf("max price deviation cannot be negative: %s", p.MaxPriceDeviation)
+	}
+
+	seen := make(map[string]bool, len(p.FeeTokens))
+	for _, feeToken := range p.FeeTokens {
+		if err := feeToken.Validate(); err != nil {
+			return err
+		}
+		if seen[feeToken.Denom] {
+			return fmt.Errorf("duplicate fee token %s", feeToken.Denom)
+		}
+		seen[feeToken.Denom] = true
+	}
+	return nil
+}
+
+// FeeToken returns the fee token of the denom, if it is accepted.
+func (p Params) FeeToken(denom string) (FeeToken, bool) {
+	for _, feeToken := range p.FeeTokens {
+		if feeToken.Denom == denom {
+			return feeToken, true
+		}
+	}
+	return FeeToken{}, false
+}
+
+// Validate validates the fee token.
+func (t FeeToken) Validate() error {
+	if err := validateDenom(t.Denom); err != nil {
+		return err
+	}
+
+	switch t.RateSource {
+	case RATE_SOURCE_STATIC:
+		if err := validatePrice(t.StaticRate); err != nil {
+			return fmt.Errorf("invalid static rate of fee token %s: %w", t.Denom, err)
+		}
+		if t.PriceFeeder != "" {
+			return fmt.Errorf("fee token %s with a static rate cannot have a price feeder", t.Denom)
+		}
+	case RATE_SOURCE_TWAP:
+		if _, err := sdk.AccAddressFromBech32(t.PriceFeeder); err != nil {
+			return fmt.Errorf("invalid price feeder of fee token %s: %w", t.Denom, err)
+		}
+		if !t.StaticRate.IsNil() && !t.StaticRate.IsZero() {
+			return fmt.Errorf("fee token %s with a TWAP rate cannot have a static rate", t.Denom)
+		}
+	default:
+		return fmt.Errorf("invalid rate source %s of fee token %s", t.RateSource, t.Denom)
+	}
+	return nil
+}
+
+func validateDenom(denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return fmt.Errorf("invalid fee token denom: %w", err)
+	}
+	return nil
+}
+
+func validatePrice(price math.LegacyDec) error {
+	if price.IsNil() || !price.IsPositive() {
+		return fmt.Errorf("price must be positive: %s", price)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TacBuild/tacchain/x/feeabs/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	feeder := sdk.AccAddress("feeder______________").String()
+	static := types.FeeToken{Denom: "ibc/static", RateSource: types.RATE_SOURCE_STATIC, StaticRate: math.LegacyOneDec()}
+	twap := types.FeeToken{Denom: "erc20/twap", RateSource: types.RATE_SOURCE_TWAP, StaticRate: math.LegacyZeroDec(), PriceFeeder: feeder}
+
+	testCases := []struct {
+		name   string
+		params types.Params
+		errMsg string
+	}{
+		{"default", types.DefaultParams(), ""},
+		{"valid", types.NewParams([]types.FeeToken{static, twap}, 60, 60, math.LegacyZeroDec()), ""},
+		{"zero twap window", types.NewParams(nil, 0, 0, math.LegacyZeroDec()), "twap window must be positive"},
+		{"zero max price age", types.NewParams(nil, 60, 0, math.LegacyZeroDec()), "max price age must be positive"},
+		{"max price age above twap window", types.NewParams(nil, 60, 61, math.LegacyZeroDec()), "exceeds the twap window"},
+		{"negative max price deviation", types.NewParams(nil, 60, 60, math.LegacyNewDec(-1)), "max price deviation cannot be negative"},
+		{"nil max price deviation", types.NewParams(nil, 60, 60, math.LegacyDec{}), "max price deviation cannot be negative"},
+		{"duplicate", types.NewParams([]types.FeeToken{static, static}, 60, 60, math.LegacyZeroDec()), "duplicate fee token"},
+		{"invalid denom", types.NewParams([]types.FeeToken{{Denom: "1", RateSource: types.RATE_SOURCE_STATIC, StaticRate: math.LegacyOneDec()}}, 60, 60, math.LegacyZeroDec()), "invalid fee token denom"},
+		{"unspecified source", types.NewParams([]types.FeeToken{{Denom: "ibc/token", StaticRate: math.LegacyOneDec()}}, 60, 60, math.LegacyZeroDec()), "invalid rate source"},
+		{"zero static rate", types.NewParams([]types.FeeToken{{Denom: "ibc/token", RateSource: types.RATE_SOURCE_STATIC, StaticRate: math.LegacyZeroDec()}}, 60, 60, math.LegacyZeroDec()), "price must be positive"},
+		{"static rate with feeder", types.NewParams([]types.FeeToken{{Denom: "ibc/token", RateSource: types.RATE_SOURCE_STATIC, StaticRate: math.LegacyOneDec(), PriceFeeder: feeder}}, 60, 60, math.LegacyZeroDec()), "cannot have a price feeder"},
+		{"twap without feeder", types.NewParams([]types.FeeToken{{Denom: "ibc/token", RateSource: types.RATE_SOURCE_TWAP, StaticRate: math.LegacyZeroDec()}}, 60, 60, math.LegacyZeroDec()), "invalid price feeder"},
+		{"twap with static rate", types.NewParams([]types.FeeToken{{Denom: "ibc/token", RateSource: types.RATE_SOURCE_TWAP, StaticRate: math.LegacyOneDec(), PriceFeeder: feeder}}, 60, 60, math.LegacyZeroDec()), "cannot have a static rate"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package inflation
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current inflation parameters",
+				},
+				{
+					RpcMethod: "Curve",
+					Use:       "curve",
+					Short:     "Query the active inflation curve and the mint parameters it uses",
+				},
+				{
+					RpcMethod:      "EvaluateCurve",
+					Use:            "evaluate-curve [bonded-ratio]",
+					Short:          "Query the inflation rate a curve yields at the given bonded ratio",
+					Long:           "Query the inflation rate a curve yields at the given bonded ratio. The active curve is evaluated unless --curve is set.",
+					Example:        fmt.Sprintf("%s query inflation evaluate-curve 0.55 --curve INFLATION_CURVE_PIECEWISE_LINEAR", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "bonded_ratio"}},
+				},
+				{
+					RpcMethod: "SupplyCap",
+					Use:       "supply-cap",
+					Short:     "Query the mintable headroom under the max supply and the projected cap height",
+				},
+				{
+					RpcMethod: "ProvisionFlows",
+					Use:       "provision-flows",
+					Short:     "Query how minted provisions are split and the totals sent to each destination",
+				},
+				{
+					RpcMethod:      "BurnedSupply",
+					Use:            "burned-supply [module]",
+					Short:          "Query the cumulative burns of each tracked module account and the net burned supply",
+					Example:        fmt.Sprintf("%s query inflation burned-supply gov", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "module", Optional: true}},
+				},
+				{
+					RpcMethod:      "Burns",
+					Use:            "burns [from-height] [to-height]",
+					Short:          "Query the burns of each tracked module account within a height range",
+					Long:           fmt.Sprintf("Query the burns of each tracked module account between two heights inclusive. The range cannot span more than %d blocks.", types.MaxBurnsHeightRange),
+					Example:        fmt.Sprintf("%s query inflation burns 1000 2000 --module evm", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "from_height"}, {ProtoField: "to_height"}},
+				},
+				{
+					// served by `query mint projection`, which also renders CSV
+					RpcMethod: "Projection",
+					Skip:      true,
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update inflation module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update inflation module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query inflation params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx inflation update-params-proposal '{ "curve": "INFLATION_CURVE_PARABOLIC" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SpendTreasury",
+					Use:            "spend-treasury-proposal [recipient] [amount]",
+					Short:          "Submit a proposal to send coins from the treasury module account",
+					Example:        fmt.Sprintf(`%s tx inflation spend-treasury-proposal tac1... 1000000utac`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "amount", Varargs: true}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
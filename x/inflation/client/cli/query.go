@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+const (
+	FlagYears          = "years"
+	FlagPeriodsPerYear = "periods-per-year"
+	FlagBondedRatios   = "bonded-ratios"
+	FlagBlockTime      = "block-time"
+	FlagCurve          = "curve"
+	FlagFormat         = "format"
+
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// GetMintProjectionCmd returns the command projecting minting forward from the
+// current state. It is mounted under the x/mint query command.
+func GetMintProjectionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projection",
+		Short: "Project supply, annual provisions and inflation for the coming years",
+		Long: `Replay the active (or the given) inflation curve forward from the current state.
+The bonded ratio path holds one value per period, the last value holding for the remaining periods.
+Without a block time the chain is assumed to produce exactly the x/mint blocks_per_year.`,
+		Example: fmt.Sprintf(
+			"%s query mint projection --years 5 --periods-per-year 12 --bonded-ratios 0.5,0.6,0.7 --block-time 1.553s --format csv",
+			version.AppName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req, err := projectionRequestFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+			if format != FormatJSON && format != FormatCSV {
+				return fmt.Errorf("unsupported format %q, expected %s or %s", format, FormatJSON, FormatCSV)
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Projection(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if format == FormatCSV {
+				return writeProjectionCSV(cmd, res)
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagYears, 10, "Number of years to project")
+	cmd.Flags().Uint32(FlagPeriodsPerYear, 1, "Number of rows per year")
+	cmd.Flags().String(FlagBondedRatios, "", "Comma-separated bonded ratio per period (defaults to the current bonded ratio)")
+	cmd.Flags().Duration(FlagBlockTime, 0, "Assumed average block time (defaults to matching blocks_per_year)")
+	cmd.Flags().String(FlagCurve, "", "Inflation curve to project, e.g. INFLATION_CURVE_LINEAR (defaults to the active curve)")
+	cmd.Flags().String(FlagFormat, FormatJSON, "Output format (json|csv)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func projectionRequestFromFlags(cmd *cobra.Command) (*types.QueryProjectionRequest, error) {
+	years, err := cmd.Flags().GetUint32(FlagYears)
+	if err != nil {
+		return nil, err
+	}
+	periodsPerYear, err := cmd.Flags().GetUint32(FlagPeriodsPerYear)
+	if err != nil {
+		return nil, err
+	}
+	blockTime, err := cmd.Flags().GetDuration(FlagBlockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &types.QueryProjectionRequest{
+		Years:          years,
+		PeriodsPerYear: periodsPerYear,
+		BlockTime:      blockTime,
+	}
+
+	bondedRatios, err := cmd.Flags().GetString(FlagBondedRatios)
+	if err != nil {
+		return nil, err
+	}
+	if bondedRatios != "" {
+		for _, s := range strings.Split(bondedRatios, ",") {
+			bondedRatio, err := math.LegacyNewDecFromStr(strings.TrimSpace(s))
+			if err != nil {
+				return nil, fmt.Errorf("invalid bonded ratio %q: %w", s, err)
+			}
+			req.BondedRatios = append(req.BondedRatios, bondedRatio)
+		}
+	}
+
+	curve, err := cmd.Flags().GetString(FlagCurve)
+	if err != nil {
+		return nil, err
+	}
+	if curve != "" {
+		value, ok := types.InflationCurve_value[curve]
+		if !ok {
+			return nil, fmt.Errorf("unknown inflation curve %q", curve)
+		}
+		req.Curve = types.InflationCurve(value)
+	}
+
+	return req, nil
+}
+
+func writeProjectionCSV(cmd *cobra.Command, res *types.QueryProjectionResponse) error {
+	w := csv.NewWriter(cmd.OutOrStdout())
+	if err := w.Write([]string{
+		"period", "blocks", "bonded_ratio", "supply", "annual_provisions", "inflation", "effective_inflation",
+	}); err != nil {
+		return err
+	}
+
+	for _, row := range res.Periods {
+		if err := w.Write([]string{
+			strconv.FormatUint(uint64(row.Period), 10),
+			strconv.FormatUint(row.Blocks, 10),
+			row.BondedRatio.String(),
+			row.Supply.String(),
+			row.AnnualProvisions.String(),
+			row.Inflation.String(),
+			row.EffectiveInflation.String(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
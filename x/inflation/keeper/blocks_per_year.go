@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+// year is the wall-clock duration blocks_per_year is derived for. It matches
+// the 365-day year the x/mint blocks_per_year parameter has always assumed.
+const year = 365 * 24 * time.Hour
+
+// AdjustBlocksPerYear re-derives the x/mint blocks_per_year from the average
+// block time observed since the previous end of the configured epoch, clamped
+// to the governance bounds. x/mint mints annual_provisions / blocks_per_year
+// per block regardless of wall-clock time, so a stale value over- or
+// under-mints whenever the block time drifts.
+//
+// The first epoch end only records a checkpoint; every later one measures the
+// interval since that checkpoint and moves it forward.
+func (k Keeper) AdjustBlocksPerYear(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.BlocksPerYearEpochIdentifier == "" || params.BlocksPerYearEpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	current := types.BlockTimeCheckpoint{Height: ctx.BlockHeight(), Time: ctx.BlockTime()}
+	last, err := k.BlockTimeCheckpoint.Get(ctx)
+	found := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err := k.BlockTimeCheckpoint.Set(ctx, current); err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+
+	blocks := current.Height - last.Height
+	elapsed := current.Time.Sub(last.Time)
+	if blocks <= 0 || elapsed <= 0 {
+		k.Logger(ctx).Error("skipping blocks_per_year adjustment on non-increasing checkpoint",
+			"last_height", last.Height, "height", current.Height)
+		return nil
+	}
+
+	avgBlockTime := elapsed / time.Duration(blocks)
+	blocksPerYear := DeriveBlocksPerYear(avgBlockTime, params.MinBlocksPerYear, params.MaxBlocksPerYear)
+
+	mintParams, err := k.mintKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	old := mintParams.BlocksPerYear
+	if old == blocksPerYear {
+		return nil
+	}
+
+	mintParams.BlocksPerYear = blocksPerYear
+	if err := mintParams.Validate(); err != nil {
+		return fmt.Errorf("validate mint params after blocks_per_year change: %w", err)
+	}
+	if err := k.mintKeeper.Params.Set(ctx, mintParams); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBlocksPerYearAdjusted,
+			sdk.NewAttribute(types.AttributeKeyEpochIdentifier, epochIdentifier),
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(epochNumber, 10)),
+			sdk.NewAttribute(types.AttributeKeyAvgBlockTime, avgBlockTime.String()),
+			sdk.NewAttribute(types.AttributeKeyOldBlocksPerYear, strconv.FormatUint(old, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewBlocksPerYear, strconv.FormatUint(blocksPerYear, 10)),
+		),
+	)
+	k.Logger(ctx).Info("adjusted x/mint blocks_per_year",
+		"old", old, "new", blocksPerYear, "avg_block_time", avgBlockTime)
+
+	return nil
+}
+
+// DeriveBlocksPerYear returns the number of blocks produced in a year at the
+// given average block time, clamped to [minBlocks, maxBlocks].
+func DeriveBlocksPerYear(avgBlockTime time.Duration, minBlocks, maxBlocks uint64) uint64 {
+	if avgBlockTime <= 0 {
+		return maxBlocks
+	}
+	blocksPerYear := uint64(year / avgBlockTime)
+	if blocksPerYear < minBlocks {
+		return minBlocks
+	}
+	if blocksPerYear > maxBlocks {
+		return maxBlocks
+	}
+	return blocksPerYear
+}
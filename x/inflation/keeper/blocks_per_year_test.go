@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TacBuild/tacchain/x/inflation/keeper"
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+func TestDeriveBlocksPerYear(t *testing.T) {
+	testCases := []struct {
+		name         string
+		avgBlockTime time.Duration
+		expected     uint64
+	}{
+		{"one second", time.Second, 31_536_000},
+		{"mainnet block time", 1553 * time.Millisecond, 20_306_503},
+		{"clamped to min", 10 * time.Second, 10_000_000},
+		{"clamped to max", 500 * time.Millisecond, 40_000_000},
+		{"zero block time", 0, 40_000_000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, keeper.DeriveBlocksPerYear(tc.avgBlockTime, 10_000_000, 40_000_000))
+		})
+	}
+}
+
+func TestAdjustBlocksPerYear(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	epoch := types.DefaultBlocksPerYearEpochIdentifier
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mintParams, err := f.mintKeeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	initial := mintParams.BlocksPerYear
+
+	// The first epoch end only records the checkpoint.
+	ctx := f.ctx.WithBlockHeight(100).WithBlockTime(start)
+	require.NoError(t, f.keeper.EpochHooks().AfterEpochEnd(ctx, epoch, 1))
+	mintParams, err = f.mintKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, initial, mintParams.BlocksPerYear)
+
+	// Other epochs are ignored.
+	ctx = f.ctx.WithBlockHeight(200).WithBlockTime(start.Add(time.Hour))
+	require.NoError(t, f.keeper.EpochHooks().AfterEpochEnd(ctx, "hour", 1))
+	checkpoint, err := f.keeper.BlockTimeCheckpoint.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), checkpoint.Height)
+
+	// 57_600 blocks in a day is an average block time of 1.5s.
+	ctx = f.ctx.WithBlockHeight(100 + 57_600).WithBlockTime(start.Add(24 * time.Hour)).
+		WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.EpochHooks().AfterEpochEnd(ctx, epoch, 2))
+	mintParams, err = f.mintKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21_024_000), mintParams.BlocksPerYear)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeBlocksPerYearAdjusted, events[0].Type)
+	old, ok := events[0].GetAttribute(types.AttributeKeyOldBlocksPerYear)
+	require.True(t, ok)
+	require.Equal(t, "6311520", old.Value)
+	updated, ok := events[0].GetAttribute(types.AttributeKeyNewBlocksPerYear)
+	require.True(t, ok)
+	require.Equal(t, "21024000", updated.Value)
+
+	// A stalled chain is clamped to the governance lower bound.
+	ctx = f.ctx.WithBlockHeight(100 + 57_600 + 100).WithBlockTime(start.Add(48 * time.Hour))
+	require.NoError(t, f.keeper.EpochHooks().AfterEpochEnd(ctx, epoch, 3))
+	mintParams, err = f.mintKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultMinBlocksPerYear, mintParams.BlocksPerYear)
+}
+
+func TestAdjustBlocksPerYearDisabled(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	params := types.DefaultParams()
+	params.BlocksPerYearEpochIdentifier = ""
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	require.NoError(t, f.keeper.AdjustBlocksPerYear(f.ctx, "", 1))
+	_, err := f.keeper.BlockTimeCheckpoint.Get(f.ctx)
+	require.Error(t, err)
+}
+
+func TestParamsValidateBlocksPerYearBounds(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.MinBlocksPerYear = 0
+	require.ErrorContains(t, params.Validate(), "min blocks per year must be positive")
+
+	params = types.DefaultParams()
+	params.MaxBlocksPerYear = params.MinBlocksPerYear - 1
+	require.ErrorContains(t, params.Validate(), "must not be lower than")
+
+	params = types.DefaultParams()
+	params.BlocksPerYearEpochIdentifier = " day"
+	require.ErrorContains(t, params.Validate(), "whitespace")
+
+	// Bounds are not checked while the adjustment is disabled.
+	params = types.DefaultParams()
+	params.BlocksPerYearEpochIdentifier = ""
+	params.MinBlocksPerYear = 0
+	require.NoError(t, params.Validate())
+}
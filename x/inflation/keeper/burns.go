@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+// BurnTrackingBankKeeper is a bank keeper that records every burn from, and
+// every mint into, a module account in the inflation store. It is handed to
+// the modules holding burner accounts so that the burned supply can be
+// attributed to them.
+//
+// The supply changes made within a tx are only recorded net, when the tx
+// completes: x/vm settles every EVM balance change by burning from, and
+// minting into, its module account, so a transfer would otherwise be counted
+// as both a burn and a mint.
+type BurnTrackingBankKeeper struct {
+	bankkeeper.BaseKeeper
+
+	// k is taken by reference because the bank keeper is handed out before
+	// the inflation keeper is built.
+	k *Keeper
+}
+
+// NewBurnTrackingBankKeeper wraps the bank keeper with burn tracking.
+func NewBurnTrackingBankKeeper(bk bankkeeper.BaseKeeper, k *Keeper) BurnTrackingBankKeeper {
+	return BurnTrackingBankKeeper{BaseKeeper: bk, k: k}
+}
+
+// BurnCoins burns the coins from the module account and records the burn.
+func (bk BurnTrackingBankKeeper) BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error {
+	if err := bk.BaseKeeper.BurnCoins(ctx, moduleName, amounts); err != nil {
+		return err
+	}
+	return bk.k.TrackSupplyChange(ctx, moduleName, types.SupplyChange{Burned: amounts})
+}
+
+// MintCoins mints the coins into the module account and records the mint.
+func (bk BurnTrackingBankKeeper) MintCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error {
+	if err := bk.BaseKeeper.MintCoins(ctx, moduleName, amounts); err != nil {
+		return err
+	}
+	return bk.k.TrackSupplyChange(ctx, moduleName, types.SupplyChange{Minted: amounts})
+}
+
+// TrackSupplyChange records the supply change of a module account, or, within
+// a tx, adds it to the pending changes recorded net by
+// RecordPendingSupplyChanges.
+func (k Keeper) TrackSupplyChange(ctx context.Context, moduleName string, change types.SupplyChange) error {
+	if len(sdk.UnwrapSDKContext(ctx).TxBytes()) == 0 {
+		return k.RecordSupplyChange(ctx, moduleName, change)
+	}
+
+	pending, err := getSupplyChange(ctx, k.PendingSupplyChanges, moduleName)
+	if err != nil {
+		return err
+	}
+	return k.PendingSupplyChanges.Set(ctx, moduleName, pending.Add(change))
+}
+
+// RecordPendingSupplyChanges records the net pending supply change of every
+// module account and clears them. It is run at the end of every tx, and at
+// the end of the block for the changes of the txs whose messages failed after
+// the ante handler made some.
+func (k Keeper) RecordPendingSupplyChanges(ctx context.Context) error {
+	pending, err := k.PendingSupplyChanges.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	changes, err := pending.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range changes {
+		if err := k.PendingSupplyChanges.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+		if net := kv.Value.Net(); !net.IsEmpty() {
+			if err := k.RecordSupplyChange(ctx, kv.Key, net); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RecordSupplyChange adds the supply change of a module account to its
+// cumulative total and to the record of the current height.
+func (k Keeper) RecordSupplyChange(ctx context.Context, moduleName string, change types.SupplyChange) error {
+	total, err := getSupplyChange(ctx, k.BurnTotals, moduleName)
+	if err != nil {
+		return err
+	}
+	if err := k.BurnTotals.Set(ctx, moduleName, total.Add(change)); err != nil {
+		return err
+	}
+
+	key := collections.Join(sdk.UnwrapSDKContext(ctx).BlockHeight(), moduleName)
+	record, err := getSupplyChange(ctx, k.BurnRecords, key)
+	if err != nil {
+		return err
+	}
+	return k.BurnRecords.Set(ctx, key, record.Add(change))
+}
+
+// PruneBurnRecords removes the burn records of the heights that fell out of
+// the last BurnRecordsRetention ones.
+func (k Keeper) PruneBurnRecords(ctx context.Context) error {
+	oldest := sdk.UnwrapSDKContext(ctx).BlockHeight() - types.BurnRecordsRetention + 1
+	if oldest <= 1 {
+		return nil
+	}
+	ranger := new(collections.Range[collections.Pair[int64, string]]).
+		EndExclusive(collections.Join(oldest, ""))
+	return k.BurnRecords.Clear(ctx, ranger)
+}
+
+// ModuleSupplyChanges returns the cumulative supply change of every tracked
+// module account, or of the given one only.
+func (k Keeper) ModuleSupplyChanges(ctx context.Context, moduleName string) ([]types.ModuleSupplyChange, error) {
+	if moduleName != "" {
+		change, err := getSupplyChange(ctx, k.BurnTotals, moduleName)
+		if err != nil {
+			return nil, err
+		}
+		return []types.ModuleSupplyChange{{Module: moduleName, Change: change}}, nil
+	}
+
+	var changes []types.ModuleSupplyChange
+	err := k.BurnTotals.Walk(ctx, nil, func(module string, change types.SupplyChange) (bool, error) {
+		changes = append(changes, types.ModuleSupplyChange{Module: module, Change: change})
+		return false, nil
+	})
+	return changes, err
+}
+
+// ModuleSupplyChangesInRange returns the supply change of every tracked
+// module account, or of the given one only, between the two heights
+// inclusive.
+func (k Keeper) ModuleSupplyChangesInRange(ctx context.Context, fromHeight, toHeight int64, moduleName string) ([]types.ModuleSupplyChange, error) {
+	ranger := new(collections.Range[collections.Pair[int64, string]]).
+		StartInclusive(collections.Join(fromHeight, "")).
+		EndExclusive(collections.Join(toHeight+1, ""))
+
+	sums := make(map[string]types.SupplyChange)
+	err := k.BurnRecords.Walk(ctx, ranger, func(key collections.Pair[int64, string], change types.SupplyChange) (bool, error) {
+		if moduleName == "" || key.K2() == moduleName {
+			sums[key.K2()] = sums[key.K2()].Add(change)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	modules := maps.Keys(sums)
+	changes := make([]types.ModuleSupplyChange, 0, len(sums))
+	for _, module := range slices.Sorted(modules) {
+		changes = append(changes, types.ModuleSupplyChange{Module: module, Change: sums[module]})
+	}
+	return changes, nil
+}
+
+// GetBurnRecords returns all the retained burn records.
+func (k Keeper) GetBurnRecords(ctx context.Context) ([]types.BurnRecord, error) {
+	var records []types.BurnRecord
+	err := k.BurnRecords.Walk(ctx, nil, func(key collections.Pair[int64, string], change types.SupplyChange) (bool, error) {
+		records = append(records, types.BurnRecord{Height: key.K1(), Module: key.K2(), Change: change})
+		return false, nil
+	})
+	return records, err
+}
+
+// getSupplyChange returns the supply change stored under the key, or an empty
+// one if there is none.
+func getSupplyChange[K any](ctx context.Context, m collections.Map[K, types.SupplyChange], key K) (types.SupplyChange, error) {
+	change, err := m.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.SupplyChange{}, nil
+	}
+	return change, err
+}
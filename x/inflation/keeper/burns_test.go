@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TacBuild/tacchain/x/inflation/keeper"
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+func TestBurnQueries(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("utac", amount)) }
+
+	record := func(height int64, module string, change types.SupplyChange) {
+		require.NoError(t, f.keeper.RecordSupplyChange(f.ctx.WithBlockHeight(height), module, change))
+	}
+	record(10, "gov", types.SupplyChange{Burned: coins(100)})
+	record(10, "evm", types.SupplyChange{Burned: coins(50)})
+	record(10, "evm", types.SupplyChange{Minted: coins(40)})
+	record(20, "gov", types.SupplyChange{Burned: coins(5)})
+	record(30, "evm", types.SupplyChange{Burned: coins(7)})
+
+	res, err := queryServer.BurnedSupply(f.ctx, &types.QueryBurnedSupplyRequest{})
+	require.NoError(t, err)
+	requireSupplyChanges(t, []types.ModuleSupplyChange{
+		{Module: "evm", Change: types.SupplyChange{Burned: coins(57), Minted: coins(40)}},
+		{Module: "gov", Change: types.SupplyChange{Burned: coins(105)}},
+	}, res.Modules)
+	require.Equal(t, coins(122), res.NetBurned)
+	requireSupplyChanges(t, res.Modules, f.keeper.ExportGenesis(f.ctx).BurnTotals)
+
+	res, err = queryServer.BurnedSupply(f.ctx, &types.QueryBurnedSupplyRequest{Module: "gov"})
+	require.NoError(t, err)
+	require.Equal(t, coins(105), res.NetBurned)
+
+	rangeRes, err := queryServer.Burns(f.ctx, &types.QueryBurnsRequest{FromHeight: 10, ToHeight: 20})
+	require.NoError(t, err)
+	requireSupplyChanges(t, []types.ModuleSupplyChange{
+		{Module: "evm", Change: types.SupplyChange{Burned: coins(50), Minted: coins(40)}},
+		{Module: "gov", Change: types.SupplyChange{Burned: coins(105)}},
+	}, rangeRes.Modules)
+	require.Equal(t, coins(115), rangeRes.NetBurned)
+
+	rangeRes, err = queryServer.Burns(f.ctx, &types.QueryBurnsRequest{FromHeight: 20, ToHeight: 30, Module: "evm"})
+	require.NoError(t, err)
+	requireSupplyChanges(t, []types.ModuleSupplyChange{
+		{Module: "evm", Change: types.SupplyChange{Burned: coins(7)}},
+	}, rangeRes.Modules)
+
+	_, err = queryServer.Burns(f.ctx, &types.QueryBurnsRequest{FromHeight: 20, ToHeight: 10})
+	require.ErrorContains(t, err, "below from height")
+
+	_, err = queryServer.Burns(f.ctx, &types.QueryBurnsRequest{FromHeight: 1, ToHeight: types.MaxBurnsHeightRange + 1})
+	require.ErrorContains(t, err, "cannot span more than")
+}
+
+func TestPendingSupplyChanges(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("utac", amount)) }
+	txCtx := f.ctx.WithBlockHeight(10).WithTxBytes([]byte("tx"))
+
+	// an EVM transfer burns from and re-mints into the x/vm module account
+	require.NoError(t, f.keeper.TrackSupplyChange(txCtx, "evm", types.SupplyChange{Burned: coins(50)}))
+	require.NoError(t, f.keeper.TrackSupplyChange(txCtx, "evm", types.SupplyChange{Minted: coins(50)}))
+	require.NoError(t, f.keeper.TrackSupplyChange(txCtx, "gov", types.SupplyChange{Burned: coins(100)}))
+	require.NoError(t, f.keeper.TrackSupplyChange(txCtx, "gov", types.SupplyChange{Minted: coins(30)}))
+	changes, err := f.keeper.ModuleSupplyChanges(txCtx, "")
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	require.NoError(t, f.keeper.RecordPendingSupplyChanges(txCtx))
+	changes, err = f.keeper.ModuleSupplyChanges(txCtx, "")
+	require.NoError(t, err)
+	requireSupplyChanges(t, []types.ModuleSupplyChange{
+		{Module: "gov", Change: types.SupplyChange{Burned: coins(70)}},
+	}, changes)
+	pending, err := f.keeper.PendingSupplyChanges.Iterate(txCtx, nil)
+	require.NoError(t, err)
+	keys, err := pending.Keys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	// outside of a tx the changes are recorded right away
+	require.NoError(t, f.keeper.TrackSupplyChange(f.ctx.WithBlockHeight(10), "evm", types.SupplyChange{Minted: coins(5)}))
+	changes, err = f.keeper.ModuleSupplyChangesInRange(f.ctx, 10, 10, "evm")
+	require.NoError(t, err)
+	requireSupplyChanges(t, []types.ModuleSupplyChange{
+		{Module: "evm", Change: types.SupplyChange{Minted: coins(5)}},
+	}, changes)
+}
+
+func TestPruneBurnRecords(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("utac", 1))
+	for _, height := range []int64{1, 2, 3} {
+		require.NoError(t, f.keeper.RecordSupplyChange(f.ctx.WithBlockHeight(height), "gov", types.SupplyChange{Burned: coins}))
+	}
+
+	ctx := f.ctx.WithBlockHeight(types.BurnRecordsRetention + 2)
+	require.NoError(t, f.keeper.PruneBurnRecords(ctx))
+	records, err := f.keeper.GetBurnRecords(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, int64(3), records[0].Height)
+
+	// the totals are kept
+	changes, err := f.keeper.ModuleSupplyChanges(ctx, "gov")
+	require.NoError(t, err)
+	require.Equal(t, coins.MulInt(math.NewInt(3)), changes[0].Change.Burned)
+
+	_, err = keeper.NewQueryServerImpl(f.keeper).Burns(ctx, &types.QueryBurnsRequest{FromHeight: 2, ToHeight: 3})
+	require.ErrorContains(t, err, "pruned")
+
+	genesis := f.keeper.ExportGenesis(ctx)
+	require.Equal(t, records, genesis.BurnRecords)
+	require.NoError(t, types.ValidateGenesis(*genesis))
+
+	imported := newFixture(t, defaultCurves())
+	imported.keeper.InitGenesis(imported.ctx, genesis)
+	importedRecords, err := imported.keeper.GetBurnRecords(imported.ctx)
+	require.NoError(t, err)
+	require.Equal(t, records, importedRecords)
+}
+
+// requireSupplyChanges compares supply changes regardless of whether empty
+// coins are nil.
+func requireSupplyChanges(t *testing.T, expected, actual []types.ModuleSupplyChange) {
+	t.Helper()
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		require.Equal(t, expected[i].String(), actual[i].String())
+	}
+}
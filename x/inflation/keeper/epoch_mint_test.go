@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/TacBuild/tacchain/x/inflation/keeper"
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+func epochMintingParams() types.Params {
+	params := types.DefaultParams()
+	params.MintingMode = types.MINTING_MODE_EPOCH
+	params.MintingEpochIdentifier = "week"
+	return params
+}
+
+// expectEpochMint sets up a single mint of amount, all of it sent to the fee
+// collector.
+func (f *fixture) expectEpochMint(amount int64) {
+	minted := sdk.NewCoins(sdk.NewInt64Coin(minttypes.DefaultParams().MintDenom, amount))
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), minttypes.ModuleName, minted).Return(nil)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), minttypes.ModuleName, authtypes.FeeCollectorName, minted).Return(nil)
+}
+
+func (f *fixture) expectMinterUpdate() {
+	f.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(math.NewInt(1_000_000_000_000), nil)
+	f.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(5, 1), nil)
+}
+
+func TestEpochMinting(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.ctx = f.ctx.WithBlockHeight(10).WithBlockTime(start)
+
+	_, err := keeper.NewMsgServerImpl(f.keeper).UpdateParams(f.ctx, &types.MsgUpdateParams{
+		Authority: f.authority,
+		Params:    epochMintingParams(),
+	})
+	require.NoError(t, err)
+
+	// Blocks keep updating the minter but mint nothing.
+	f.expectMinterUpdate()
+	require.NoError(t, f.mintKeeper.MintFn(f.ctx))
+	minter, err := f.mintKeeper.Minter.Get(f.ctx)
+	require.NoError(t, err)
+	require.True(t, linearRate.Equal(minter.Inflation))
+
+	// Other epochs are ignored.
+	f.ctx = f.ctx.WithBlockHeight(20).WithBlockTime(start.Add(24 * time.Hour))
+	require.NoError(t, f.keeper.MintEpochProvisions(f.ctx, "day"))
+
+	// 5% of 1e12 over 7 of 365 days.
+	f.ctx = f.ctx.WithBlockHeight(100).WithBlockTime(start.Add(7 * 24 * time.Hour))
+	f.expectMinterUpdate()
+	f.expectEpochMint(958_904_109)
+	require.NoError(t, f.keeper.MintEpochProvisions(f.ctx, "week"))
+
+	checkpoint, err := f.keeper.MintCheckpoint.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), checkpoint.Height)
+
+	totals, err := f.keeper.ProvisionTotals.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, "958904109stake", totals.FeeCollector.String())
+}
+
+func TestSwitchFromEpochMintingReleasesAccruedProvisions(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.ctx = f.ctx.WithBlockHeight(10).WithBlockTime(start)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	_, err := msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.authority, Params: epochMintingParams()})
+	require.NoError(t, err)
+
+	// 5% of 1e12 over 1 of 365 days.
+	f.ctx = f.ctx.WithBlockHeight(20).WithBlockTime(start.Add(24 * time.Hour))
+	f.expectMinterUpdate()
+	f.expectEpochMint(136_986_301)
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.authority, Params: types.DefaultParams()})
+	require.NoError(t, err)
+
+	has, err := f.keeper.MintCheckpoint.Has(f.ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestEpochMintingAcrossGenesisExport(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.ctx = f.ctx.WithBlockHeight(10).WithBlockTime(start)
+
+	params := epochMintingParams()
+	params.BlocksPerYearEpochIdentifier = "week"
+	_, err := keeper.NewMsgServerImpl(f.keeper).UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.authority, Params: params})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.AdjustBlocksPerYear(f.ctx, "week", 1))
+
+	genesis := f.keeper.ExportGenesis(f.ctx)
+	require.Equal(t, &types.BlockTimeCheckpoint{Height: 10, Time: start}, genesis.MintCheckpoint)
+	require.Equal(t, &types.BlockTimeCheckpoint{Height: 10, Time: start}, genesis.BlockTimeCheckpoint)
+	require.NoError(t, types.ValidateGenesis(*genesis))
+
+	// The first epoch end after the import releases the provisions accrued
+	// since the exported release instead of only recording a checkpoint.
+	imported := newFixture(t, defaultCurves())
+	imported.ctx = imported.ctx.WithBlockHeight(100).WithBlockTime(start.Add(7 * 24 * time.Hour))
+	imported.keeper.InitGenesis(imported.ctx, genesis)
+	imported.expectMinterUpdate()
+	imported.expectEpochMint(958_904_109)
+	require.NoError(t, imported.keeper.MintEpochProvisions(imported.ctx, "week"))
+
+	// The block time is measured from the exported checkpoint as well.
+	require.NoError(t, imported.keeper.AdjustBlocksPerYear(imported.ctx, "week", 2))
+	mintParams, err := imported.mintKeeper.Params.Get(imported.ctx)
+	require.NoError(t, err)
+	require.Equal(t, keeper.DeriveBlocksPerYear(7*24*time.Hour/90, params.MinBlocksPerYear, params.MaxBlocksPerYear), mintParams.BlocksPerYear)
+}
+
+func TestValidateMintingMode(t *testing.T) {
+	params := epochMintingParams()
+	require.NoError(t, params.Validate())
+
+	params.MintingEpochIdentifier = ""
+	require.ErrorContains(t, params.Validate(), "minting epoch identifier")
+
+	params = types.DefaultParams()
+	params.MintingMode = types.MINTING_MODE_UNSPECIFIED
+	require.ErrorContains(t, params.Validate(), "minting mode")
+}
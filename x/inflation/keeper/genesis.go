@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+// InitGenesis initializes the inflation module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if _, err := k.InflationCalculationFn(data.Params); err != nil {
+		panic(err)
+	}
+
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	if err := k.ProvisionTotals.Set(ctx, data.ProvisionTotals); err != nil {
+		panic(err)
+	}
+
+	for _, burns := range data.BurnTotals {
+		if err := k.BurnTotals.Set(ctx, burns.Module, burns.Change); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range data.BurnRecords {
+		if err := k.BurnRecords.Set(ctx, collections.Join(record.Height, record.Module), record.Change); err != nil {
+			panic(err)
+		}
+	}
+
+	if data.BlockTimeCheckpoint != nil {
+		if err := k.BlockTimeCheckpoint.Set(ctx, *data.BlockTimeCheckpoint); err != nil {
+			panic(err)
+		}
+	}
+
+	if data.MintCheckpoint != nil {
+		if err := k.MintCheckpoint.Set(ctx, *data.MintCheckpoint); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	totals, err := k.ProvisionTotals.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	burnTotals, err := k.ModuleSupplyChanges(ctx, "")
+	if err != nil {
+		panic(err)
+	}
+
+	burnRecords, err := k.GetBurnRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis := types.NewGenesisState(params, totals, burnTotals)
+	genesis.BurnRecords = burnRecords
+	genesis.BlockTimeCheckpoint = getCheckpoint(ctx, k.BlockTimeCheckpoint)
+	genesis.MintCheckpoint = getCheckpoint(ctx, k.MintCheckpoint)
+	return genesis
+}
+
+// getCheckpoint returns the checkpoint stored in the item, or nil if there is
+// none.
+func getCheckpoint(ctx sdk.Context, item collections.Item[types.BlockTimeCheckpoint]) *types.BlockTimeCheckpoint {
+	checkpoint, err := item.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		panic(err)
+	}
+	return &checkpoint
+}
@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/inflation QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the inflation module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Curve returns the active inflation curve and the x/mint params it is shaped by.
+func (q queryServer) Curve(ctx context.Context, _ *types.QueryCurveRequest) (*types.QueryCurveResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mintParams, err := q.k.mintKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCurveResponse{
+		Curve:        params.Curve,
+		InflationMin: mintParams.InflationMin,
+		InflationMax: mintParams.InflationMax,
+		GoalBonded:   mintParams.GoalBonded,
+	}, nil
+}
+
+// EvaluateCurve returns the inflation rate a curve yields at the given bonded
+// ratio with the current x/mint parameters.
+func (q queryServer) EvaluateCurve(ctx context.Context, req *types.QueryEvaluateCurveRequest) (*types.QueryEvaluateCurveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	bondedRatio, err := math.LegacyNewDecFromStr(req.BondedRatio)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bonded ratio %q: %s", req.BondedRatio, err)
+	}
+	if bondedRatio.IsNegative() || bondedRatio.GT(math.LegacyOneDec()) {
+		return nil, status.Errorf(codes.InvalidArgument, "bonded ratio must be in [0, 1], got %s", bondedRatio)
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Curve != types.INFLATION_CURVE_UNSPECIFIED {
+		params.Curve = req.Curve
+	}
+	if err := params.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ic, err := q.k.InflationCalculationFn(params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	minter, err := q.k.mintKeeper.Minter.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mintParams, err := q.k.mintKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEvaluateCurveResponse{
+		Curve:     params.Curve,
+		Inflation: nonNegative(ic)(ctx, minter, mintParams, bondedRatio),
+	}, nil
+}
+
+// SupplyCap returns the remaining mintable headroom under the max supply and
+// the height at which the cap is projected to be hit at the current block
+// provision.
+func (q queryServer) SupplyCap(ctx context.Context, _ *types.QuerySupplyCapRequest) (*types.QuerySupplyCapResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	minter, err := q.k.mintKeeper.Minter.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mintParams, err := q.k.mintKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockProvision := minter.BlockProvision(mintParams).Amount
+	headroom, capped := q.k.SupplyHeadroom(ctx, params.MaxSupply, mintParams.MintDenom)
+
+	var projectedCapHeight int64
+	if capped && blockProvision.IsPositive() {
+		// ceil(headroom / blockProvision) more blocks fill the remaining headroom
+		blocks := headroom.Add(blockProvision).SubRaw(1).Quo(blockProvision)
+		if blocks.IsInt64() {
+			projectedCapHeight = sdk.UnwrapSDKContext(ctx).BlockHeight() + blocks.Int64()
+		}
+	}
+
+	return &types.QuerySupplyCapResponse{
+		MaxSupply:          params.MaxSupply,
+		Supply:             q.k.bankKeeper.GetSupply(ctx, mintParams.MintDenom),
+		Headroom:           headroom,
+		BlockProvision:     blockProvision,
+		ProjectedCapHeight: projectedCapHeight,
+	}, nil
+}
+
+// Projection replays an inflation curve forward from the current state under
+// an assumed bonded-ratio path and block time.
+func (q queryServer) Projection(ctx context.Context, req *types.QueryProjectionRequest) (*types.QueryProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateProjectionRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Curve != types.INFLATION_CURVE_UNSPECIFIED {
+		params.Curve = req.Curve
+	}
+	if err := params.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ic, err := q.k.InflationCalculationFn(params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	minter, err := q.k.mintKeeper.Minter.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mintParams, err := q.k.mintKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bondedRatios := req.BondedRatios
+	if len(bondedRatios) == 0 {
+		bondedRatio, err := q.k.mintKeeper.BondedRatio(ctx)
+		if err != nil {
+			return nil, err
+		}
+		bondedRatios = []math.LegacyDec{bondedRatio}
+	}
+
+	periodsPerYear := max(req.PeriodsPerYear, 1)
+	rows := projection{
+		ic:             nonNegative(ic),
+		minter:         minter,
+		mintParams:     mintParams,
+		supply:         q.k.bankKeeper.GetSupply(ctx, mintParams.MintDenom).Amount,
+		maxSupply:      params.MaxSupply,
+		bondedRatios:   bondedRatios,
+		blockTime:      req.BlockTime,
+		periods:        req.Years * periodsPerYear,
+		periodsPerYear: periodsPerYear,
+	}.run(ctx)
+
+	return &types.QueryProjectionResponse{
+		Curve:   params.Curve,
+		Periods: rows,
+	}, nil
+}
+
+// ProvisionFlows returns how minted provisions are split and the totals sent
+// to each destination so far.
+func (q queryServer) ProvisionFlows(ctx context.Context, _ *types.QueryProvisionFlowsRequest) (*types.QueryProvisionFlowsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := q.k.ProvisionTotals.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	treasury := authtypes.NewModuleAddress(types.TreasuryName)
+	return &types.QueryProvisionFlowsResponse{
+		TreasuryFraction:      params.TreasuryFraction,
+		CommunityPoolFraction: params.CommunityPoolFraction,
+		Totals:                totals,
+		TreasuryAddress:       treasury.String(),
+		TreasuryBalance:       q.k.bankKeeper.GetAllBalances(ctx, treasury),
+	}, nil
+}
+
+// BurnedSupply returns the cumulative burns of each tracked module account.
+func (q queryServer) BurnedSupply(ctx context.Context, req *types.QueryBurnedSupplyRequest) (*types.QueryBurnedSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	modules, err := q.k.ModuleSupplyChanges(ctx, req.Module)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBurnedSupplyResponse{
+		Modules:   modules,
+		NetBurned: types.NetBurned(modules),
+	}, nil
+}
+
+// Burns returns the burns of each tracked module account within a height
+// range.
+func (q queryServer) Burns(ctx context.Context, req *types.QueryBurnsRequest) (*types.QueryBurnsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateBurnsHeightRange(req.FromHeight, req.ToHeight); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if oldest := sdk.UnwrapSDKContext(ctx).BlockHeight() - types.BurnRecordsRetention + 1; req.FromHeight < oldest {
+		return nil, status.Errorf(codes.InvalidArgument, "burn records below height %d are pruned", oldest)
+	}
+
+	modules, err := q.k.ModuleSupplyChangesInRange(ctx, req.FromHeight, req.ToHeight, req.Module)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBurnsResponse{
+		Modules:   modules,
+		NetBurned: types.NetBurned(modules),
+	}, nil
+}
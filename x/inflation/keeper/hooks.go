@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/evm/x/epochs/types"
+
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks wrapper struct for the inflation keeper
+type Hooks struct {
+	k Keeper
+}
+
+// EpochHooks returns the wrapper struct implementing the x/epochs hooks.
+func (k Keeper) EpochHooks() Hooks {
+	return Hooks{k}
+}
+
+// GetModuleName implements EpochHooks.
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
+
+// BeforeEpochStart implements EpochHooks.
+func (h Hooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// AfterEpochEnd re-derives the x/mint blocks_per_year and, in epoch minting
+// mode, releases the accrued provisions at the end of the configured epochs.
+func (h Hooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := h.k.AdjustBlocksPerYear(sdkCtx, epochIdentifier, epochNumber); err != nil {
+		return err
+	}
+	return h.k.MintEpochProvisions(sdkCtx, epochIdentifier)
+}
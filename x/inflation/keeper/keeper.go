@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+// Keeper of the inflation store
+type Keeper struct {
+	cdc                   codec.BinaryCodec
+	storeService          storetypes.KVStoreService
+	transientStoreService storetypes.TransientStoreService
+	mintKeeper            *mintkeeper.Keeper
+	bankKeeper            types.BankKeeper
+	distrKeeper           types.DistributionKeeper
+
+	// curves maps every selectable curve to the formula that implements it.
+	curves map[types.InflationCurve]minttypes.InflationCalculationFn
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema              collections.Schema
+	Params              collections.Item[types.Params]
+	BlockTimeCheckpoint collections.Item[types.BlockTimeCheckpoint]
+	ProvisionTotals     collections.Item[types.ProvisionTotals]
+	MintCheckpoint      collections.Item[types.BlockTimeCheckpoint]
+	// BurnTotals maps each tracked module account to its cumulative supply
+	// change.
+	BurnTotals collections.Map[string, types.SupplyChange]
+	// BurnRecords holds the supply change of each tracked module account by
+	// height.
+	BurnRecords collections.Map[collections.Pair[int64, string], types.SupplyChange]
+
+	// PendingSupplyChanges accumulates the supply changes of the current tx
+	// per tracked module account, until they are recorded net.
+	PendingSupplyChanges collections.Map[string, types.SupplyChange]
+}
+
+// NewKeeper creates a new inflation Keeper instance.
+//
+// The mint keeper is taken by reference because the x/mint keeper is built
+// with this keeper's MintFn and therefore has to be constructed afterwards.
+// The same holds for the distribution keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	transientStoreService storetypes.TransientStoreService,
+	mintKeeper *mintkeeper.Keeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
+	curves map[types.InflationCurve]minttypes.InflationCalculationFn,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	tsb := collections.NewSchemaBuilderFromAccessor(transientStoreService.OpenTransientStore)
+	k := Keeper{
+		cdc:                   cdc,
+		storeService:          storeService,
+		transientStoreService: transientStoreService,
+		mintKeeper:            mintKeeper,
+		bankKeeper:            bankKeeper,
+		distrKeeper:           distrKeeper,
+		curves:                curves,
+		authority:             authority,
+		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BlockTimeCheckpoint: collections.NewItem(
+			sb, types.BlockTimeCheckpointKey, "block_time_checkpoint", codec.CollValue[types.BlockTimeCheckpoint](cdc),
+		),
+		ProvisionTotals: collections.NewItem(
+			sb, types.ProvisionTotalsKey, "provision_totals", codec.CollValue[types.ProvisionTotals](cdc),
+		),
+		MintCheckpoint: collections.NewItem(
+			sb, types.MintCheckpointKey, "mint_checkpoint", codec.CollValue[types.BlockTimeCheckpoint](cdc),
+		),
+		BurnTotals: collections.NewMap(
+			sb, types.BurnTotalsKey, "burn_totals", collections.StringKey, codec.CollValue[types.SupplyChange](cdc),
+		),
+		BurnRecords: collections.NewMap(
+			sb, types.BurnRecordsKey, "burn_records",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+			codec.CollValue[types.SupplyChange](cdc),
+		),
+		PendingSupplyChanges: collections.NewMap(
+			tsb, types.PendingSupplyChangesKey, "pending_supply_changes",
+			collections.StringKey, codec.CollValue[types.SupplyChange](cdc),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	if _, err := tsb.Build(); err != nil {
+		panic(err)
+	}
+
+	return k
+}
+
+// GetAuthority returns the x/inflation module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// InflationCalculationFn returns the formula for the curve selected in the
+// given params. The piecewise-linear curve is built from the params'
+// breakpoints; every other curve must be registered with the keeper.
+func (k Keeper) InflationCalculationFn(params types.Params) (minttypes.InflationCalculationFn, error) {
+	if params.Curve == types.INFLATION_CURVE_PIECEWISE_LINEAR {
+		return piecewiseLinear(params.Breakpoints), nil
+	}
+
+	fn, ok := k.curves[params.Curve]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", types.ErrCurveNotRegistered, params.Curve)
+	}
+	return fn, nil
+}
@@ -0,0 +1,338 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	minttestutil "github.com/cosmos/cosmos-sdk/x/mint/testutil"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/TacBuild/tacchain/x/inflation"
+	"github.com/TacBuild/tacchain/x/inflation/keeper"
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+var (
+	linearRate    = math.LegacyNewDecWithPrec(5, 2)
+	parabolicRate = math.LegacyNewDecWithPrec(7, 2)
+)
+
+// supplyKeeper is a fixed-supply BankKeeper that tracks the balances of the
+// accounts it sends to.
+type supplyKeeper struct {
+	supply   math.Int
+	balances map[string]sdk.Coins
+}
+
+func (s *supplyKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, s.supply)
+}
+
+func (s *supplyKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return s.balances[addr.String()]
+}
+
+func (s *supplyKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return s.send(authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (s *supplyKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return s.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (s *supplyKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	if from.Equals(authtypes.NewModuleAddress(minttypes.ModuleName)) {
+		// the mint module's balance is not tracked
+		s.balances[to.String()] = s.balances[to.String()].Add(amt...)
+		return nil
+	}
+	balance, hasNeg := s.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds: %s < %s", s.balances[from.String()], amt)
+	}
+	s.balances[from.String()] = balance
+	s.balances[to.String()] = s.balances[to.String()].Add(amt...)
+	return nil
+}
+
+// communityPool is a DistributionKeeper recording the funded amount.
+type communityPool struct {
+	funded sdk.Coins
+}
+
+func (c *communityPool) FundCommunityPool(_ context.Context, amount sdk.Coins, _ sdk.AccAddress) error {
+	c.funded = c.funded.Add(amount...)
+	return nil
+}
+
+type fixture struct {
+	ctx           sdk.Context
+	keeper        keeper.Keeper
+	mintKeeper    *mintkeeper.Keeper
+	stakingKeeper *minttestutil.MockStakingKeeper
+	bankKeeper    *minttestutil.MockBankKeeper
+	supplyKeeper  *supplyKeeper
+	communityPool *communityPool
+	authority     string
+}
+
+func defaultCurves() map[types.InflationCurve]minttypes.InflationCalculationFn {
+	return map[types.InflationCurve]minttypes.InflationCalculationFn{
+		types.INFLATION_CURVE_LINEAR:    constantCurve(linearRate),
+		types.INFLATION_CURVE_PARABOLIC: constantCurve(parabolicRate),
+	}
+}
+
+func paramsWithCurve(curve types.InflationCurve) types.Params {
+	params := types.DefaultParams()
+	params.Curve = curve
+	return params
+}
+
+func constantCurve(rate math.LegacyDec) minttypes.InflationCalculationFn {
+	return func(context.Context, minttypes.Minter, minttypes.Params, math.LegacyDec) math.LegacyDec {
+		return rate
+	}
+}
+
+func newFixture(t *testing.T, curves map[types.InflationCurve]minttypes.InflationCalculationFn) *fixture {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{}, inflation.AppModuleBasic{})
+	mintKey := storetypes.NewKVStoreKey(minttypes.StoreKey)
+	inflationKey := storetypes.NewKVStoreKey(types.StoreKey)
+	inflationTKey := storetypes.NewTransientStoreKey(types.TransientStoreKey)
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{minttypes.StoreKey: mintKey, types.StoreKey: inflationKey},
+		map[string]*storetypes.TransientStoreKey{types.TransientStoreKey: inflationTKey},
+		nil,
+	)
+
+	ctrl := gomock.NewController(t)
+	accountKeeper := minttestutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := minttestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(minttypes.ModuleName).
+		Return(authtypes.NewModuleAddress(minttypes.ModuleName))
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	f := &fixture{
+		ctx:           ctx,
+		stakingKeeper: stakingKeeper,
+		bankKeeper:    bankKeeper,
+		supplyKeeper:  &supplyKeeper{supply: math.ZeroInt(), balances: map[string]sdk.Coins{}},
+		communityPool: &communityPool{},
+		authority:     authority,
+	}
+
+	// Mirror the app wiring: the inflation keeper holds a pointer to the mint
+	// keeper, which is only built afterwards with the inflation MintFn.
+	f.mintKeeper = &mintkeeper.Keeper{}
+	f.keeper = keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(inflationKey),
+		runtime.NewTransientStoreService(inflationTKey),
+		f.mintKeeper,
+		f.supplyKeeper,
+		f.communityPool,
+		curves,
+		authority,
+	)
+
+	*f.mintKeeper = mintkeeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(mintKey),
+		stakingKeeper,
+		accountKeeper,
+		bankKeeper,
+		authtypes.FeeCollectorName,
+		authority,
+		mintkeeper.WithMintFn(f.keeper.MintFn()),
+	)
+
+	require.NoError(t, f.mintKeeper.Params.Set(ctx, minttypes.DefaultParams()))
+	require.NoError(t, f.mintKeeper.Minter.Set(ctx, minttypes.DefaultInitialMinter()))
+	require.NoError(t, f.keeper.Params.Set(ctx, types.DefaultParams()))
+	require.NoError(t, f.keeper.ProvisionTotals.Set(ctx, types.ProvisionTotals{}))
+
+	return f
+}
+
+// expectMint sets up the staking and bank calls made by x/mint's default
+// minting function for a single block.
+func (f *fixture) expectMint() {
+	f.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(math.NewInt(1_000_000_000_000), nil)
+	f.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(5, 1), nil)
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), minttypes.ModuleName, gomock.Any()).Return(nil).MaxTimes(1)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), minttypes.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil).MaxTimes(1)
+}
+
+func TestMintFnUsesSelectedCurve(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+
+	f.expectMint()
+	require.NoError(t, f.mintKeeper.MintFn(f.ctx))
+	minter, err := f.mintKeeper.Minter.Get(f.ctx)
+	require.NoError(t, err)
+	require.True(t, linearRate.Equal(minter.Inflation))
+
+	require.NoError(t, f.keeper.Params.Set(f.ctx, paramsWithCurve(types.INFLATION_CURVE_PARABOLIC)))
+
+	f.expectMint()
+	require.NoError(t, f.mintKeeper.MintFn(f.ctx))
+	minter, err = f.mintKeeper.Minter.Get(f.ctx)
+	require.NoError(t, err)
+	require.True(t, parabolicRate.Equal(minter.Inflation))
+}
+
+func TestMintFnClampsNegativeInflation(t *testing.T) {
+	f := newFixture(t, map[types.InflationCurve]minttypes.InflationCalculationFn{
+		types.INFLATION_CURVE_LINEAR: constantCurve(math.LegacyNewDecWithPrec(-1, 2)),
+	})
+
+	f.expectMint()
+	require.NoError(t, f.mintKeeper.MintFn(f.ctx))
+	minter, err := f.mintKeeper.Minter.Get(f.ctx)
+	require.NoError(t, err)
+	require.True(t, minter.Inflation.IsZero())
+	require.True(t, minter.AnnualProvisions.IsZero())
+}
+
+func TestCurveQuery(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	require.NoError(t, f.keeper.Params.Set(f.ctx, paramsWithCurve(types.INFLATION_CURVE_PARABOLIC)))
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).Curve(f.ctx, &types.QueryCurveRequest{})
+	require.NoError(t, err)
+
+	mintParams := minttypes.DefaultParams()
+	require.Equal(t, types.INFLATION_CURVE_PARABOLIC, res.Curve)
+	require.True(t, mintParams.InflationMin.Equal(res.InflationMin))
+	require.True(t, mintParams.InflationMax.Equal(res.InflationMax))
+	require.True(t, mintParams.GoalBonded.Equal(res.GoalBonded))
+}
+
+func TestUpdateParams(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		errMsg string
+	}{
+		{
+			name:   "invalid authority",
+			msg:    &types.MsgUpdateParams{Authority: "invalid", Params: types.DefaultParams()},
+			errMsg: "invalid authority",
+		},
+		{
+			name:   "unspecified curve",
+			msg:    &types.MsgUpdateParams{Authority: f.authority, Params: paramsWithCurve(types.INFLATION_CURVE_UNSPECIFIED)},
+			errMsg: "inflation curve must be specified",
+		},
+		{
+			name:   "unknown curve",
+			msg:    &types.MsgUpdateParams{Authority: f.authority, Params: paramsWithCurve(types.InflationCurve(42))},
+			errMsg: "unknown inflation curve",
+		},
+		{
+			name: "switch to parabolic",
+			msg:  &types.MsgUpdateParams{Authority: f.authority, Params: paramsWithCurve(types.INFLATION_CURVE_PARABOLIC)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.UpdateParams(f.ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			params, err := f.keeper.Params.Get(f.ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.msg.Params, params)
+		})
+	}
+}
+
+func TestUpdateParamsRejectsUnregisteredCurve(t *testing.T) {
+	f := newFixture(t, map[types.InflationCurve]minttypes.InflationCalculationFn{
+		types.INFLATION_CURVE_LINEAR: constantCurve(linearRate),
+	})
+
+	_, err := keeper.NewMsgServerImpl(f.keeper).UpdateParams(f.ctx, &types.MsgUpdateParams{
+		Authority: f.authority,
+		Params:    paramsWithCurve(types.INFLATION_CURVE_PARABOLIC),
+	})
+	require.ErrorIs(t, err, types.ErrCurveNotRegistered)
+}
+
+func TestPiecewiseLinearCurve(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	params := paramsWithCurve(types.INFLATION_CURVE_PIECEWISE_LINEAR)
+	params.Breakpoints = []types.Breakpoint{
+		types.NewBreakpoint(math.LegacyZeroDec(), math.LegacyNewDecWithPrec(2, 2)),
+		types.NewBreakpoint(math.LegacyOneDec(), math.LegacyNewDecWithPrec(10, 2)),
+	}
+
+	_, err := keeper.NewMsgServerImpl(f.keeper).UpdateParams(f.ctx, &types.MsgUpdateParams{
+		Authority: f.authority,
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	// The fixture reports a bonded ratio of 50%.
+	f.expectMint()
+	require.NoError(t, f.mintKeeper.MintFn(f.ctx))
+	minter, err := f.mintKeeper.Minter.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(6, 2).String(), minter.Inflation.String())
+}
+
+func TestEvaluateCurveQuery(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+
+	res, err := queryServer.EvaluateCurve(f.ctx, &types.QueryEvaluateCurveRequest{BondedRatio: "0.5"})
+	require.NoError(t, err)
+	require.Equal(t, types.INFLATION_CURVE_LINEAR, res.Curve)
+	require.True(t, linearRate.Equal(res.Inflation))
+
+	res, err = queryServer.EvaluateCurve(f.ctx, &types.QueryEvaluateCurveRequest{
+		BondedRatio: "0.5",
+		Curve:       types.INFLATION_CURVE_PARABOLIC,
+	})
+	require.NoError(t, err)
+	require.True(t, parabolicRate.Equal(res.Inflation))
+
+	// No breakpoints are configured yet.
+	_, err = queryServer.EvaluateCurve(f.ctx, &types.QueryEvaluateCurveRequest{
+		BondedRatio: "0.5",
+		Curve:       types.INFLATION_CURVE_PIECEWISE_LINEAR,
+	})
+	require.ErrorContains(t, err, "needs at least 2 breakpoints")
+
+	_, err = queryServer.EvaluateCurve(f.ctx, &types.QueryEvaluateCurveRequest{BondedRatio: "1.5"})
+	require.ErrorContains(t, err, "bonded ratio must be in [0, 1]")
+
+	_, err = queryServer.EvaluateCurve(f.ctx, &types.QueryEvaluateCurveRequest{BondedRatio: "abc"})
+	require.ErrorContains(t, err, "invalid bonded ratio")
+}
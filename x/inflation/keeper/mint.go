@@ -0,0 +1,303 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+// MintFn returns the x/mint minting function. On every block it resolves the
+// curve selected in the module params and updates the minter with it, so
+// governance can switch curves without a binary upgrade. In per-block minting
+// mode it then mints the block provision; in epoch mode provisions are
+// released by the epoch hook instead.
+//
+// It follows x/mint's DefaultMintFn, except that the provision is clamped to
+// the headroom left under the optional max supply and that the treasury and
+// community pool shares are split off before the rest goes to the fee
+// collector.
+func (k Keeper) MintFn() mintkeeper.MintFn {
+	return func(ctx sdk.Context, mk *mintkeeper.Keeper) error {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		state, err := k.updateMinter(ctx, mk, params)
+		if err != nil {
+			return err
+		}
+
+		if params.MintingMode == types.MINTING_MODE_EPOCH {
+			return nil
+		}
+
+		return k.mintProvision(ctx, mk, params, state, state.minter.BlockProvision(state.mintParams))
+	}
+}
+
+// MintEpochProvisions releases, at the end of the minting epoch, the
+// provisions for the wall-clock time elapsed since the previous release. The
+// first epoch end after switching to epoch minting only records the release
+// time.
+func (k Keeper) MintEpochProvisions(ctx sdk.Context, epochIdentifier string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MintingMode != types.MINTING_MODE_EPOCH || params.MintingEpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	return k.releaseEpochProvisions(ctx, params)
+}
+
+// releaseEpochProvisions mints the provisions accrued since the last release
+// and moves the release checkpoint to the current block.
+func (k Keeper) releaseEpochProvisions(ctx sdk.Context, params types.Params) error {
+	current := types.BlockTimeCheckpoint{Height: ctx.BlockHeight(), Time: ctx.BlockTime()}
+	last, err := k.MintCheckpoint.Get(ctx)
+	found := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err := k.MintCheckpoint.Set(ctx, current); err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+
+	elapsed := current.Time.Sub(last.Time)
+	if elapsed <= 0 {
+		return nil
+	}
+
+	state, err := k.updateMinter(ctx, k.mintKeeper, params)
+	if err != nil {
+		return err
+	}
+
+	provision := sdk.NewCoin(
+		state.mintParams.MintDenom,
+		state.minter.AnnualProvisions.MulInt64(int64(elapsed)).QuoInt64(int64(year)).TruncateInt(),
+	)
+	return k.mintProvision(ctx, k.mintKeeper, params, state, provision)
+}
+
+// mintState is the x/mint state a provision is minted from.
+type mintState struct {
+	minter      minttypes.Minter
+	mintParams  minttypes.Params
+	bondedRatio math.LegacyDec
+}
+
+// updateMinter recalculates the inflation rate and annual provisions with the
+// selected curve and stores them in the x/mint minter.
+func (k Keeper) updateMinter(ctx sdk.Context, mk *mintkeeper.Keeper, params types.Params) (mintState, error) {
+	ic, err := k.InflationCalculationFn(params)
+	if err != nil {
+		return mintState{}, err
+	}
+
+	minter, err := mk.Minter.Get(ctx)
+	if err != nil {
+		return mintState{}, err
+	}
+
+	mintParams, err := mk.Params.Get(ctx)
+	if err != nil {
+		return mintState{}, err
+	}
+
+	totalStakingSupply, err := mk.StakingTokenSupply(ctx)
+	if err != nil {
+		return mintState{}, err
+	}
+
+	bondedRatio, err := mk.BondedRatio(ctx)
+	if err != nil {
+		return mintState{}, err
+	}
+
+	minter.Inflation = nonNegative(ic)(ctx, minter, mintParams, bondedRatio)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(mintParams, totalStakingSupply)
+	if err = mk.Minter.Set(ctx, minter); err != nil {
+		return mintState{}, err
+	}
+
+	return mintState{minter: minter, mintParams: mintParams, bondedRatio: bondedRatio}, nil
+}
+
+// mintProvision mints the provision, clamped to the max supply headroom, and
+// splits it between its destinations.
+func (k Keeper) mintProvision(ctx sdk.Context, mk *mintkeeper.Keeper, params types.Params, state mintState, mintedCoin sdk.Coin) error {
+	if headroom, capped := k.SupplyHeadroom(ctx, params.MaxSupply, mintedCoin.Denom); capped && mintedCoin.Amount.GT(headroom) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSupplyCapped,
+				sdk.NewAttribute(types.AttributeKeyMaxSupply, params.MaxSupply.String()),
+				sdk.NewAttribute(types.AttributeKeyBlockProvision, mintedCoin.Amount.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, headroom.String()),
+			),
+		)
+		mintedCoin.Amount = headroom
+	}
+	mintedCoins := sdk.NewCoins(mintedCoin)
+
+	if err := mk.MintCoins(ctx, mintedCoins); err != nil {
+		return err
+	}
+
+	if err := k.splitProvisions(ctx, mk, params, mintedCoins); err != nil {
+		return err
+	}
+
+	if mintedCoin.Amount.IsInt64() {
+		defer telemetry.ModuleSetGauge(minttypes.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			minttypes.EventTypeMint,
+			sdk.NewAttribute(minttypes.AttributeKeyBondedRatio, state.bondedRatio.String()),
+			sdk.NewAttribute(minttypes.AttributeKeyInflation, state.minter.Inflation.String()),
+			sdk.NewAttribute(minttypes.AttributeKeyAnnualProvisions, state.minter.AnnualProvisions.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// splitProvisions sends the treasury and community pool fractions of the
+// minted coins to their destinations and the remainder to the fee collector.
+func (k Keeper) splitProvisions(ctx sdk.Context, mk *mintkeeper.Keeper, params types.Params, minted sdk.Coins) error {
+	treasury := fractionOf(minted, params.TreasuryFraction)
+	communityPool := fractionOf(minted, params.CommunityPoolFraction)
+	feeCollector := minted.Sub(treasury...).Sub(communityPool...)
+
+	if !treasury.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.TreasuryName, treasury); err != nil {
+			return err
+		}
+	}
+
+	if !communityPool.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, authtypes.NewModuleAddress(minttypes.ModuleName)); err != nil {
+			return err
+		}
+	}
+
+	// send the remaining minted coins to the fee collector account
+	if err := mk.AddCollectedFees(ctx, feeCollector); err != nil {
+		return err
+	}
+
+	if minted.IsZero() {
+		return nil
+	}
+
+	totals, err := k.ProvisionTotals.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err := k.ProvisionTotals.Set(ctx, totals.Add(feeCollector, treasury, communityPool)); err != nil {
+		return err
+	}
+
+	if treasury.IsZero() && communityPool.IsZero() {
+		return nil
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSplitProvisions,
+			sdk.NewAttribute(types.AttributeKeyFeeCollector, feeCollector.String()),
+			sdk.NewAttribute(types.AttributeKeyTreasury, treasury.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPool, communityPool.String()),
+		),
+	)
+
+	return nil
+}
+
+// fractionOf returns the truncated fraction of every coin.
+func fractionOf(coins sdk.Coins, fraction math.LegacyDec) sdk.Coins {
+	if fraction.IsNil() || fraction.IsZero() {
+		return sdk.NewCoins()
+	}
+
+	out := sdk.NewCoins()
+	for _, coin := range coins {
+		out = out.Add(sdk.NewCoin(coin.Denom, fraction.MulInt(coin.Amount).TruncateInt()))
+	}
+	return out
+}
+
+// SupplyHeadroom returns how much of denom can still be minted under
+// maxSupply. capped is false when maxSupply is zero, i.e. the cap is disabled.
+func (k Keeper) SupplyHeadroom(ctx context.Context, maxSupply math.Int, denom string) (headroom math.Int, capped bool) {
+	if maxSupply.IsNil() || maxSupply.IsZero() {
+		return math.ZeroInt(), false
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	if supply.Amount.GTE(maxSupply) {
+		return math.ZeroInt(), true
+	}
+	return maxSupply.Sub(supply.Amount), true
+}
+
+// nonNegative wraps an inflation formula so that it never yields a negative
+// rate, which would otherwise make x/mint panic on a negative block provision.
+func nonNegative(ic minttypes.InflationCalculationFn) minttypes.InflationCalculationFn {
+	return func(ctx context.Context, minter minttypes.Minter, params minttypes.Params, bondedRatio math.LegacyDec) math.LegacyDec {
+		inflation := ic(ctx, minter, params, bondedRatio)
+		if inflation.IsNegative() {
+			return math.LegacyZeroDec()
+		}
+		return inflation
+	}
+}
+
+// piecewiseLinear returns the formula interpolating between the given
+// breakpoints. The x/mint params do not shape this curve.
+func piecewiseLinear(breakpoints []types.Breakpoint) minttypes.InflationCalculationFn {
+	return func(_ context.Context, _ minttypes.Minter, _ minttypes.Params, bondedRatio math.LegacyDec) math.LegacyDec {
+		return types.InterpolateBreakpoints(breakpoints, bondedRatio)
+	}
+}
+
+// switchMintingMode hands over between the minting modes without gaps: when
+// leaving epoch mode the provisions accrued since the last release are minted,
+// and when entering it the release interval starts at the current block, the
+// last one minted per block.
+func (k Keeper) switchMintingMode(ctx sdk.Context, oldParams types.Params, mode types.MintingMode) error {
+	if oldParams.MintingMode == mode {
+		return nil
+	}
+
+	if oldParams.MintingMode == types.MINTING_MODE_EPOCH {
+		if err := k.releaseEpochProvisions(ctx, oldParams); err != nil {
+			return err
+		}
+		return k.MintCheckpoint.Remove(ctx)
+	}
+
+	if mode == types.MINTING_MODE_EPOCH {
+		return k.MintCheckpoint.Set(ctx, types.BlockTimeCheckpoint{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+// projection holds the inputs of an inflation projection.
+type projection struct {
+	ic             minttypes.InflationCalculationFn
+	minter         minttypes.Minter
+	mintParams     minttypes.Params
+	supply         math.Int
+	maxSupply      math.Int
+	bondedRatios   []math.LegacyDec
+	blockTime      time.Duration
+	periods        uint32
+	periodsPerYear uint32
+}
+
+// run replays the mint function period by period. Within a period the bonded
+// ratio, and therefore the inflation rate, is constant, while the supply
+// compounds every block exactly as x/mint does: each block mints
+// inflation * supply / blocks_per_year.
+func (p projection) run(ctx context.Context) []types.ProjectionPeriod {
+	blocks := p.mintParams.BlocksPerYear / uint64(p.periodsPerYear)
+	if p.blockTime > 0 {
+		blocks = uint64(year / time.Duration(p.periodsPerYear) / p.blockTime)
+	}
+
+	minter := p.minter
+	supply := p.supply
+	rows := make([]types.ProjectionPeriod, 0, p.periods)
+	for i := uint32(0); i < p.periods; i++ {
+		bondedRatio := p.bondedRatios[min(int(i), len(p.bondedRatios)-1)]
+
+		minter.Inflation = p.ic(ctx, minter, p.mintParams, bondedRatio)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(p.mintParams, supply)
+
+		growth := math.LegacyOneDec().
+			Add(minter.Inflation.QuoInt64(int64(p.mintParams.BlocksPerYear))).
+			Power(blocks)
+		end := growth.MulInt(supply).TruncateInt()
+		if p.maxSupply.IsPositive() && end.GT(p.maxSupply) {
+			end = math.MaxInt(p.maxSupply, supply)
+		}
+
+		effective := math.LegacyZeroDec()
+		if supply.IsPositive() {
+			effective = math.LegacyNewDecFromInt(end.Sub(supply)).
+				QuoInt(supply).
+				MulInt64(int64(p.periodsPerYear))
+		}
+
+		rows = append(rows, types.ProjectionPeriod{
+			Period:             i + 1,
+			Blocks:             blocks,
+			BondedRatio:        bondedRatio,
+			Supply:             end,
+			AnnualProvisions:   minter.AnnualProvisions,
+			Inflation:          minter.Inflation,
+			EffectiveInflation: effective,
+		})
+		supply = end
+	}
+
+	return rows
+}
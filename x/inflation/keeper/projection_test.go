@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/TacBuild/tacchain/x/inflation/keeper"
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+func TestProjectionQuery(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	f.supplyKeeper.supply = math.NewInt(1_000_000_000_000)
+	blocksPerYear := minttypes.DefaultParams().BlocksPerYear
+
+	// Without a path the current bonded ratio is used.
+	f.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(5, 1), nil)
+	res, err := queryServer.Projection(f.ctx, &types.QueryProjectionRequest{Years: 2})
+	require.NoError(t, err)
+	require.Equal(t, types.INFLATION_CURVE_LINEAR, res.Curve)
+	require.Len(t, res.Periods, 2)
+
+	first := res.Periods[0]
+	require.Equal(t, uint32(1), first.Period)
+	require.Equal(t, blocksPerYear, first.Blocks)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), first.BondedRatio)
+	require.True(t, linearRate.Equal(first.Inflation))
+	require.Equal(t, math.LegacyNewDec(50_000_000_000), first.AnnualProvisions)
+	// Per-block compounding makes the effective rate slightly exceed 5%.
+	require.True(t, first.EffectiveInflation.GT(linearRate))
+	require.True(t, first.EffectiveInflation.LT(math.LegacyNewDecWithPrec(513, 4)))
+	require.True(t, res.Periods[1].Supply.GT(first.Supply))
+
+	// Blocks twice as fast as blocks_per_year assumes mint twice as much.
+	res, err = queryServer.Projection(f.ctx, &types.QueryProjectionRequest{
+		Years:        1,
+		BondedRatios: []math.LegacyDec{math.LegacyNewDecWithPrec(5, 1)},
+		BlockTime:    year() / time.Duration(blocksPerYear) / 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2*blocksPerYear, res.Periods[0].Blocks)
+	require.True(t, res.Periods[0].EffectiveInflation.GT(math.LegacyNewDecWithPrec(10, 2)))
+}
+
+func TestProjectionQueryPathAndCap(t *testing.T) {
+	f := newFixture(t, map[types.InflationCurve]minttypes.InflationCalculationFn{
+		types.INFLATION_CURVE_LINEAR: func(_ context.Context, _ minttypes.Minter, _ minttypes.Params, bondedRatio math.LegacyDec) math.LegacyDec {
+			return bondedRatio.QuoInt64(10)
+		},
+	})
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	f.supplyKeeper.supply = math.NewInt(1_000_000)
+	f.setMaxSupply(t, math.NewInt(1_030_000))
+
+	res, err := queryServer.Projection(f.ctx, &types.QueryProjectionRequest{
+		Years:          1,
+		PeriodsPerYear: 4,
+		BondedRatios:   []math.LegacyDec{math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(8, 1)},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Periods, 4)
+
+	// The last value of the path holds for the remaining periods.
+	require.True(t, math.LegacyNewDecWithPrec(2, 2).Equal(res.Periods[0].Inflation))
+	for _, row := range res.Periods[1:] {
+		require.True(t, math.LegacyNewDecWithPrec(8, 2).Equal(row.Inflation))
+	}
+
+	// The supply never exceeds the cap and stops growing once it is hit.
+	last := res.Periods[3]
+	require.Equal(t, math.NewInt(1_030_000), last.Supply)
+	require.True(t, last.EffectiveInflation.IsZero())
+}
+
+func TestProjectionQueryValidation(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+
+	testCases := []struct {
+		name   string
+		req    *types.QueryProjectionRequest
+		errMsg string
+	}{
+		{"zero years", &types.QueryProjectionRequest{}, "years must be in"},
+		{"too many years", &types.QueryProjectionRequest{Years: types.MaxProjectionYears + 1}, "years must be in"},
+		{"too many periods", &types.QueryProjectionRequest{Years: 100, PeriodsPerYear: 365}, "exceeds the limit"},
+		{"negative block time", &types.QueryProjectionRequest{Years: 1, BlockTime: -time.Second}, "block time cannot be negative"},
+		{
+			"bonded ratio out of range",
+			&types.QueryProjectionRequest{Years: 1, BondedRatios: []math.LegacyDec{math.LegacyNewDec(2)}},
+			"bonded ratio 0 must be in [0, 1]",
+		},
+		{
+			"piecewise curve without breakpoints",
+			&types.QueryProjectionRequest{Years: 1, Curve: types.INFLATION_CURVE_PIECEWISE_LINEAR},
+			"needs at least 2 breakpoints",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := queryServer.Projection(f.ctx, tc.req)
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+func year() time.Duration {
+	return 365 * 24 * time.Hour
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/TacBuild/tacchain/x/inflation/keeper"
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+func TestMintFnSplitsProvisions(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	params := types.DefaultParams()
+	params.TreasuryFraction = math.LegacyNewDecWithPrec(1, 1)
+	params.CommunityPoolFraction = math.LegacyNewDecWithPrec(25, 2)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// 5% of 1e12 over the default 6_311_520 blocks per year mints 7_922 per block.
+	denom := minttypes.DefaultParams().MintDenom
+	minted := sdk.NewCoins(sdk.NewInt64Coin(denom, 7_922))
+	treasury := sdk.NewCoins(sdk.NewInt64Coin(denom, 792))
+	communityPool := sdk.NewCoins(sdk.NewInt64Coin(denom, 1_980))
+	feeCollector := sdk.NewCoins(sdk.NewInt64Coin(denom, 5_150))
+
+	f.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(math.NewInt(1_000_000_000_000), nil)
+	f.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(5, 1), nil)
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), minttypes.ModuleName, minted).Return(nil)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), minttypes.ModuleName, authtypes.FeeCollectorName, feeCollector).Return(nil)
+
+	require.NoError(t, f.mintKeeper.MintFn(f.ctx))
+	require.Equal(t, treasury, f.supplyKeeper.balances[authtypes.NewModuleAddress(types.TreasuryName).String()])
+	require.Equal(t, communityPool, f.communityPool.funded)
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).ProvisionFlows(f.ctx, &types.QueryProvisionFlowsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, feeCollector, res.Totals.FeeCollector)
+	require.Equal(t, treasury, res.Totals.Treasury)
+	require.Equal(t, communityPool, res.Totals.CommunityPool)
+	require.Equal(t, treasury, res.TreasuryBalance)
+	require.Equal(t, authtypes.NewModuleAddress(types.TreasuryName).String(), res.TreasuryAddress)
+}
+
+func TestSpendTreasury(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	treasury := authtypes.NewModuleAddress(types.TreasuryName).String()
+	recipient := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	f.supplyKeeper.balances[treasury] = sdk.NewCoins(sdk.NewInt64Coin("utac", 100))
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSpendTreasury
+		errMsg string
+	}{
+		{
+			name:   "invalid authority",
+			msg:    &types.MsgSpendTreasury{Authority: "invalid", Recipient: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("utac", 1))},
+			errMsg: "invalid authority",
+		},
+		{
+			name:   "invalid recipient",
+			msg:    &types.MsgSpendTreasury{Authority: f.authority, Recipient: "invalid", Amount: sdk.NewCoins(sdk.NewInt64Coin("utac", 1))},
+			errMsg: "invalid recipient address",
+		},
+		{
+			name:   "empty amount",
+			msg:    &types.MsgSpendTreasury{Authority: f.authority, Recipient: recipient},
+			errMsg: "invalid coins",
+		},
+		{
+			name:   "insufficient funds",
+			msg:    &types.MsgSpendTreasury{Authority: f.authority, Recipient: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("utac", 101))},
+			errMsg: "insufficient funds",
+		},
+		{
+			name: "success",
+			msg:  &types.MsgSpendTreasury{Authority: f.authority, Recipient: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("utac", 40))},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.SpendTreasury(f.ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utac", 60)), f.supplyKeeper.balances[treasury])
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utac", 40)), f.supplyKeeper.balances[recipient])
+		})
+	}
+}
+
+func TestParamsValidateProvisionSplit(t *testing.T) {
+	params := types.DefaultParams()
+	params.TreasuryFraction = math.LegacyNewDecWithPrec(6, 1)
+	params.CommunityPoolFraction = math.LegacyNewDecWithPrec(5, 1)
+	require.ErrorContains(t, params.Validate(), "must not sum to more than one")
+
+	params.CommunityPoolFraction = math.LegacyNewDecWithPrec(-1, 1)
+	require.ErrorContains(t, params.Validate(), "community pool fraction cannot be negative")
+
+	params.CommunityPoolFraction = math.LegacyNewDecWithPrec(4, 1)
+	require.NoError(t, params.Validate())
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/TacBuild/tacchain/x/inflation/keeper"
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+func (f *fixture) setMaxSupply(t *testing.T, maxSupply math.Int) {
+	t.Helper()
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.MaxSupply = maxSupply
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+}
+
+func TestMintFnClampsToMaxSupply(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	f.supplyKeeper.supply = math.NewInt(1_000_000_000_000)
+	f.setMaxSupply(t, math.NewInt(1_000_000_000_100))
+
+	denom := minttypes.DefaultParams().MintDenom
+	capped := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	f.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(math.NewInt(1_000_000_000_000), nil)
+	f.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(5, 1), nil)
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), minttypes.ModuleName, capped).Return(nil)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), minttypes.ModuleName, authtypes.FeeCollectorName, capped).Return(nil)
+
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.mintKeeper.MintFn(ctx))
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSupplyCapped {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestMintFnStopsAtMaxSupply(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	f.supplyKeeper.supply = math.NewInt(1_000_000_000_000)
+	f.setMaxSupply(t, math.NewInt(999_000_000_000))
+
+	// Nothing is minted; the empty coin set is still handed to the fee collector.
+	f.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(math.NewInt(1_000_000_000_000), nil)
+	f.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(5, 1), nil)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), minttypes.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins()).Return(nil)
+
+	require.NoError(t, f.mintKeeper.MintFn(f.ctx))
+}
+
+func TestSupplyCapQuery(t *testing.T) {
+	f := newFixture(t, defaultCurves())
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	f.supplyKeeper.supply = math.NewInt(1_000_000)
+
+	minter := minttypes.DefaultInitialMinter()
+	minter.AnnualProvisions = math.LegacyNewDec(int64(minttypes.DefaultParams().BlocksPerYear) * 1_000)
+	require.NoError(t, f.mintKeeper.Minter.Set(f.ctx, minter))
+
+	// Uncapped.
+	res, err := queryServer.SupplyCap(f.ctx, &types.QuerySupplyCapRequest{})
+	require.NoError(t, err)
+	require.True(t, res.MaxSupply.IsZero())
+	require.True(t, res.Headroom.IsZero())
+	require.Equal(t, int64(0), res.ProjectedCapHeight)
+
+	// 10_500 of headroom at 1_000 per block is hit 11 blocks from now.
+	f.setMaxSupply(t, math.NewInt(1_010_500))
+	res, err = queryServer.SupplyCap(f.ctx.WithBlockHeight(50), &types.QuerySupplyCapRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1_000_000), res.Supply.Amount)
+	require.Equal(t, math.NewInt(10_500), res.Headroom)
+	require.Equal(t, math.NewInt(1_000), res.BlockProvision)
+	require.Equal(t, int64(61), res.ProjectedCapHeight)
+}
+
+func TestParamsValidateMaxSupply(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxSupply = math.NewInt(-1)
+	require.ErrorContains(t, params.Validate(), "max supply cannot be negative")
+
+	params.MaxSupply = math.Int{}
+	require.ErrorContains(t, params.Validate(), "max supply cannot be nil")
+}
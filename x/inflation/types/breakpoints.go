@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// MinBreakpoints is the number of breakpoints INFLATION_CURVE_PIECEWISE_LINEAR
+// needs to form at least one segment.
+const MinBreakpoints = 2
+
+// NewBreakpoint creates a new Breakpoint instance.
+func NewBreakpoint(bondedRatio, inflation math.LegacyDec) Breakpoint {
+	return Breakpoint{
+		BondedRatio: bondedRatio,
+		Inflation:   inflation,
+	}
+}
+
+// ValidateBreakpoints checks that every breakpoint lies in the unit square and
+// that bonded ratios are strictly increasing.
+func ValidateBreakpoints(breakpoints []Breakpoint) error {
+	for i, bp := range breakpoints {
+		if err := validateUnitDec("bonded ratio", bp.BondedRatio); err != nil {
+			return fmt.Errorf("breakpoint %d: %w", i, err)
+		}
+		if err := validateUnitDec("inflation", bp.Inflation); err != nil {
+			return fmt.Errorf("breakpoint %d: %w", i, err)
+		}
+		if i > 0 && !bp.BondedRatio.GT(breakpoints[i-1].BondedRatio) {
+			return fmt.Errorf("breakpoint %d: bonded ratio %s must be greater than the previous %s",
+				i, bp.BondedRatio, breakpoints[i-1].BondedRatio)
+		}
+	}
+	return nil
+}
+
+// InterpolateBreakpoints evaluates the piecewise-linear curve through the
+// given breakpoints at bondedRatio. The breakpoints must be valid and not
+// empty.
+func InterpolateBreakpoints(breakpoints []Breakpoint, bondedRatio math.LegacyDec) math.LegacyDec {
+	first, last := breakpoints[0], breakpoints[len(breakpoints)-1]
+	if bondedRatio.LTE(first.BondedRatio) {
+		return first.Inflation
+	}
+	if bondedRatio.GTE(last.BondedRatio) {
+		return last.Inflation
+	}
+
+	for i := 1; i < len(breakpoints); i++ {
+		left, right := breakpoints[i-1], breakpoints[i]
+		if bondedRatio.GT(right.BondedRatio) {
+			continue
+		}
+		// inflation = y0 + (x - x0) * (y1 - y0) / (x1 - x0)
+		slope := right.Inflation.Sub(left.Inflation).Quo(right.BondedRatio.Sub(left.BondedRatio))
+		return left.Inflation.Add(bondedRatio.Sub(left.BondedRatio).Mul(slope))
+	}
+	return last.Inflation
+}
+
+func validateUnitDec(name string, v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s cannot be nil", name)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s cannot be negative: %s", name, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s too large: %s", name, v)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/TacBuild/tacchain/x/inflation/types"
+)
+
+func bp(bondedRatio, inflation string) types.Breakpoint {
+	return types.NewBreakpoint(math.LegacyMustNewDecFromStr(bondedRatio), math.LegacyMustNewDecFromStr(inflation))
+}
+
+func TestValidateBreakpoints(t *testing.T) {
+	testCases := []struct {
+		name        string
+		breakpoints []types.Breakpoint
+		errMsg      string
+	}{
+		{"empty", nil, ""},
+		{"valid", []types.Breakpoint{bp("0", "0.02"), bp("0.7", "0.07"), bp("1", "0.02")}, ""},
+		{"equal bonded ratios", []types.Breakpoint{bp("0.5", "0.02"), bp("0.5", "0.07")}, "must be greater than the previous"},
+		{"decreasing bonded ratios", []types.Breakpoint{bp("0.6", "0.02"), bp("0.5", "0.07")}, "must be greater than the previous"},
+		{"bonded ratio above one", []types.Breakpoint{bp("0", "0.02"), bp("1.1", "0.07")}, "bonded ratio too large"},
+		{"negative inflation", []types.Breakpoint{bp("0", "-0.01")}, "inflation cannot be negative"},
+		{"inflation above one", []types.Breakpoint{bp("0", "1.5")}, "inflation too large"},
+		{"nil inflation", []types.Breakpoint{{BondedRatio: math.LegacyZeroDec()}}, "inflation cannot be nil"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateBreakpoints(tc.breakpoints)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestInterpolateBreakpoints(t *testing.T) {
+	breakpoints := []types.Breakpoint{bp("0.2", "0.03"), bp("0.7", "0.08"), bp("0.9", "0.04")}
+
+	testCases := []struct {
+		bondedRatio string
+		expected    string
+	}{
+		{"0", "0.03"},
+		{"0.2", "0.03"},
+		{"0.45", "0.055"},
+		{"0.7", "0.08"},
+		{"0.8", "0.06"},
+		{"0.9", "0.04"},
+		{"1", "0.04"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.bondedRatio, func(t *testing.T) {
+			got := types.InterpolateBreakpoints(breakpoints, math.LegacyMustNewDecFromStr(tc.bondedRatio))
+			require.Equal(t, math.LegacyMustNewDecFromStr(tc.expected).String(), got.String())
+		})
+	}
+}
+
+func TestParamsValidatePiecewiseLinear(t *testing.T) {
+	params := types.DefaultParams()
+	params.Curve = types.INFLATION_CURVE_PIECEWISE_LINEAR
+	require.ErrorContains(t, params.Validate(), "needs at least 2 breakpoints")
+
+	params.Breakpoints = []types.Breakpoint{bp("0", "0.02"), bp("1", "0.07")}
+	require.NoError(t, params.Validate())
+
+	// Breakpoints are validated even while another curve is active.
+	params.Curve = types.INFLATION_CURVE_LINEAR
+	params.Breakpoints = []types.Breakpoint{bp("1", "0.02"), bp("0", "0.07")}
+	require.Error(t, params.Validate())
+}
@@ -0,0 +1,8 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/inflation module sentinel errors
+var (
+	ErrCurveNotRegistered = errorsmod.Register(ModuleName, 2, "inflation curve not registered")
+)
@@ -0,0 +1,21 @@
+package types
+
+// x/inflation module event types
+const (
+	EventTypeBlocksPerYearAdjusted = "blocks_per_year_adjusted"
+	EventTypeSupplyCapped          = "supply_capped"
+	EventTypeSplitProvisions       = "split_provisions"
+	EventTypeSpendTreasury         = "spend_treasury"
+
+	AttributeKeyEpochIdentifier  = "epoch_identifier"
+	AttributeKeyEpochNumber      = "epoch_number"
+	AttributeKeyAvgBlockTime     = "avg_block_time"
+	AttributeKeyOldBlocksPerYear = "old_blocks_per_year"
+	AttributeKeyNewBlocksPerYear = "new_blocks_per_year"
+	AttributeKeyMaxSupply        = "max_supply"
+	AttributeKeyBlockProvision   = "block_provision"
+	AttributeKeyFeeCollector     = "fee_collector"
+	AttributeKeyTreasury         = "treasury"
+	AttributeKeyCommunityPool    = "community_pool"
+	AttributeKeyRecipient        = "recipient"
+)
@@ -0,0 +1,82 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(params Params, provisionTotals ProvisionTotals, burnTotals []ModuleSupplyChange) *GenesisState {
+	return &GenesisState{
+		Params:          params,
+		ProvisionTotals: provisionTotals,
+		BurnTotals:      burnTotals,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), ProvisionTotals{}, nil)
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	if err := data.ProvisionTotals.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.BurnTotals))
+	for _, burns := range data.BurnTotals {
+		if err := burns.Validate(); err != nil {
+			return err
+		}
+		if seen[burns.Module] {
+			return fmt.Errorf("duplicate burn totals for module %s", burns.Module)
+		}
+		seen[burns.Module] = true
+	}
+
+	type recordKey struct {
+		height int64
+		module string
+	}
+	seenRecords := make(map[recordKey]bool, len(data.BurnRecords))
+	for _, record := range data.BurnRecords {
+		if err := record.Validate(); err != nil {
+			return fmt.Errorf("invalid burn record at height %d: %w", record.Height, err)
+		}
+		key := recordKey{record.Height, record.Module}
+		if seenRecords[key] {
+			return fmt.Errorf("duplicate burn record for module %s at height %d", record.Module, record.Height)
+		}
+		seenRecords[key] = true
+	}
+
+	if data.BlockTimeCheckpoint != nil {
+		if err := data.BlockTimeCheckpoint.Validate(); err != nil {
+			return fmt.Errorf("invalid block time checkpoint: %w", err)
+		}
+	}
+	if data.MintCheckpoint != nil {
+		if data.Params.MintingMode != MINTING_MODE_EPOCH {
+			return fmt.Errorf("mint checkpoint set outside of %s", MINTING_MODE_EPOCH)
+		}
+		if err := data.MintCheckpoint.Validate(); err != nil {
+			return fmt.Errorf("invalid mint checkpoint: %w", err)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the checkpoint is at a positive height and has a
+// block time.
+func (c BlockTimeCheckpoint) Validate() error {
+	if c.Height <= 0 {
+		return fmt.Errorf("height must be positive: %d", c.Height)
+	}
+	if c.Time.IsZero() {
+		return fmt.Errorf("time cannot be zero")
+	}
+	return nil
+}
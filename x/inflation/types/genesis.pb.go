@@ -0,0 +1,642 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tacchain/inflation/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the inflation module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// provision_totals are the provisions split off so far.
+	ProvisionTotals ProvisionTotals `protobuf:"bytes,2,opt,name=provision_totals,json=provisionTotals,proto3" json:"provision_totals"`
+	// burn_totals are the cumulative burns of each tracked module account.
+	BurnTotals []ModuleSupplyChange `protobuf:"bytes,3,rep,name=burn_totals,json=burnTotals,proto3" json:"burn_totals"`
+	// block_time_checkpoint is the block the next blocks_per_year adjustment
+	// measures the block time from, if any.
+	BlockTimeCheckpoint *BlockTimeCheckpoint `protobuf:"bytes,4,opt,name=block_time_checkpoint,json=blockTimeCheckpoint,proto3" json:"block_time_checkpoint,omitempty"`
+	// mint_checkpoint is the last epoch provision release, if any. It is set
+	// in MINTING_MODE_EPOCH only.
+	MintCheckpoint *BlockTimeCheckpoint `protobuf:"bytes,5,opt,name=mint_checkpoint,json=mintCheckpoint,proto3" json:"mint_checkpoint,omitempty"`
+	// burn_records are the supply changes of each tracked module account by
+	// height, within the retained heights.
+	BurnRecords []BurnRecord `protobuf:"bytes,6,rep,name=burn_records,json=burnRecords,proto3" json:"burn_records"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1458a590d8a12986, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetProvisionTotals() ProvisionTotals {
+	if m != nil {
+		return m.ProvisionTotals
+	}
+	return ProvisionTotals{}
+}
+
+func (m *GenesisState) GetBurnTotals() []ModuleSupplyChange {
+	if m != nil {
+		return m.BurnTotals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockTimeCheckpoint() *BlockTimeCheckpoint {
+	if m != nil {
+		return m.BlockTimeCheckpoint
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintCheckpoint() *BlockTimeCheckpoint {
+	if m != nil {
+		return m.MintCheckpoint
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnRecords() []BurnRecord {
+	if m != nil {
+		return m.BurnRecords
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "tacchain.inflation.v1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("tacchain/inflation/v1/genesis.proto", fileDescriptor_1458a590d8a12986)
+}
+
+var fileDescriptor_1458a590d8a12986 = []byte{
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x6e, 0xda, 0x40,
+	0x14, 0x86, 0xed, 0x42, 0x91, 0x3a, 0x46, 0xa5, 0x75, 0x8b, 0x64, 0x21, 0xd5, 0xa5, 0xad, 0x5a,
+	0x51, 0x16, 0xb6, 0xa0, 0x17, 0xa8, 0xcc, 0xa2, 0xdd, 0x54, 0xad, 0x80, 0x6e, 0xa2, 0x28, 0x68,
+	0x3c, 0x4c, 0xec, 0x11, 0xf6, 0xcc, 0xc8, 0x33, 0x46, 0xe1, 0x0c, 0xd9, 0xe4, 0x18, 0x59, 0xe6,
+	0x18, 0x2c, 0x59, 0x66, 0x15, 0x45, 0xb0, 0xc8, 0x35, 0x22, 0x0f, 0xe0, 0x38, 0x0a, 0x5e, 0x64,
+	0x63, 0x59, 0x6f, 0xbe, 0xf7, 0xbd, 0xa7, 0xa7, 0x1f, 0x7c, 0x91, 0x10, 0xa1, 0x10, 0x12, 0xea,
+	0x12, 0x7a, 0x1a, 0x41, 0x49, 0x18, 0x75, 0xe7, 0x3d, 0x37, 0xc0, 0x14, 0x0b, 0x22, 0x1c, 0x9e,
+	0x30, 0xc9, 0xcc, 0xe6, 0x1e, 0x72, 0x72, 0xc8, 0x99, 0xf7, 0x5a, 0xef, 0x03, 0x16, 0x30, 0x45,
+	0xb8, 0xd9, 0xdf, 0x16, 0x6e, 0xbd, 0x85, 0x31, 0xa1, 0xcc, 0x55, 0xdf, 0x5d, 0xe9, 0xeb, 0xe1,
+	0x21, 0x0f, 0x32, 0x85, 0x7d, 0x3e, 0xaf, 0x82, 0xfa, 0xaf, 0xed, 0xe0, 0x91, 0x84, 0x12, 0x9b,
+	0x3f, 0x41, 0x8d, 0xc3, 0x04, 0xc6, 0xc2, 0xd2, 0xdb, 0x7a, 0xc7, 0xe8, 0x7f, 0x70, 0x0e, 0x2e,
+	0xe2, 0xfc, 0x53, 0x90, 0xf7, 0x6a, 0x79, 0xf3, 0x51, 0xbb, 0xbc, 0xbb, 0xea, 0xea, 0xc3, 0x5d,
+	0x9f, 0x79, 0x0c, 0xde, 0xf0, 0x84, 0xcd, 0x89, 0x20, 0x8c, 0x4e, 0x24, 0x93, 0x30, 0x12, 0xd6,
+	0x0b, 0xe5, 0xfa, 0x56, 0xe6, 0xda, 0xe3, 0x63, 0x45, 0x17, 0xa5, 0x0d, 0xfe, 0xf8, 0xcd, 0xfc,
+	0x0f, 0x0c, 0x3f, 0x4d, 0x72, 0x71, 0xa5, 0x5d, 0xe9, 0x18, 0xfd, 0xef, 0x25, 0xe2, 0x3f, 0x6c,
+	0x9a, 0x46, 0x78, 0x94, 0x72, 0x1e, 0x2d, 0x06, 0x21, 0xa4, 0x01, 0x2e, 0xba, 0x41, 0x26, 0xda,
+	0x69, 0x4f, 0x40, 0xd3, 0x8f, 0x18, 0x9a, 0x4d, 0x24, 0x89, 0xf1, 0x04, 0x85, 0x18, 0xcd, 0x38,
+	0x23, 0x54, 0x5a, 0x55, 0xb5, 0x79, 0xb7, 0x64, 0x80, 0x97, 0xf5, 0x8c, 0x49, 0x8c, 0x07, 0x79,
+	0xc7, 0xf0, 0x9d, 0xff, 0xb4, 0x68, 0x8e, 0x40, 0x23, 0x26, 0x54, 0x16, 0xcd, 0x2f, 0x9f, 0x6d,
+	0x7e, 0x9d, 0x29, 0x0a, 0xd2, 0xbf, 0xa0, 0xae, 0x6e, 0x91, 0x60, 0xc4, 0x92, 0xa9, 0xb0, 0x6a,
+	0xea, 0x18, 0x9f, 0xca, 0x8c, 0x69, 0x42, 0x87, 0x8a, 0x2c, 0x1e, 0xc1, 0xf0, 0xf3, 0xb2, 0xf0,
+	0x7e, 0x2f, 0xd7, 0xb6, 0xbe, 0x5a, 0xdb, 0xfa, 0xed, 0xda, 0xd6, 0x2f, 0x36, 0xb6, 0xb6, 0xda,
+	0xd8, 0xda, 0xf5, 0xc6, 0xd6, 0x8e, 0x9c, 0x80, 0xc8, 0x30, 0xf5, 0x1d, 0xc4, 0x62, 0x77, 0x0c,
+	0x91, 0x97, 0x92, 0x68, 0xea, 0xe6, 0x11, 0x3b, 0x2b, 0x84, 0x4c, 0x2e, 0x38, 0x16, 0x7e, 0x4d,
+	0xc5, 0xeb, 0xc7, 0xfd, 0x00, 0x7a, 0xca, 0x4d, 0x76, 0xec, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BurnRecords) > 0 {
+		for iNdEx := len(m.BurnRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MintCheckpoint != nil {
+		{
+			size, err := m.MintCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockTimeCheckpoint != nil {
+		{
+			size, err := m.BlockTimeCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BurnTotals) > 0 {
+		for iNdEx := len(m.BurnTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.ProvisionTotals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ProvisionTotals.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BurnTotals) > 0 {
+		for _, e := range m.BurnTotals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BlockTimeCheckpoint != nil {
+		l = m.BlockTimeCheckpoint.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MintCheckpoint != nil {
+		l = m.MintCheckpoint.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BurnRecords) > 0 {
+		for _, e := range m.BurnRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProvisionTotals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnTotals = append(m.BurnTotals, ModuleSupplyChange{})
+			if err := m.BurnTotals[len(m.BurnTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockTimeCheckpoint == nil {
+				m.BlockTimeCheckpoint = &BlockTimeCheckpoint{}
+			}
+			if err := m.BlockTimeCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintCheckpoint == nil {
+				m.MintCheckpoint = &BlockTimeCheckpoint{}
+			}
+			if err := m.MintCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnRecords = append(m.BurnRecords, BurnRecord{})
+			if err := m.BurnRecords[len(m.BurnRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,2337 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tacchain/inflation/v1/inflation.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InflationCurve enumerates the inflation formulas the mint function can use.
+type InflationCurve int32
+
+const (
+	// INFLATION_CURVE_UNSPECIFIED is an invalid curve.
+	INFLATION_CURVE_UNSPECIFIED InflationCurve = 0
+	// INFLATION_CURVE_LINEAR peaks at goal_bonded and decreases linearly to
+	// inflation_min on both sides.
+	INFLATION_CURVE_LINEAR InflationCurve = 1
+	// INFLATION_CURVE_PARABOLIC is an inverted parabola peaking at goal_bonded
+	// with inflation_max.
+	INFLATION_CURVE_PARABOLIC InflationCurve = 2
+	// INFLATION_CURVE_PIECEWISE_LINEAR interpolates linearly between the
+	// breakpoints held in the module params.
+	INFLATION_CURVE_PIECEWISE_LINEAR InflationCurve = 3
+)
+
+var InflationCurve_name = map[int32]string{
+	0: "INFLATION_CURVE_UNSPECIFIED",
+	1: "INFLATION_CURVE_LINEAR",
+	2: "INFLATION_CURVE_PARABOLIC",
+	3: "INFLATION_CURVE_PIECEWISE_LINEAR",
+}
+
+var InflationCurve_value = map[string]int32{
+	"INFLATION_CURVE_UNSPECIFIED":      0,
+	"INFLATION_CURVE_LINEAR":           1,
+	"INFLATION_CURVE_PARABOLIC":        2,
+	"INFLATION_CURVE_PIECEWISE_LINEAR": 3,
+}
+
+func (x InflationCurve) String() string {
+	return proto.EnumName(InflationCurve_name, int32(x))
+}
+
+func (InflationCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6d943809b415fa8d, []int{0}
+}
+
+// MintingMode enumerates when provisions are minted.
+type MintingMode int32
+
+const (
+	// MINTING_MODE_UNSPECIFIED is an invalid mode.
+	MINTING_MODE_UNSPECIFIED MintingMode = 0
+	// MINTING_MODE_PER_BLOCK mints annual_provisions / blocks_per_year every
+	// block, so emission depends on the block time.
+	MINTING_MODE_PER_BLOCK MintingMode = 1
+	// MINTING_MODE_EPOCH mints the provisions for the wall-clock time elapsed
+	// since the previous release at the end of every minting epoch.
+	MINTING_MODE_EPOCH MintingMode = 2
+)
+
+var MintingMode_name = map[int32]string{
+	0: "MINTING_MODE_UNSPECIFIED",
+	1: "MINTING_MODE_PER_BLOCK",
+	2: "MINTING_MODE_EPOCH",
+}
+
+var MintingMode_value = map[string]int32{
+	"MINTING_MODE_UNSPECIFIED": 0,
+	"MINTING_MODE_PER_BLOCK":   1,
+	"MINTING_MODE_EPOCH":       2,
+}
+
+func (x MintingMode) String() string {
+	return proto.EnumName(MintingMode_name, int32(x))
+}
+
+func (MintingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6d943809b415fa8d, []int{1}
+}
+
+// Params defines the parameters for the x/inflation module.
+type Params struct {
+	// curve is the inflation formula used by the x/mint begin blocker.
+	Curve InflationCurve `protobuf:"varint,1,opt,name=curve,proto3,enum=tacchain.inflation.v1.InflationCurve" json:"curve,omitempty"`
+	// blocks_per_year_epoch_identifier is the x/epochs epoch at whose end the
+	// x/mint blocks_per_year is re-derived from the observed block time. An
+	// empty identifier disables the adjustment.
+	BlocksPerYearEpochIdentifier string `protobuf:"bytes,2,opt,name=blocks_per_year_epoch_identifier,json=blocksPerYearEpochIdentifier,proto3" json:"blocks_per_year_epoch_identifier,omitempty"`
+	// min_blocks_per_year is the lower bound for the re-derived blocks_per_year.
+	MinBlocksPerYear uint64 `protobuf:"varint,3,opt,name=min_blocks_per_year,json=minBlocksPerYear,proto3" json:"min_blocks_per_year,omitempty"`
+	// max_blocks_per_year is the upper bound for the re-derived blocks_per_year.
+	MaxBlocksPerYear uint64 `protobuf:"varint,4,opt,name=max_blocks_per_year,json=maxBlocksPerYear,proto3" json:"max_blocks_per_year,omitempty"`
+	// breakpoints define INFLATION_CURVE_PIECEWISE_LINEAR, ordered by strictly
+	// increasing bonded_ratio. Below the first and above the last breakpoint the
+	// curve is flat.
+	Breakpoints []Breakpoint `protobuf:"bytes,5,rep,name=breakpoints,proto3" json:"breakpoints"`
+	// max_supply caps the total supply of the x/mint denom. Block provisions are
+	// clamped so that minting never exceeds it. Zero disables the cap.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// treasury_fraction is the fraction of each block's provisions sent to the
+	// treasury module account instead of the fee collector.
+	TreasuryFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=treasury_fraction,json=treasuryFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"treasury_fraction"`
+	// community_pool_fraction is the fraction of each block's provisions sent
+	// to the community pool instead of the fee collector.
+	CommunityPoolFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=community_pool_fraction,json=communityPoolFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_fraction"`
+	// minting_mode selects between per-block and per-epoch minting.
+	MintingMode MintingMode `protobuf:"varint,9,opt,name=minting_mode,json=mintingMode,proto3,enum=tacchain.inflation.v1.MintingMode" json:"minting_mode,omitempty"`
+	// minting_epoch_identifier is the x/epochs epoch at whose end provisions are
+	// released in MINTING_MODE_EPOCH.
+	MintingEpochIdentifier string `protobuf:"bytes,10,opt,name=minting_epoch_identifier,json=mintingEpochIdentifier,proto3" json:"minting_epoch_identifier,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d943809b415fa8d, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetCurve() InflationCurve {
+	if m != nil {
+		return m.Curve
+	}
+	return INFLATION_CURVE_UNSPECIFIED
+}
+
+func (m *Params) GetBlocksPerYearEpochIdentifier() string {
+	if m != nil {
+		return m.BlocksPerYearEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetMinBlocksPerYear() uint64 {
+	if m != nil {
+		return m.MinBlocksPerYear
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlocksPerYear() uint64 {
+	if m != nil {
+		return m.MaxBlocksPerYear
+	}
+	return 0
+}
+
+func (m *Params) GetBreakpoints() []Breakpoint {
+	if m != nil {
+		return m.Breakpoints
+	}
+	return nil
+}
+
+func (m *Params) GetMintingMode() MintingMode {
+	if m != nil {
+		return m.MintingMode
+	}
+	return MINTING_MODE_UNSPECIFIED
+}
+
+func (m *Params) GetMintingEpochIdentifier() string {
+	if m != nil {
+		return m.MintingEpochIdentifier
+	}
+	return ""
+}
+
+// ProvisionTotals accumulates where minted provisions have been sent.
+type ProvisionTotals struct {
+	// fee_collector is the total sent to the fee collector for distribution.
+	FeeCollector github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee_collector,json=feeCollector,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_collector"`
+	// treasury is the total sent to the treasury module account.
+	Treasury github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=treasury,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"treasury"`
+	// community_pool is the total sent to the community pool.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool"`
+}
+
+func (m *ProvisionTotals) Reset()         { *m = ProvisionTotals{} }
+func (m *ProvisionTotals) String() string { return proto.CompactTextString(m) }
+func (*ProvisionTotals) ProtoMessage()    {}
+func (*ProvisionTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d943809b415fa8d, []int{1}
+}
+func (m *ProvisionTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvisionTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvisionTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProvisionTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvisionTotals.Merge(m, src)
+}
+func (m *ProvisionTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvisionTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvisionTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvisionTotals proto.InternalMessageInfo
+
+func (m *ProvisionTotals) GetFeeCollector() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeCollector
+	}
+	return nil
+}
+
+func (m *ProvisionTotals) GetTreasury() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Treasury
+	}
+	return nil
+}
+
+func (m *ProvisionTotals) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+// Breakpoint is a point of the piecewise-linear inflation curve.
+type Breakpoint struct {
+	// bonded_ratio is the bonded ratio, in [0, 1], the point is placed at.
+	BondedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonded_ratio"`
+	// inflation is the annual inflation rate, in [0, 1], at bonded_ratio.
+	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+}
+
+func (m *Breakpoint) Reset()         { *m = Breakpoint{} }
+func (m *Breakpoint) String() string { return proto.CompactTextString(m) }
+func (*Breakpoint) ProtoMessage()    {}
+func (*Breakpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d943809b415fa8d, []int{2}
+}
+func (m *Breakpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Breakpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Breakpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Breakpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Breakpoint.Merge(m, src)
+}
+func (m *Breakpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *Breakpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Breakpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Breakpoint proto.InternalMessageInfo
+
+// BlockTimeCheckpoint is a block observed at the end of an epoch. It marks
+// the start of the interval measured at the next epoch end.
+type BlockTimeCheckpoint struct {
+	Height int64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *BlockTimeCheckpoint) Reset()         { *m = BlockTimeCheckpoint{} }
+func (m *BlockTimeCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*BlockTimeCheckpoint) ProtoMessage()    {}
+func (*BlockTimeCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d943809b415fa8d, []int{3}
+}
+func (m *BlockTimeCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockTimeCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockTimeCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockTimeCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTimeCheckpoint.Merge(m, src)
+}
+func (m *BlockTimeCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockTimeCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTimeCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTimeCheckpoint proto.InternalMessageInfo
+
+func (m *BlockTimeCheckpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockTimeCheckpoint) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// SupplyChange accumulates the coins a module account burned and the coins
+// minted back into it. Within a tx only the net change of each denom is
+// recorded, so that modules such as x/vm, which settle every balance change
+// by burning and re-minting, don't count transfers.
+type SupplyChange struct {
+	// burned is the total burned from the module account.
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// minted is the total minted into the module account. Only the burned
+	// amount in excess of it leaves the supply.
+	Minted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=minted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted"`
+}
+
+func (m *SupplyChange) Reset()         { *m = SupplyChange{} }
+func (m *SupplyChange) String() string { return proto.CompactTextString(m) }
+func (*SupplyChange) ProtoMessage()    {}
+func (*SupplyChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d943809b415fa8d, []int{4}
+}
+func (m *SupplyChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyChange.Merge(m, src)
+}
+func (m *SupplyChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyChange proto.InternalMessageInfo
+
+func (m *SupplyChange) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *SupplyChange) GetMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+// ModuleSupplyChange is the supply change of a single module account.
+type ModuleSupplyChange struct {
+	// module is the name of the module account burned from.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// change is the supply change attributed to the module account.
+	Change SupplyChange `protobuf:"bytes,2,opt,name=change,proto3" json:"change"`
+}
+
+func (m *ModuleSupplyChange) Reset()         { *m = ModuleSupplyChange{} }
+func (m *ModuleSupplyChange) String() string { return proto.CompactTextString(m) }
+func (*ModuleSupplyChange) ProtoMessage()    {}
+func (*ModuleSupplyChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d943809b415fa8d, []int{5}
+}
+func (m *ModuleSupplyChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleSupplyChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleSupplyChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleSupplyChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleSupplyChange.Merge(m, src)
+}
+func (m *ModuleSupplyChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleSupplyChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleSupplyChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleSupplyChange proto.InternalMessageInfo
+
+func (m *ModuleSupplyChange) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleSupplyChange) GetChange() SupplyChange {
+	if m != nil {
+		return m.Change
+	}
+	return SupplyChange{}
+}
+
+// BurnRecord is the supply change of a single module account at a height.
+type BurnRecord struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// module is the name of the module account burned from.
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// change is the supply change attributed to the module account.
+	Change SupplyChange `protobuf:"bytes,3,opt,name=change,proto3" json:"change"`
+}
+
+func (m *BurnRecord) Reset()         { *m = BurnRecord{} }
+func (m *BurnRecord) String() string { return proto.CompactTextString(m) }
+func (*BurnRecord) ProtoMessage()    {}
+func (*BurnRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d943809b415fa8d, []int{6}
+}
+func (m *BurnRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnRecord.Merge(m, src)
+}
+func (m *BurnRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnRecord proto.InternalMessageInfo
+
+func (m *BurnRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BurnRecord) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *BurnRecord) GetChange() SupplyChange {
+	if m != nil {
+		return m.Change
+	}
+	return SupplyChange{}
+}
+
+func init() {
+	proto.RegisterEnum("tacchain.inflation.v1.InflationCurve", InflationCurve_name, InflationCurve_value)
+	proto.RegisterEnum("tacchain.inflation.v1.MintingMode", MintingMode_name, MintingMode_value)
+	proto.RegisterType((*Params)(nil), "tacchain.inflation.v1.Params")
+	proto.RegisterType((*ProvisionTotals)(nil), "tacchain.inflation.v1.ProvisionTotals")
+	proto.RegisterType((*Breakpoint)(nil), "tacchain.inflation.v1.Breakpoint")
+	proto.RegisterType((*BlockTimeCheckpoint)(nil), "tacchain.inflation.v1.BlockTimeCheckpoint")
+	proto.RegisterType((*SupplyChange)(nil), "tacchain.inflation.v1.SupplyChange")
+	proto.RegisterType((*ModuleSupplyChange)(nil), "tacchain.inflation.v1.ModuleSupplyChange")
+	proto.RegisterType((*BurnRecord)(nil), "tacchain.inflation.v1.BurnRecord")
+}
+
+func init() {
+	proto.RegisterFile("tacchain/inflation/v1/inflation.proto", fileDescriptor_6d943809b415fa8d)
+}
+
+var fileDescriptor_6d943809b415fa8d = []byte{
+	// 1024 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x25, 0x47, 0x8d, 0x4f, 0x8e, 0xab, 0x5c, 0x6a, 0x97, 0x96, 0x53, 0x49, 0x55, 0x1b,
+	0xc0, 0x30, 0x60, 0xb2, 0x76, 0x81, 0x22, 0x68, 0x27, 0x8b, 0xa6, 0x1a, 0xa2, 0xb6, 0x24, 0xd0,
+	0x4a, 0x8b, 0x74, 0x21, 0x4e, 0xc7, 0x13, 0x75, 0x30, 0x79, 0x27, 0x90, 0x47, 0xc3, 0x1a, 0xba,
+	0x19, 0x45, 0xd0, 0x29, 0x63, 0xd1, 0xb5, 0x1d, 0x8a, 0x4e, 0x1e, 0xba, 0x76, 0xcf, 0x18, 0x74,
+	0x2a, 0x3a, 0x24, 0x85, 0x3d, 0xf8, 0xdf, 0x28, 0xf8, 0x43, 0xbf, 0x6c, 0x6b, 0x32, 0xe0, 0xc5,
+	0xd6, 0xe3, 0xfb, 0xee, 0xfb, 0xde, 0xdd, 0x7d, 0xef, 0x1d, 0x78, 0x22, 0x10, 0xc6, 0x7d, 0x44,
+	0x99, 0x4a, 0x59, 0xcf, 0x45, 0x82, 0x72, 0xa6, 0x1e, 0x6f, 0x4f, 0x02, 0x65, 0xe0, 0x73, 0xc1,
+	0xe1, 0xca, 0x08, 0xa6, 0x4c, 0x32, 0xc7, 0xdb, 0xa5, 0x0f, 0x1c, 0xee, 0xf0, 0x18, 0xa1, 0x46,
+	0xbf, 0x12, 0x70, 0xe9, 0x21, 0xf2, 0x28, 0xe3, 0x6a, 0xfc, 0x37, 0xfd, 0xb4, 0x86, 0x79, 0xe0,
+	0xf1, 0xc0, 0x4a, 0xb0, 0x49, 0x90, 0xa6, 0xca, 0x49, 0xa4, 0x76, 0x51, 0x40, 0xd4, 0xe3, 0xed,
+	0x2e, 0x11, 0x68, 0x5b, 0xc5, 0x9c, 0xa6, 0xd2, 0xa5, 0x8a, 0xc3, 0xb9, 0xe3, 0x12, 0x35, 0x8e,
+	0xba, 0x61, 0x4f, 0x15, 0xd4, 0x23, 0x81, 0x40, 0xde, 0x20, 0x01, 0xd4, 0x4e, 0xf3, 0x20, 0xdf,
+	0x46, 0x3e, 0xf2, 0x02, 0xf8, 0x15, 0xb8, 0x87, 0x43, 0xff, 0x98, 0xc8, 0x52, 0x55, 0xda, 0x58,
+	0xde, 0x79, 0xa2, 0xdc, 0x58, 0xb6, 0x62, 0x8c, 0x02, 0x2d, 0x02, 0x9b, 0xc9, 0x1a, 0xd8, 0x00,
+	0xd5, 0xae, 0xcb, 0xf1, 0x51, 0x60, 0x0d, 0x88, 0x6f, 0x0d, 0x09, 0xf2, 0x2d, 0x32, 0xe0, 0xb8,
+	0x6f, 0x51, 0x9b, 0x30, 0x41, 0x7b, 0x94, 0xf8, 0x72, 0xb6, 0x2a, 0x6d, 0x2c, 0x9a, 0x8f, 0x13,
+	0x5c, 0x9b, 0xf8, 0x2f, 0x08, 0xf2, 0xf5, 0x08, 0x64, 0x8c, 0x31, 0x70, 0x0b, 0x3c, 0xf2, 0x28,
+	0xb3, 0xae, 0x70, 0xc9, 0xb9, 0xaa, 0xb4, 0xb1, 0x60, 0x16, 0x3d, 0xca, 0xea, 0xd3, 0xab, 0x63,
+	0x38, 0x3a, 0xb9, 0x06, 0x5f, 0x48, 0xe1, 0xe8, 0x64, 0x16, 0xde, 0x04, 0x85, 0xae, 0x4f, 0xd0,
+	0xd1, 0x80, 0x53, 0x26, 0x02, 0xf9, 0x5e, 0x35, 0xb7, 0x51, 0xd8, 0xf9, 0x78, 0xce, 0x46, 0xeb,
+	0x63, 0x64, 0x7d, 0xf1, 0xf5, 0xdb, 0x4a, 0xe6, 0xf7, 0xcb, 0xb3, 0x4d, 0xc9, 0x9c, 0x26, 0x80,
+	0x2d, 0x00, 0x22, 0xf9, 0x20, 0x1c, 0x0c, 0xdc, 0xa1, 0x9c, 0x8f, 0xf6, 0x57, 0xff, 0x2c, 0xc2,
+	0xfe, 0xfb, 0xb6, 0xb2, 0x92, 0x5c, 0x4d, 0x60, 0x1f, 0x29, 0x94, 0xab, 0x1e, 0x12, 0x7d, 0xc5,
+	0x60, 0xe2, 0xef, 0x3f, 0xb7, 0x40, 0x7a, 0x83, 0x06, 0x13, 0x09, 0xe5, 0xa2, 0x87, 0x4e, 0x0e,
+	0x63, 0x0a, 0x88, 0xc1, 0x43, 0xe1, 0x13, 0x14, 0x84, 0xfe, 0xd0, 0xea, 0xf9, 0x08, 0x47, 0xb5,
+	0xc8, 0xef, 0xc5, 0xbc, 0x5f, 0xa4, 0xbc, 0xeb, 0xd7, 0x79, 0xf7, 0x89, 0x83, 0xf0, 0x70, 0x8f,
+	0xe0, 0x29, 0xf6, 0x3d, 0x82, 0x13, 0xf6, 0xe2, 0x88, 0xb0, 0x91, 0xf2, 0x41, 0x06, 0x3e, 0xc4,
+	0xdc, 0xf3, 0x42, 0x46, 0xc5, 0xd0, 0x1a, 0x70, 0xee, 0x4e, 0xa4, 0xee, 0xdf, 0x4a, 0x6a, 0x65,
+	0x4c, 0xdb, 0xe6, 0xdc, 0x1d, 0xeb, 0xe9, 0x60, 0xc9, 0xa3, 0x4c, 0x50, 0xe6, 0x58, 0x1e, 0xb7,
+	0x89, 0xbc, 0x18, 0xfb, 0xab, 0x36, 0xe7, 0xd8, 0x0f, 0x12, 0xe8, 0x01, 0xb7, 0x89, 0x59, 0xf0,
+	0x26, 0x01, 0x7c, 0x0a, 0xe4, 0x11, 0xcd, 0x35, 0x6b, 0x81, 0xd8, 0x5a, 0xab, 0x69, 0xfe, 0x8a,
+	0xa9, 0xbe, 0xac, 0xfe, 0x74, 0x79, 0xb6, 0xb9, 0x3e, 0x6e, 0xd6, 0x93, 0xa9, 0x76, 0x4d, 0xbc,
+	0x5f, 0xfb, 0x2d, 0x07, 0xde, 0x6f, 0xfb, 0xfc, 0x98, 0x06, 0x94, 0xb3, 0x0e, 0x17, 0xc8, 0x0d,
+	0xe0, 0x8f, 0x12, 0x78, 0xd0, 0x23, 0xc4, 0xc2, 0xdc, 0x75, 0x09, 0x16, 0xdc, 0x97, 0xa5, 0xd8,
+	0x2f, 0x6b, 0x4a, 0xba, 0xef, 0xa8, 0xe9, 0x94, 0xb4, 0xe9, 0x14, 0x8d, 0x53, 0x56, 0x6f, 0x44,
+	0x07, 0xf7, 0xc7, 0xbb, 0xca, 0x86, 0x43, 0x45, 0x3f, 0xec, 0x2a, 0x98, 0x7b, 0x69, 0xbf, 0xa6,
+	0xff, 0xb6, 0x02, 0xfb, 0x48, 0x15, 0xc3, 0x01, 0x09, 0xe2, 0x05, 0xc1, 0x2f, 0x97, 0x67, 0x9b,
+	0x4b, 0x6e, 0x7c, 0xa6, 0x56, 0xd4, 0xb6, 0x41, 0x72, 0x90, 0x4b, 0x3d, 0x42, 0xb4, 0x91, 0x2c,
+	0xfc, 0x01, 0xdc, 0x1f, 0xdd, 0xa1, 0x9c, 0xbd, 0xab, 0x12, 0xc6, 0x92, 0xf0, 0xa5, 0x04, 0x96,
+	0x67, 0xfd, 0x22, 0xe7, 0xee, 0xaa, 0x8a, 0x07, 0x33, 0x8e, 0xaa, 0xfd, 0x25, 0x01, 0x30, 0x69,
+	0x4b, 0xf8, 0x02, 0x2c, 0x75, 0x39, 0xb3, 0x89, 0x6d, 0xf9, 0xd1, 0x6d, 0xca, 0xd2, 0xad, 0xdc,
+	0x5b, 0x48, 0xb8, 0xcc, 0x88, 0x0a, 0x76, 0xc0, 0xe2, 0xd8, 0x24, 0x72, 0xf6, 0x56, 0xbc, 0x13,
+	0xa2, 0x9a, 0x03, 0x1e, 0xc5, 0x03, 0xa9, 0x43, 0x3d, 0xa2, 0xf5, 0x09, 0x4e, 0xf7, 0xb1, 0x0a,
+	0xf2, 0x7d, 0x42, 0x9d, 0xbe, 0x88, 0x77, 0x90, 0x33, 0xd3, 0x08, 0x3e, 0x05, 0x0b, 0xd1, 0xbc,
+	0x8e, 0xf5, 0x0b, 0x3b, 0x25, 0x25, 0x19, 0xe6, 0xca, 0x68, 0x98, 0x2b, 0x9d, 0xd1, 0x30, 0xaf,
+	0xdf, 0x8f, 0x6a, 0x7b, 0xf5, 0xae, 0x22, 0x99, 0xf1, 0x8a, 0xda, 0x69, 0x16, 0x2c, 0x25, 0x23,
+	0x45, 0xeb, 0x23, 0xe6, 0x10, 0x38, 0x04, 0xf9, 0x6e, 0xe8, 0x33, 0x62, 0xdf, 0x9d, 0x89, 0x53,
+	0xc1, 0x48, 0x3a, 0xea, 0x4b, 0x62, 0xdf, 0x9d, 0x79, 0x53, 0xc1, 0x9a, 0x00, 0xf0, 0x80, 0xdb,
+	0xa1, 0x4b, 0x66, 0xce, 0x62, 0x15, 0xe4, 0xbd, 0xf8, 0x6b, 0x62, 0x18, 0x33, 0x8d, 0x60, 0x03,
+	0xe4, 0x71, 0x8c, 0x48, 0x0f, 0xfc, 0x93, 0x39, 0x13, 0x6a, 0x9a, 0x6c, 0xfa, 0x69, 0x48, 0x57,
+	0xd7, 0x4e, 0x23, 0x97, 0x86, 0x3e, 0x33, 0x09, 0xe6, 0xbe, 0x3d, 0xf7, 0x76, 0x27, 0x65, 0x64,
+	0xe7, 0x94, 0x91, 0xbb, 0x4d, 0x19, 0x9b, 0x3f, 0x4b, 0x60, 0x79, 0xf6, 0xb1, 0x86, 0x15, 0xb0,
+	0x6e, 0x34, 0x1b, 0xfb, 0xbb, 0x1d, 0xa3, 0xd5, 0xb4, 0xb4, 0xe7, 0xe6, 0xb7, 0xba, 0xf5, 0xbc,
+	0x79, 0xd8, 0xd6, 0x35, 0xa3, 0x61, 0xe8, 0x7b, 0xc5, 0x0c, 0x2c, 0x81, 0xd5, 0xab, 0x80, 0x7d,
+	0xa3, 0xa9, 0xef, 0x9a, 0x45, 0x09, 0x7e, 0x04, 0xd6, 0xae, 0xe6, 0xda, 0xbb, 0xe6, 0x6e, 0xbd,
+	0xb5, 0x6f, 0x68, 0xc5, 0x2c, 0xfc, 0x14, 0x54, 0xaf, 0xa5, 0x0d, 0x5d, 0xd3, 0xbf, 0x33, 0x0e,
+	0xc7, 0x24, 0xb9, 0xd2, 0xc2, 0xcb, 0x5f, 0xcb, 0x99, 0x4d, 0x02, 0x0a, 0x53, 0x63, 0x1e, 0x3e,
+	0x06, 0xf2, 0x81, 0xd1, 0xec, 0x18, 0xcd, 0xaf, 0xad, 0x83, 0xd6, 0xde, 0x0d, 0x35, 0xcd, 0x64,
+	0xdb, 0xba, 0x69, 0xd5, 0xf7, 0x5b, 0xda, 0x37, 0x45, 0x09, 0xae, 0x02, 0x38, 0x93, 0xd3, 0xdb,
+	0x2d, 0xed, 0x59, 0x31, 0x9b, 0xc8, 0xd4, 0x9f, 0xbd, 0x3e, 0x2f, 0x4b, 0x6f, 0xce, 0xcb, 0xd2,
+	0x7f, 0xe7, 0x65, 0xe9, 0xd5, 0x45, 0x39, 0xf3, 0xe6, 0xa2, 0x9c, 0xf9, 0xe7, 0xa2, 0x9c, 0xf9,
+	0x5e, 0x99, 0x32, 0x58, 0x07, 0xe1, 0x7a, 0x48, 0x5d, 0x5b, 0xbd, 0xf1, 0x81, 0x88, 0xcd, 0xd6,
+	0xcd, 0xc7, 0x3d, 0xf7, 0xf9, 0xff, 0x03, 0x00, 0x6a, 0x43, 0x10, 0xd0, 0xf2, 0x09, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MintingEpochIdentifier) > 0 {
+		i -= len(m.MintingEpochIdentifier)
+		copy(dAtA[i:], m.MintingEpochIdentifier)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.MintingEpochIdentifier)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MintingMode != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.MintingMode))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.CommunityPoolFraction.Size()
+		i -= size
+		if _, err := m.CommunityPoolFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TreasuryFraction.Size()
+		i -= size
+		if _, err := m.TreasuryFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Breakpoints) > 0 {
+		for iNdEx := len(m.Breakpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Breakpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxBlocksPerYear != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.MaxBlocksPerYear))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinBlocksPerYear != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.MinBlocksPerYear))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlocksPerYearEpochIdentifier) > 0 {
+		i -= len(m.BlocksPerYearEpochIdentifier)
+		copy(dAtA[i:], m.BlocksPerYearEpochIdentifier)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.BlocksPerYearEpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Curve != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProvisionTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvisionTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvisionTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Treasury) > 0 {
+		for iNdEx := len(m.Treasury) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Treasury[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeeCollector) > 0 {
+		for iNdEx := len(m.FeeCollector) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCollector[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Breakpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Breakpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Breakpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTimeCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTimeCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTimeCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintInflation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleSupplyChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleSupplyChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleSupplyChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInflation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Curve != 0 {
+		n += 1 + sovInflation(uint64(m.Curve))
+	}
+	l = len(m.BlocksPerYearEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	if m.MinBlocksPerYear != 0 {
+		n += 1 + sovInflation(uint64(m.MinBlocksPerYear))
+	}
+	if m.MaxBlocksPerYear != 0 {
+		n += 1 + sovInflation(uint64(m.MaxBlocksPerYear))
+	}
+	if len(m.Breakpoints) > 0 {
+		for _, e := range m.Breakpoints {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.TreasuryFraction.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.CommunityPoolFraction.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	if m.MintingMode != 0 {
+		n += 1 + sovInflation(uint64(m.MintingMode))
+	}
+	l = len(m.MintingEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	return n
+}
+
+func (m *ProvisionTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeCollector) > 0 {
+		for _, e := range m.FeeCollector {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	if len(m.Treasury) > 0 {
+		for _, e := range m.Treasury {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Breakpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *BlockTimeCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovInflation(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *SupplyChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleSupplyChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Change.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *BurnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovInflation(uint64(m.Height))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Change.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func sovInflation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInflation(x uint64) (n int) {
+	return sovInflation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= InflationCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYearEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlocksPerYearEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlocksPerYear", wireType)
+			}
+			m.MinBlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlocksPerYear", wireType)
+			}
+			m.MaxBlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Breakpoints = append(m.Breakpoints, Breakpoint{})
+			if err := m.Breakpoints[len(m.Breakpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingMode", wireType)
+			}
+			m.MintingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintingMode |= MintingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintingEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProvisionTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProvisionTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProvisionTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollector = append(m.FeeCollector, types.Coin{})
+			if err := m.FeeCollector[len(m.FeeCollector)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = append(m.Treasury, types.Coin{})
+			if err := m.Treasury[len(m.Treasury)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Breakpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Breakpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Breakpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockTimeCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockTimeCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockTimeCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = append(m.Minted, types.Coin{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleSupplyChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleSupplyChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleSupplyChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInflation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInflation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInflation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInflation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInflation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInflation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInflation = fmt.Errorf("proto: unexpected end of group")
+)
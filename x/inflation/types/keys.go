@@ -0,0 +1,42 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "inflation"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// TransientStoreKey defines the transient store key
+	TransientStoreKey = "transient_" + ModuleName
+
+	// TreasuryName is the name of the module account holding the treasury's
+	// share of minted provisions.
+	TreasuryName = "treasury"
+)
+
+var (
+	// ParamsKey is the prefix under which the module params are stored.
+	ParamsKey = collections.NewPrefix(0)
+	// BlockTimeCheckpointKey is the prefix under which the last
+	// blocks_per_year epoch checkpoint is stored.
+	BlockTimeCheckpointKey = collections.NewPrefix(1)
+	// ProvisionTotalsKey is the prefix under which the provisions split off
+	// so far are stored.
+	ProvisionTotalsKey = collections.NewPrefix(2)
+	// MintCheckpointKey is the prefix under which the last epoch provision
+	// release is stored.
+	MintCheckpointKey = collections.NewPrefix(3)
+	// BurnTotalsKey is the prefix under which the cumulative supply change of
+	// each tracked module account is stored.
+	BurnTotalsKey = collections.NewPrefix(4)
+	// BurnRecordsKey is the prefix under which the supply changes of each
+	// tracked module account are stored by height.
+	BurnRecordsKey = collections.NewPrefix(5)
+)
+
+// PendingSupplyChangesKey is the transient store prefix under which the
+// supply changes of the current tx are accumulated per module account.
+var PendingSupplyChangesKey = collections.NewPrefix(0)
@@ -0,0 +1,151 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+)
+
+// Default blocks_per_year adjustment parameters. The bounds correspond to 3s
+// and 1s blocks respectively.
+const (
+	DefaultBlocksPerYearEpochIdentifier        = "day"
+	DefaultMinBlocksPerYear             uint64 = 10_512_000
+	DefaultMaxBlocksPerYear             uint64 = 31_536_000
+
+	DefaultMintingEpochIdentifier = "day"
+)
+
+// NewParams creates a new Params instance.
+func NewParams(
+	curve InflationCurve,
+	blocksPerYearEpochIdentifier string,
+	minBlocksPerYear uint64,
+	maxBlocksPerYear uint64,
+	breakpoints []Breakpoint,
+	maxSupply math.Int,
+	treasuryFraction math.LegacyDec,
+	communityPoolFraction math.LegacyDec,
+	mintingMode MintingMode,
+	mintingEpochIdentifier string,
+) Params {
+	return Params{
+		Curve:                        curve,
+		BlocksPerYearEpochIdentifier: blocksPerYearEpochIdentifier,
+		MinBlocksPerYear:             minBlocksPerYear,
+		MaxBlocksPerYear:             maxBlocksPerYear,
+		Breakpoints:                  breakpoints,
+		MaxSupply:                    maxSupply,
+		TreasuryFraction:             treasuryFraction,
+		CommunityPoolFraction:        communityPoolFraction,
+		MintingMode:                  mintingMode,
+		MintingEpochIdentifier:       mintingEpochIdentifier,
+	}
+}
+
+// DefaultParams returns default x/inflation module parameters. The linear
+// curve is the one TacChain has minted with since genesis.
+func DefaultParams() Params {
+	return NewParams(
+		INFLATION_CURVE_LINEAR,
+		DefaultBlocksPerYearEpochIdentifier,
+		DefaultMinBlocksPerYear,
+		DefaultMaxBlocksPerYear,
+		nil,
+		math.ZeroInt(),
+		math.LegacyZeroDec(),
+		math.LegacyZeroDec(),
+		MINTING_MODE_PER_BLOCK,
+		DefaultMintingEpochIdentifier,
+	)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateCurve(p.Curve); err != nil {
+		return err
+	}
+	if err := ValidateBreakpoints(p.Breakpoints); err != nil {
+		return err
+	}
+	if p.Curve == INFLATION_CURVE_PIECEWISE_LINEAR && len(p.Breakpoints) < MinBreakpoints {
+		return fmt.Errorf("piecewise linear curve needs at least %d breakpoints, got %d", MinBreakpoints, len(p.Breakpoints))
+	}
+	if err := validateBlocksPerYearBounds(p.BlocksPerYearEpochIdentifier, p.MinBlocksPerYear, p.MaxBlocksPerYear); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+	if err := validateProvisionSplit(p.TreasuryFraction, p.CommunityPoolFraction); err != nil {
+		return err
+	}
+	return validateMintingMode(p.MintingMode, p.MintingEpochIdentifier)
+}
+
+func validateCurve(curve InflationCurve) error {
+	if _, ok := InflationCurve_name[int32(curve)]; !ok {
+		return fmt.Errorf("unknown inflation curve: %d", curve)
+	}
+	if curve == INFLATION_CURVE_UNSPECIFIED {
+		return fmt.Errorf("inflation curve must be specified")
+	}
+	return nil
+}
+
+func validateBlocksPerYearBounds(epochIdentifier string, minBlocks, maxBlocks uint64) error {
+	if epochIdentifier == "" {
+		return nil
+	}
+	if strings.TrimSpace(epochIdentifier) != epochIdentifier {
+		return fmt.Errorf("blocks per year epoch identifier has leading or trailing whitespace: %q", epochIdentifier)
+	}
+	if minBlocks == 0 {
+		return fmt.Errorf("min blocks per year must be positive")
+	}
+	if maxBlocks < minBlocks {
+		return fmt.Errorf("max blocks per year %d must not be lower than min blocks per year %d", maxBlocks, minBlocks)
+	}
+	return nil
+}
+
+func validateMintingMode(mode MintingMode, epochIdentifier string) error {
+	if _, ok := MintingMode_name[int32(mode)]; !ok {
+		return fmt.Errorf("unknown minting mode: %d", mode)
+	}
+	if mode == MINTING_MODE_UNSPECIFIED {
+		return fmt.Errorf("minting mode must be specified")
+	}
+	if strings.TrimSpace(epochIdentifier) != epochIdentifier {
+		return fmt.Errorf("minting epoch identifier has leading or trailing whitespace: %q", epochIdentifier)
+	}
+	if mode == MINTING_MODE_EPOCH && epochIdentifier == "" {
+		return fmt.Errorf("minting epoch identifier must be set in epoch minting mode")
+	}
+	return nil
+}
+
+func validateProvisionSplit(treasuryFraction, communityPoolFraction math.LegacyDec) error {
+	if err := validateUnitDec("treasury fraction", treasuryFraction); err != nil {
+		return err
+	}
+	if err := validateUnitDec("community pool fraction", communityPoolFraction); err != nil {
+		return err
+	}
+	if treasuryFraction.Add(communityPoolFraction).GT(math.LegacyOneDec()) {
+		return fmt.Errorf("treasury fraction %s and community pool fraction %s must not sum to more than one",
+			treasuryFraction, communityPoolFraction)
+	}
+	return nil
+}
+
+func validateMaxSupply(maxSupply math.Int) error {
+	if maxSupply.IsNil() {
+		return fmt.Errorf("max supply cannot be nil")
+	}
+	if maxSupply.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", maxSupply)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Limits of an inflation projection, keeping the query cheap to serve.
+const (
+	MaxProjectionYears          = 100
+	MaxProjectionPeriodsPerYear = 365
+	MaxProjectionPeriods        = 1200
+)
+
+// ValidateProjectionRequest checks the bounds of a projection request.
+func ValidateProjectionRequest(req *QueryProjectionRequest) error {
+	if req.Years == 0 || req.Years > MaxProjectionYears {
+		return fmt.Errorf("years must be in [1, %d], got %d", MaxProjectionYears, req.Years)
+	}
+	if req.PeriodsPerYear > MaxProjectionPeriodsPerYear {
+		return fmt.Errorf("periods per year must not exceed %d, got %d", MaxProjectionPeriodsPerYear, req.PeriodsPerYear)
+	}
+	if periods := req.Years * max(req.PeriodsPerYear, 1); periods > MaxProjectionPeriods {
+		return fmt.Errorf("projection of %d periods exceeds the limit of %d", periods, MaxProjectionPeriods)
+	}
+	if req.BlockTime < 0 {
+		return fmt.Errorf("block time cannot be negative: %s", req.BlockTime)
+	}
+	for i, bondedRatio := range req.BondedRatios {
+		if bondedRatio.IsNil() || bondedRatio.IsNegative() || bondedRatio.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("bonded ratio %d must be in [0, 1], got %s", i, bondedRatio)
+		}
+	}
+	return nil
+}
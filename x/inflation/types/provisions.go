@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks that every total is a valid set of coins.
+func (t ProvisionTotals) Validate() error {
+	if err := t.FeeCollector.Validate(); err != nil {
+		return fmt.Errorf("invalid fee collector total: %w", err)
+	}
+	if err := t.Treasury.Validate(); err != nil {
+		return fmt.Errorf("invalid treasury total: %w", err)
+	}
+	if err := t.CommunityPool.Validate(); err != nil {
+		return fmt.Errorf("invalid community pool total: %w", err)
+	}
+	return nil
+}
+
+// Add returns the totals increased by one block's split.
+func (t ProvisionTotals) Add(feeCollector, treasury, communityPool sdk.Coins) ProvisionTotals {
+	return ProvisionTotals{
+		FeeCollector:  t.FeeCollector.Add(feeCollector...),
+		Treasury:      t.Treasury.Add(treasury...),
+		CommunityPool: t.CommunityPool.Add(communityPool...),
+	}
+}
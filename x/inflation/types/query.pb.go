@@ -0,0 +1,4631 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tacchain/inflation/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryCurveRequest is the request type for the Query/Curve RPC method.
+type QueryCurveRequest struct {
+}
+
+func (m *QueryCurveRequest) Reset()         { *m = QueryCurveRequest{} }
+func (m *QueryCurveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurveRequest) ProtoMessage()    {}
+func (*QueryCurveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{2}
+}
+func (m *QueryCurveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurveRequest.Merge(m, src)
+}
+func (m *QueryCurveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurveRequest proto.InternalMessageInfo
+
+// QueryCurveResponse is the response type for the Query/Curve RPC method.
+type QueryCurveResponse struct {
+	// curve is the active inflation curve.
+	Curve InflationCurve `protobuf:"varint,1,opt,name=curve,proto3,enum=tacchain.inflation.v1.InflationCurve" json:"curve,omitempty"`
+	// inflation_min is the x/mint minimum inflation rate.
+	InflationMin cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation_min,json=inflationMin,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_min"`
+	// inflation_max is the x/mint maximum inflation rate.
+	InflationMax cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=inflation_max,json=inflationMax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_max"`
+	// goal_bonded is the x/mint target bonded ratio.
+	GoalBonded cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=goal_bonded,json=goalBonded,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"goal_bonded"`
+}
+
+func (m *QueryCurveResponse) Reset()         { *m = QueryCurveResponse{} }
+func (m *QueryCurveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurveResponse) ProtoMessage()    {}
+func (*QueryCurveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{3}
+}
+func (m *QueryCurveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurveResponse.Merge(m, src)
+}
+func (m *QueryCurveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurveResponse proto.InternalMessageInfo
+
+func (m *QueryCurveResponse) GetCurve() InflationCurve {
+	if m != nil {
+		return m.Curve
+	}
+	return INFLATION_CURVE_UNSPECIFIED
+}
+
+// QueryEvaluateCurveRequest is the request type for the Query/EvaluateCurve RPC
+// method.
+type QueryEvaluateCurveRequest struct {
+	// bonded_ratio is the bonded ratio, in [0, 1], to evaluate the curve at.
+	BondedRatio string `protobuf:"bytes,1,opt,name=bonded_ratio,json=bondedRatio,proto3" json:"bonded_ratio,omitempty"`
+	// curve is the curve to evaluate. The active curve is used if unspecified.
+	Curve InflationCurve `protobuf:"varint,2,opt,name=curve,proto3,enum=tacchain.inflation.v1.InflationCurve" json:"curve,omitempty"`
+}
+
+func (m *QueryEvaluateCurveRequest) Reset()         { *m = QueryEvaluateCurveRequest{} }
+func (m *QueryEvaluateCurveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvaluateCurveRequest) ProtoMessage()    {}
+func (*QueryEvaluateCurveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{4}
+}
+func (m *QueryEvaluateCurveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvaluateCurveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvaluateCurveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvaluateCurveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvaluateCurveRequest.Merge(m, src)
+}
+func (m *QueryEvaluateCurveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvaluateCurveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvaluateCurveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvaluateCurveRequest proto.InternalMessageInfo
+
+func (m *QueryEvaluateCurveRequest) GetBondedRatio() string {
+	if m != nil {
+		return m.BondedRatio
+	}
+	return ""
+}
+
+func (m *QueryEvaluateCurveRequest) GetCurve() InflationCurve {
+	if m != nil {
+		return m.Curve
+	}
+	return INFLATION_CURVE_UNSPECIFIED
+}
+
+// QueryEvaluateCurveResponse is the response type for the Query/EvaluateCurve
+// RPC method.
+type QueryEvaluateCurveResponse struct {
+	// curve is the evaluated curve.
+	Curve InflationCurve `protobuf:"varint,1,opt,name=curve,proto3,enum=tacchain.inflation.v1.InflationCurve" json:"curve,omitempty"`
+	// inflation is the annual inflation rate at the requested bonded ratio.
+	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+}
+
+func (m *QueryEvaluateCurveResponse) Reset()         { *m = QueryEvaluateCurveResponse{} }
+func (m *QueryEvaluateCurveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvaluateCurveResponse) ProtoMessage()    {}
+func (*QueryEvaluateCurveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{5}
+}
+func (m *QueryEvaluateCurveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvaluateCurveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvaluateCurveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvaluateCurveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvaluateCurveResponse.Merge(m, src)
+}
+func (m *QueryEvaluateCurveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvaluateCurveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvaluateCurveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvaluateCurveResponse proto.InternalMessageInfo
+
+func (m *QueryEvaluateCurveResponse) GetCurve() InflationCurve {
+	if m != nil {
+		return m.Curve
+	}
+	return INFLATION_CURVE_UNSPECIFIED
+}
+
+// QuerySupplyCapRequest is the request type for the Query/SupplyCap RPC method.
+type QuerySupplyCapRequest struct {
+}
+
+func (m *QuerySupplyCapRequest) Reset()         { *m = QuerySupplyCapRequest{} }
+func (m *QuerySupplyCapRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyCapRequest) ProtoMessage()    {}
+func (*QuerySupplyCapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{6}
+}
+func (m *QuerySupplyCapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyCapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyCapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyCapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyCapRequest.Merge(m, src)
+}
+func (m *QuerySupplyCapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyCapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyCapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyCapRequest proto.InternalMessageInfo
+
+// QuerySupplyCapResponse is the response type for the Query/SupplyCap RPC
+// method.
+type QuerySupplyCapResponse struct {
+	// max_supply is the configured cap. Zero means the supply is uncapped.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// supply is the current total supply of the x/mint denom.
+	Supply types.Coin `protobuf:"bytes,2,opt,name=supply,proto3" json:"supply"`
+	// headroom is the amount that can still be minted before the cap is hit.
+	// It is zero when the supply is uncapped.
+	Headroom cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=headroom,proto3,customtype=cosmossdk.io/math.Int" json:"headroom"`
+	// block_provision is the amount minted per block at the current inflation.
+	BlockProvision cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=block_provision,json=blockProvision,proto3,customtype=cosmossdk.io/math.Int" json:"block_provision"`
+	// projected_cap_height is the height at which the cap is hit if the current
+	// block provision stays constant. It is zero when the supply is uncapped or
+	// nothing is being minted.
+	ProjectedCapHeight int64 `protobuf:"varint,5,opt,name=projected_cap_height,json=projectedCapHeight,proto3" json:"projected_cap_height,omitempty"`
+}
+
+func (m *QuerySupplyCapResponse) Reset()         { *m = QuerySupplyCapResponse{} }
+func (m *QuerySupplyCapResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyCapResponse) ProtoMessage()    {}
+func (*QuerySupplyCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{7}
+}
+func (m *QuerySupplyCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyCapResponse.Merge(m, src)
+}
+func (m *QuerySupplyCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyCapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyCapResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyCapResponse) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySupplyCapResponse) GetProjectedCapHeight() int64 {
+	if m != nil {
+		return m.ProjectedCapHeight
+	}
+	return 0
+}
+
+// QueryProjectionRequest is the request type for the Query/Projection RPC
+// method.
+type QueryProjectionRequest struct {
+	// years is the number of years to project.
+	Years uint32 `protobuf:"varint,1,opt,name=years,proto3" json:"years,omitempty"`
+	// periods_per_year is the number of rows reported per year. Defaults to 1.
+	PeriodsPerYear uint32 `protobuf:"varint,2,opt,name=periods_per_year,json=periodsPerYear,proto3" json:"periods_per_year,omitempty"`
+	// bonded_ratios is the assumed bonded ratio of each period. The last value
+	// holds for the remaining periods; the current bonded ratio is used if the
+	// path is empty.
+	BondedRatios []cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,rep,name=bonded_ratios,json=bondedRatios,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonded_ratios"`
+	// block_time is the assumed average block time. If zero, the chain is
+	// assumed to produce exactly the x/mint blocks_per_year.
+	BlockTime time.Duration `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdduration" json:"block_time"`
+	// curve is the curve to project. The active curve is used if unspecified.
+	Curve InflationCurve `protobuf:"varint,5,opt,name=curve,proto3,enum=tacchain.inflation.v1.InflationCurve" json:"curve,omitempty"`
+}
+
+func (m *QueryProjectionRequest) Reset()         { *m = QueryProjectionRequest{} }
+func (m *QueryProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionRequest) ProtoMessage()    {}
+func (*QueryProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{8}
+}
+func (m *QueryProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionRequest.Merge(m, src)
+}
+func (m *QueryProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryProjectionRequest) GetYears() uint32 {
+	if m != nil {
+		return m.Years
+	}
+	return 0
+}
+
+func (m *QueryProjectionRequest) GetPeriodsPerYear() uint32 {
+	if m != nil {
+		return m.PeriodsPerYear
+	}
+	return 0
+}
+
+func (m *QueryProjectionRequest) GetBlockTime() time.Duration {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *QueryProjectionRequest) GetCurve() InflationCurve {
+	if m != nil {
+		return m.Curve
+	}
+	return INFLATION_CURVE_UNSPECIFIED
+}
+
+// QueryProjectionResponse is the response type for the Query/Projection RPC
+// method.
+type QueryProjectionResponse struct {
+	// curve is the projected curve.
+	Curve InflationCurve `protobuf:"varint,1,opt,name=curve,proto3,enum=tacchain.inflation.v1.InflationCurve" json:"curve,omitempty"`
+	// periods holds one row per projected period.
+	Periods []ProjectionPeriod `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods"`
+}
+
+func (m *QueryProjectionResponse) Reset()         { *m = QueryProjectionResponse{} }
+func (m *QueryProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionResponse) ProtoMessage()    {}
+func (*QueryProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{9}
+}
+func (m *QueryProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionResponse.Merge(m, src)
+}
+func (m *QueryProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryProjectionResponse) GetCurve() InflationCurve {
+	if m != nil {
+		return m.Curve
+	}
+	return INFLATION_CURVE_UNSPECIFIED
+}
+
+func (m *QueryProjectionResponse) GetPeriods() []ProjectionPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+// ProjectionPeriod is the projected state at the end of a period.
+type ProjectionPeriod struct {
+	// period is the 1-based index of the period.
+	Period uint32 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// blocks is the number of blocks produced in the period.
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// bonded_ratio is the bonded ratio assumed for the period.
+	BondedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonded_ratio"`
+	// supply is the total supply at the end of the period.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	// annual_provisions is the nominal annual provisions at the start of the
+	// period.
+	AnnualProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_provisions"`
+	// inflation is the nominal inflation rate the curve yields for the period.
+	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+	// effective_inflation is the annualized supply growth over the period, which
+	// differs from inflation when the block time does not match blocks_per_year
+	// or the supply cap is hit.
+	EffectiveInflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=effective_inflation,json=effectiveInflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"effective_inflation"`
+}
+
+func (m *ProjectionPeriod) Reset()         { *m = ProjectionPeriod{} }
+func (m *ProjectionPeriod) String() string { return proto.CompactTextString(m) }
+func (*ProjectionPeriod) ProtoMessage()    {}
+func (*ProjectionPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{10}
+}
+func (m *ProjectionPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectionPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectionPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectionPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectionPeriod.Merge(m, src)
+}
+func (m *ProjectionPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectionPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectionPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectionPeriod proto.InternalMessageInfo
+
+func (m *ProjectionPeriod) GetPeriod() uint32 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *ProjectionPeriod) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// QueryProvisionFlowsRequest is the request type for the Query/ProvisionFlows
+// RPC method.
+type QueryProvisionFlowsRequest struct {
+}
+
+func (m *QueryProvisionFlowsRequest) Reset()         { *m = QueryProvisionFlowsRequest{} }
+func (m *QueryProvisionFlowsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProvisionFlowsRequest) ProtoMessage()    {}
+func (*QueryProvisionFlowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{11}
+}
+func (m *QueryProvisionFlowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvisionFlowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvisionFlowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProvisionFlowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvisionFlowsRequest.Merge(m, src)
+}
+func (m *QueryProvisionFlowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvisionFlowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvisionFlowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProvisionFlowsRequest proto.InternalMessageInfo
+
+// QueryProvisionFlowsResponse is the response type for the
+// Query/ProvisionFlows RPC method.
+type QueryProvisionFlowsResponse struct {
+	// treasury_fraction is the fraction of provisions sent to the treasury.
+	TreasuryFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=treasury_fraction,json=treasuryFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"treasury_fraction"`
+	// community_pool_fraction is the fraction of provisions sent to the
+	// community pool.
+	CommunityPoolFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=community_pool_fraction,json=communityPoolFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_fraction"`
+	// totals are the provisions sent to each destination so far.
+	Totals ProvisionTotals `protobuf:"bytes,3,opt,name=totals,proto3" json:"totals"`
+	// treasury_address is the address of the treasury module account.
+	TreasuryAddress string `protobuf:"bytes,4,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+	// treasury_balance is the current balance of the treasury module account.
+	TreasuryBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=treasury_balance,json=treasuryBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"treasury_balance"`
+}
+
+func (m *QueryProvisionFlowsResponse) Reset()         { *m = QueryProvisionFlowsResponse{} }
+func (m *QueryProvisionFlowsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProvisionFlowsResponse) ProtoMessage()    {}
+func (*QueryProvisionFlowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{12}
+}
+func (m *QueryProvisionFlowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvisionFlowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvisionFlowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProvisionFlowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvisionFlowsResponse.Merge(m, src)
+}
+func (m *QueryProvisionFlowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvisionFlowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvisionFlowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProvisionFlowsResponse proto.InternalMessageInfo
+
+func (m *QueryProvisionFlowsResponse) GetTotals() ProvisionTotals {
+	if m != nil {
+		return m.Totals
+	}
+	return ProvisionTotals{}
+}
+
+func (m *QueryProvisionFlowsResponse) GetTreasuryAddress() string {
+	if m != nil {
+		return m.TreasuryAddress
+	}
+	return ""
+}
+
+func (m *QueryProvisionFlowsResponse) GetTreasuryBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TreasuryBalance
+	}
+	return nil
+}
+
+// QueryBurnedSupplyRequest is the request type for the Query/BurnedSupply RPC
+// method.
+type QueryBurnedSupplyRequest struct {
+	// module optionally restricts the result to a single module account.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+}
+
+func (m *QueryBurnedSupplyRequest) Reset()         { *m = QueryBurnedSupplyRequest{} }
+func (m *QueryBurnedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedSupplyRequest) ProtoMessage()    {}
+func (*QueryBurnedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{13}
+}
+func (m *QueryBurnedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedSupplyRequest.Merge(m, src)
+}
+func (m *QueryBurnedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryBurnedSupplyRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+// QueryBurnedSupplyResponse is the response type for the Query/BurnedSupply
+// RPC method.
+type QueryBurnedSupplyResponse struct {
+	// modules are the cumulative supply changes of each module account.
+	Modules []ModuleSupplyChange `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules"`
+	// net_burned is, per denom, the amount burned in excess of the amount
+	// minted back across the returned module accounts.
+	NetBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=net_burned,json=netBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"net_burned"`
+}
+
+func (m *QueryBurnedSupplyResponse) Reset()         { *m = QueryBurnedSupplyResponse{} }
+func (m *QueryBurnedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedSupplyResponse) ProtoMessage()    {}
+func (*QueryBurnedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{14}
+}
+func (m *QueryBurnedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedSupplyResponse.Merge(m, src)
+}
+func (m *QueryBurnedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedSupplyResponse) GetModules() []ModuleSupplyChange {
+	if m != nil {
+		return m.Modules
+	}
+	return nil
+}
+
+func (m *QueryBurnedSupplyResponse) GetNetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NetBurned
+	}
+	return nil
+}
+
+// QueryBurnsRequest is the request type for the Query/Burns RPC method.
+type QueryBurnsRequest struct {
+	// from_height is the first height of the range. Records older than the
+	// last 1,000,000 blocks are pruned.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the last height of the range.
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// module optionally restricts the result to a single module account.
+	Module string `protobuf:"bytes,3,opt,name=module,proto3" json:"module,omitempty"`
+}
+
+func (m *QueryBurnsRequest) Reset()         { *m = QueryBurnsRequest{} }
+func (m *QueryBurnsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnsRequest) ProtoMessage()    {}
+func (*QueryBurnsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{15}
+}
+func (m *QueryBurnsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnsRequest.Merge(m, src)
+}
+func (m *QueryBurnsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnsRequest proto.InternalMessageInfo
+
+func (m *QueryBurnsRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryBurnsRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryBurnsRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+// QueryBurnsResponse is the response type for the Query/Burns RPC method.
+type QueryBurnsResponse struct {
+	// modules are the supply changes of each module account within the range.
+	Modules []ModuleSupplyChange `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules"`
+	// net_burned is, per denom, the amount burned in excess of the amount
+	// minted back across the returned module accounts within the range.
+	NetBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=net_burned,json=netBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"net_burned"`
+}
+
+func (m *QueryBurnsResponse) Reset()         { *m = QueryBurnsResponse{} }
+func (m *QueryBurnsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnsResponse) ProtoMessage()    {}
+func (*QueryBurnsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17c3bedf989e8223, []int{16}
+}
+func (m *QueryBurnsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnsResponse.Merge(m, src)
+}
+func (m *QueryBurnsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnsResponse proto.InternalMessageInfo
+
+func (m *QueryBurnsResponse) GetModules() []ModuleSupplyChange {
+	if m != nil {
+		return m.Modules
+	}
+	return nil
+}
+
+func (m *QueryBurnsResponse) GetNetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NetBurned
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "tacchain.inflation.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "tacchain.inflation.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryCurveRequest)(nil), "tacchain.inflation.v1.QueryCurveRequest")
+	proto.RegisterType((*QueryCurveResponse)(nil), "tacchain.inflation.v1.QueryCurveResponse")
+	proto.RegisterType((*QueryEvaluateCurveRequest)(nil), "tacchain.inflation.v1.QueryEvaluateCurveRequest")
+	proto.RegisterType((*QueryEvaluateCurveResponse)(nil), "tacchain.inflation.v1.QueryEvaluateCurveResponse")
+	proto.RegisterType((*QuerySupplyCapRequest)(nil), "tacchain.inflation.v1.QuerySupplyCapRequest")
+	proto.RegisterType((*QuerySupplyCapResponse)(nil), "tacchain.inflation.v1.QuerySupplyCapResponse")
+	proto.RegisterType((*QueryProjectionRequest)(nil), "tacchain.inflation.v1.QueryProjectionRequest")
+	proto.RegisterType((*QueryProjectionResponse)(nil), "tacchain.inflation.v1.QueryProjectionResponse")
+	proto.RegisterType((*ProjectionPeriod)(nil), "tacchain.inflation.v1.ProjectionPeriod")
+	proto.RegisterType((*QueryProvisionFlowsRequest)(nil), "tacchain.inflation.v1.QueryProvisionFlowsRequest")
+	proto.RegisterType((*QueryProvisionFlowsResponse)(nil), "tacchain.inflation.v1.QueryProvisionFlowsResponse")
+	proto.RegisterType((*QueryBurnedSupplyRequest)(nil), "tacchain.inflation.v1.QueryBurnedSupplyRequest")
+	proto.RegisterType((*QueryBurnedSupplyResponse)(nil), "tacchain.inflation.v1.QueryBurnedSupplyResponse")
+	proto.RegisterType((*QueryBurnsRequest)(nil), "tacchain.inflation.v1.QueryBurnsRequest")
+	proto.RegisterType((*QueryBurnsResponse)(nil), "tacchain.inflation.v1.QueryBurnsResponse")
+}
+
+func init() { proto.RegisterFile("tacchain/inflation/v1/query.proto", fileDescriptor_17c3bedf989e8223) }
+
+var fileDescriptor_17c3bedf989e8223 = []byte{
+	// 1494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcd, 0x6f, 0x13, 0xd7,
+	0x16, 0xcf, 0xd8, 0xb1, 0xc1, 0x27, 0x1f, 0x24, 0x97, 0x04, 0x26, 0x06, 0x9c, 0xe0, 0x07, 0x3c,
+	0x07, 0x91, 0x99, 0x24, 0xef, 0x09, 0x3d, 0xe9, 0xa1, 0xaa, 0x75, 0x28, 0x22, 0x12, 0xb4, 0xa9,
+	0x89, 0x8a, 0x68, 0x17, 0xa3, 0xeb, 0xf1, 0x8d, 0x33, 0x65, 0x66, 0xee, 0x30, 0x1f, 0x6e, 0xac,
+	0x08, 0xa9, 0x62, 0xd1, 0x4d, 0xbb, 0x68, 0x85, 0x54, 0x55, 0x55, 0xa5, 0x6e, 0x2a, 0xb5, 0xea,
+	0xa6, 0xb4, 0xe2, 0x8f, 0x60, 0x89, 0xe8, 0xa6, 0xea, 0x02, 0x2a, 0x40, 0x62, 0xd7, 0xbf, 0xa1,
+	0x9a, 0x7b, 0xef, 0x8c, 0xc7, 0x89, 0xed, 0x3a, 0x71, 0xd4, 0x45, 0x37, 0x89, 0xe7, 0x9e, 0x73,
+	0x7e, 0xe7, 0x77, 0xcf, 0xbd, 0xe7, 0xe3, 0xc2, 0x69, 0x1f, 0xeb, 0xfa, 0x26, 0x36, 0x6c, 0xd5,
+	0xb0, 0x37, 0x4c, 0xec, 0x1b, 0xd4, 0x56, 0x1b, 0x4b, 0xea, 0x9d, 0x80, 0xb8, 0x4d, 0xc5, 0x71,
+	0xa9, 0x4f, 0xd1, 0x74, 0xa4, 0xa2, 0xc4, 0x2a, 0x4a, 0x63, 0x29, 0x3f, 0x55, 0xa7, 0x75, 0xca,
+	0x34, 0xd4, 0xf0, 0x17, 0x57, 0xce, 0x9f, 0xac, 0x53, 0x5a, 0x37, 0x89, 0x8a, 0x1d, 0x43, 0xc5,
+	0xb6, 0x4d, 0x7d, 0xa6, 0xef, 0x09, 0xe9, 0x24, 0xb6, 0x0c, 0x9b, 0xaa, 0xec, 0xaf, 0x58, 0x9a,
+	0xd1, 0xa9, 0x67, 0x51, 0x4f, 0xe3, 0x48, 0xfc, 0x43, 0x88, 0x0a, 0xfc, 0x4b, 0xad, 0x62, 0x8f,
+	0xa8, 0x8d, 0xa5, 0x2a, 0xf1, 0xf1, 0x92, 0xaa, 0x53, 0xc3, 0x8e, 0xe4, 0xc2, 0x17, 0xfb, 0xaa,
+	0x06, 0x1b, 0x6a, 0x2d, 0x70, 0x39, 0x3d, 0x2e, 0x3f, 0xdb, 0x79, 0x6f, 0xad, 0x5d, 0x30, 0xb5,
+	0xe2, 0x14, 0xa0, 0x77, 0xc2, 0xed, 0xae, 0x61, 0x17, 0x5b, 0x5e, 0x85, 0xdc, 0x09, 0x88, 0xe7,
+	0x17, 0x6f, 0xc2, 0xd1, 0xb6, 0x55, 0xcf, 0xa1, 0xb6, 0x47, 0xd0, 0xeb, 0x90, 0x75, 0xd8, 0x8a,
+	0x2c, 0xcd, 0x49, 0xa5, 0x91, 0xe5, 0x53, 0x4a, 0xc7, 0xe8, 0x28, 0xdc, 0xac, 0x9c, 0x7b, 0xf4,
+	0x74, 0x76, 0xe8, 0xfb, 0x57, 0x0f, 0xce, 0x4b, 0x15, 0x61, 0x57, 0x3c, 0x0a, 0x93, 0x0c, 0x78,
+	0x25, 0x70, 0x1b, 0x24, 0xf2, 0xf6, 0x32, 0x05, 0x28, 0xb9, 0x2a, 0xbc, 0xfd, 0x1f, 0x32, 0x7a,
+	0xb8, 0xc0, 0x9c, 0x8d, 0x2f, 0x9f, 0xed, 0xe2, 0x6c, 0x35, 0xfa, 0xe0, 0xd6, 0xdc, 0x06, 0xbd,
+	0x0f, 0x63, 0xb1, 0x96, 0x66, 0x19, 0xb6, 0x9c, 0x9a, 0x93, 0x4a, 0xb9, 0xf2, 0xc5, 0x90, 0xd2,
+	0x6f, 0x4f, 0x67, 0x4f, 0xf0, 0xe8, 0x7a, 0xb5, 0xdb, 0x8a, 0x41, 0x55, 0x0b, 0xfb, 0x9b, 0xca,
+	0x35, 0x52, 0xc7, 0x7a, 0xf3, 0x32, 0xd1, 0x9f, 0x3c, 0x5c, 0x00, 0x71, 0x14, 0x97, 0x89, 0xce,
+	0xf9, 0x8f, 0xc6, 0x60, 0xd7, 0x0d, 0x7b, 0x07, 0x38, 0xde, 0x92, 0xd3, 0x07, 0x05, 0x8e, 0xb7,
+	0xd0, 0x4d, 0x18, 0xa9, 0x53, 0x6c, 0x6a, 0x55, 0x6a, 0xd7, 0x48, 0x4d, 0x1e, 0x1e, 0x08, 0x1a,
+	0x42, 0xa8, 0x32, 0x43, 0x2a, 0x6e, 0xc3, 0x0c, 0x8b, 0xf2, 0x9b, 0x0d, 0x6c, 0x06, 0xd8, 0x27,
+	0xc9, 0x33, 0x40, 0xa7, 0x61, 0x94, 0x3b, 0xd4, 0xd8, 0x2d, 0x62, 0x31, 0xcf, 0x55, 0x46, 0xf8,
+	0x5a, 0x25, 0x5c, 0x6a, 0x9d, 0x47, 0x6a, 0xef, 0xe7, 0x51, 0xfc, 0x51, 0x82, 0x7c, 0x27, 0xef,
+	0x07, 0x71, 0xd6, 0xeb, 0x90, 0x8b, 0xb5, 0x06, 0x3c, 0xe7, 0x16, 0x50, 0xf1, 0x38, 0x4c, 0x33,
+	0xc2, 0x37, 0x02, 0xc7, 0x31, 0x9b, 0x2b, 0xd8, 0x89, 0xae, 0xeb, 0xbd, 0x34, 0x1c, 0xdb, 0x29,
+	0x11, 0xdb, 0x78, 0x1b, 0xc0, 0xc2, 0x5b, 0x9a, 0xc7, 0x04, 0x3c, 0x86, 0xe5, 0x45, 0x41, 0x65,
+	0x7a, 0x37, 0x95, 0x55, 0xdb, 0x4f, 0x90, 0x58, 0xb5, 0x7d, 0x41, 0xc2, 0xc2, 0x5b, 0x1c, 0x1b,
+	0x5d, 0x82, 0xac, 0x00, 0x4b, 0xb1, 0x8c, 0x9b, 0x51, 0x84, 0x72, 0x58, 0x16, 0x14, 0x51, 0x16,
+	0x94, 0x15, 0x6a, 0xd8, 0x6d, 0xd9, 0xc6, 0x6d, 0xd0, 0x35, 0x38, 0xbc, 0x49, 0x70, 0xcd, 0xa5,
+	0xd4, 0x92, 0xd3, 0xfb, 0x24, 0x13, 0x23, 0xa0, 0x5b, 0x70, 0xa4, 0x6a, 0x52, 0xfd, 0x76, 0x58,
+	0xad, 0x1a, 0x86, 0x17, 0x06, 0x7b, 0x78, 0x9f, 0xa0, 0xe3, 0x0c, 0x68, 0x2d, 0xc2, 0x41, 0x8b,
+	0x30, 0xe5, 0xb8, 0xf4, 0x03, 0xa2, 0xfb, 0xa4, 0xa6, 0xe9, 0xd8, 0xd1, 0x36, 0x89, 0x51, 0xdf,
+	0xf4, 0xe5, 0xcc, 0x9c, 0x54, 0x4a, 0x57, 0x50, 0x2c, 0x5b, 0xc1, 0xce, 0x55, 0x26, 0x29, 0xfe,
+	0x94, 0x12, 0x87, 0xb0, 0xc6, 0x65, 0x06, 0xb5, 0xa3, 0xab, 0x3c, 0x05, 0x99, 0x26, 0xc1, 0x2e,
+	0x2f, 0x52, 0x63, 0x15, 0xfe, 0x81, 0x4a, 0x30, 0xe1, 0x10, 0xd7, 0xa0, 0x35, 0x4f, 0x73, 0x88,
+	0xab, 0x85, 0x8b, 0x2c, 0xa6, 0x63, 0x95, 0x71, 0xb1, 0xbe, 0x46, 0xdc, 0x5b, 0x04, 0xbb, 0xe8,
+	0x5d, 0x18, 0x4b, 0xa6, 0x82, 0x27, 0xa7, 0xe7, 0xd2, 0xa5, 0x5c, 0x79, 0x69, 0xcf, 0x57, 0xaa,
+	0x32, 0x9a, 0x48, 0x1f, 0x0f, 0x95, 0x01, 0x78, 0xfc, 0x7c, 0xc3, 0x22, 0xf2, 0xb0, 0x38, 0x4f,
+	0x5e, 0xc6, 0x95, 0xa8, 0x8c, 0x2b, 0x97, 0x45, 0x19, 0x2f, 0x1f, 0x0e, 0xfd, 0x7d, 0xf9, 0x6c,
+	0x56, 0xaa, 0xe4, 0x98, 0xd9, 0xba, 0x61, 0x25, 0xf2, 0x24, 0xb3, 0x8f, 0x1c, 0xfc, 0x56, 0x82,
+	0xe3, 0xbb, 0x62, 0x76, 0x10, 0x09, 0x78, 0x0d, 0x0e, 0x89, 0x18, 0xca, 0xa9, 0xb9, 0x74, 0x69,
+	0x64, 0xf9, 0xdf, 0xdd, 0x1a, 0x43, 0xec, 0x78, 0x8d, 0xe9, 0x27, 0x2f, 0x6d, 0x04, 0x51, 0xfc,
+	0x64, 0x18, 0x26, 0x76, 0x2a, 0xa2, 0x63, 0x90, 0xe5, 0x72, 0x71, 0xaa, 0x59, 0x27, 0x5e, 0x67,
+	0xd1, 0xf1, 0xd8, 0x61, 0x0e, 0x57, 0xc4, 0x17, 0xba, 0xb5, 0xa3, 0x9e, 0x0d, 0x56, 0xa1, 0xdb,
+	0xea, 0xe0, 0xd5, 0x38, 0x27, 0xf7, 0x7b, 0xfd, 0xa3, 0xfc, 0xd4, 0x61, 0x12, 0xdb, 0x76, 0x80,
+	0xcd, 0x56, 0x4a, 0x79, 0x72, 0x66, 0x20, 0xa6, 0x13, 0x1c, 0x30, 0x4e, 0x2d, 0xaf, 0xbd, 0x3a,
+	0x66, 0x0f, 0xa8, 0x3a, 0xa2, 0x3a, 0x1c, 0x25, 0x1b, 0x1b, 0xe1, 0x11, 0x35, 0x88, 0xd6, 0xc2,
+	0x3f, 0x34, 0x10, 0x3e, 0x8a, 0x21, 0xe3, 0x7b, 0x56, 0x3c, 0x29, 0xfa, 0x46, 0xbc, 0xa3, 0x2b,
+	0x26, 0xfd, 0x30, 0x1e, 0x54, 0x3e, 0x1f, 0x86, 0x13, 0x1d, 0xc5, 0xe2, 0x5a, 0xeb, 0x30, 0xe9,
+	0xbb, 0x04, 0x7b, 0x81, 0xdb, 0xd4, 0x36, 0x5c, 0xcc, 0x6e, 0x94, 0x2c, 0x0d, 0x44, 0x72, 0x22,
+	0x02, 0xbc, 0x22, 0xf0, 0x90, 0x0d, 0xc7, 0x75, 0x6a, 0x59, 0x81, 0x6d, 0xf8, 0x4d, 0xcd, 0xa1,
+	0xd4, 0x6c, 0xb9, 0x1a, 0xac, 0x1b, 0x4d, 0xc7, 0xb0, 0x6b, 0x94, 0x9a, 0xb1, 0xbf, 0x55, 0xc8,
+	0xfa, 0xd4, 0xc7, 0xa6, 0xc7, 0x6e, 0xf5, 0xc8, 0xf2, 0xb9, 0xee, 0xd9, 0xc6, 0x63, 0xb2, 0xce,
+	0xb4, 0xdb, 0x3a, 0x04, 0x07, 0x40, 0x2b, 0x10, 0x6f, 0x47, 0xc3, 0xb5, 0x9a, 0x4b, 0x3c, 0x4f,
+	0xdc, 0x6a, 0xf9, 0xc9, 0xc3, 0x85, 0x29, 0x41, 0xe8, 0x0d, 0x2e, 0xb9, 0xe1, 0xbb, 0x86, 0x5d,
+	0xaf, 0x1c, 0x89, 0x2c, 0xc4, 0x32, 0xfa, 0x54, 0x4a, 0xa0, 0x54, 0xb1, 0x89, 0x6d, 0x3d, 0x2c,
+	0x50, 0xe9, 0xde, 0xfd, 0xea, 0x4a, 0xc8, 0xe6, 0x87, 0x67, 0xb3, 0xa5, 0xba, 0xe1, 0x6f, 0x06,
+	0x55, 0x45, 0xa7, 0x96, 0x98, 0x80, 0xc5, 0xbf, 0x05, 0xaf, 0x76, 0x5b, 0xf5, 0x9b, 0x0e, 0xf1,
+	0x98, 0x81, 0xf7, 0xd5, 0xab, 0x07, 0xe7, 0x47, 0x4d, 0x16, 0x2f, 0x4d, 0x0f, 0x17, 0xf8, 0x56,
+	0x62, 0x3a, 0x65, 0xee, 0xb9, 0xb8, 0x0c, 0x32, 0xbb, 0x12, 0xe5, 0xc0, 0xb5, 0x49, 0x8d, 0x37,
+	0xd2, 0xa8, 0x37, 0x1c, 0x83, 0xac, 0x45, 0x6b, 0x81, 0x49, 0xc4, 0x80, 0x23, 0xbe, 0x8a, 0x7f,
+	0x48, 0x30, 0xd3, 0xc1, 0x48, 0xdc, 0xa2, 0xb7, 0xe0, 0x10, 0xd7, 0x0b, 0x7b, 0x4a, 0xb8, 0xad,
+	0xf9, 0x2e, 0x11, 0xbf, 0xce, 0xb4, 0xc4, 0x5c, 0xb0, 0x89, 0xed, 0x3a, 0x69, 0xab, 0x70, 0x02,
+	0x04, 0x7d, 0x24, 0x01, 0xd8, 0xc4, 0xd7, 0xaa, 0xcc, 0x99, 0x9c, 0xfa, 0xbb, 0x42, 0x95, 0xb3,
+	0x89, 0xcf, 0x37, 0x58, 0x34, 0xc4, 0x20, 0x1e, 0x7e, 0x46, 0xd9, 0x84, 0x66, 0x61, 0x64, 0xc3,
+	0xa5, 0x56, 0xd4, 0x7d, 0x25, 0xd6, 0x7d, 0x21, 0x5c, 0xe2, 0x5d, 0x17, 0x9d, 0x80, 0x9c, 0x4f,
+	0x23, 0x71, 0x8a, 0x89, 0x0f, 0xfb, 0x54, 0x08, 0x5b, 0xb1, 0x4d, 0xb7, 0xc5, 0xf6, 0x95, 0x04,
+	0x28, 0xe9, 0xeb, 0x1f, 0x1b, 0xd4, 0xe5, 0x97, 0x39, 0xc8, 0xb0, 0x9d, 0xa2, 0x8f, 0x25, 0xc8,
+	0xf2, 0x57, 0x10, 0xea, 0xb6, 0xad, 0xdd, 0xcf, 0xae, 0xfc, 0xf9, 0x7e, 0x54, 0x79, 0xf8, 0x8a,
+	0x67, 0xef, 0xfd, 0xf2, 0xf2, 0x7e, 0x6a, 0x16, 0x9d, 0x52, 0x3b, 0x3f, 0xf4, 0xf8, 0x83, 0x0b,
+	0xdd, 0x93, 0x20, 0xc3, 0x7a, 0x35, 0x2a, 0xf5, 0x02, 0x4f, 0xbe, 0x05, 0xf2, 0xf3, 0x7d, 0x68,
+	0x0a, 0x16, 0x67, 0x18, 0x8b, 0x02, 0x3a, 0xd9, 0x85, 0x05, 0x9f, 0x0f, 0x7e, 0x96, 0x60, 0xac,
+	0x6d, 0xee, 0x47, 0x8b, 0xbd, 0x5c, 0x74, 0x7a, 0xa0, 0xe4, 0x97, 0xf6, 0x60, 0x21, 0xc8, 0x5d,
+	0x62, 0xe4, 0x2e, 0xa2, 0xff, 0xf6, 0x22, 0xa7, 0x12, 0x61, 0xab, 0x6e, 0x27, 0x07, 0x86, 0xbb,
+	0xe8, 0xbe, 0x04, 0xb9, 0x78, 0xc2, 0x47, 0x17, 0x7a, 0xb9, 0xdf, 0xf9, 0x44, 0xc8, 0x2f, 0xf4,
+	0xa9, 0x2d, 0x88, 0xce, 0x33, 0xa2, 0xff, 0x42, 0xa7, 0xbb, 0x10, 0xe5, 0xe3, 0x42, 0x38, 0x18,
+	0xa3, 0x2f, 0x24, 0x80, 0xd6, 0x70, 0x84, 0x7a, 0x3a, 0xda, 0x35, 0x1a, 0xe7, 0x95, 0x7e, 0xd5,
+	0xfb, 0x24, 0xe6, 0xb4, 0x98, 0x7c, 0x27, 0xc1, 0x78, 0x7b, 0x13, 0x46, 0x4b, 0x7f, 0xe1, 0x6d,
+	0x77, 0x3f, 0xcf, 0x2f, 0xef, 0xc5, 0x44, 0x90, 0x54, 0x18, 0xc9, 0x12, 0x3a, 0xd7, 0x9d, 0x24,
+	0x37, 0xd3, 0x36, 0x18, 0xad, 0x6f, 0x24, 0x18, 0x4d, 0x96, 0x79, 0xa4, 0xf6, 0x72, 0xda, 0xa1,
+	0x8b, 0xe4, 0x17, 0xfb, 0x37, 0x10, 0x1c, 0x2f, 0x30, 0x8e, 0xe7, 0xd0, 0x99, 0x2e, 0x1c, 0x79,
+	0xd1, 0x12, 0x0f, 0x47, 0xf4, 0xb5, 0x04, 0x99, 0x10, 0xc6, 0xeb, 0x9d, 0xb4, 0xc9, 0xda, 0x9d,
+	0x9f, 0xef, 0x43, 0x53, 0x90, 0x79, 0x8d, 0x91, 0xf9, 0x1f, 0xba, 0xd8, 0x83, 0x8c, 0xa7, 0x6e,
+	0x27, 0x5a, 0xc1, 0x5d, 0x75, 0x3b, 0xae, 0xfb, 0x77, 0xcb, 0x57, 0x1f, 0x3d, 0x2f, 0x48, 0x8f,
+	0x9f, 0x17, 0xa4, 0xdf, 0x9f, 0x17, 0xa4, 0xcf, 0x5e, 0x14, 0x86, 0x1e, 0xbf, 0x28, 0x0c, 0xfd,
+	0xfa, 0xa2, 0x30, 0xf4, 0x9e, 0x92, 0xa8, 0xa5, 0xeb, 0x58, 0x2f, 0x07, 0x86, 0x59, 0x6b, 0x39,
+	0xd9, 0x4a, 0xb8, 0x61, 0x75, 0xb5, 0x9a, 0x65, 0xcf, 0x9e, 0xff, 0xfc, 0x39, 0x00, 0x9a, 0xee,
+	0x2b, 0x7f, 0x89, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of inflation parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Curve returns the active inflation curve together with the x/mint
+	// parameters that shape it.
+	Curve(ctx context.Context, in *QueryCurveRequest, opts ...grpc.CallOption) (*QueryCurveResponse, error)
+	// EvaluateCurve returns the inflation rate a curve yields at the given
+	// bonded ratio with the current x/mint parameters.
+	EvaluateCurve(ctx context.Context, in *QueryEvaluateCurveRequest, opts ...grpc.CallOption) (*QueryEvaluateCurveResponse, error)
+	// SupplyCap returns the remaining mintable headroom under max_supply and the
+	// height at which the cap is projected to be hit.
+	SupplyCap(ctx context.Context, in *QuerySupplyCapRequest, opts ...grpc.CallOption) (*QuerySupplyCapResponse, error)
+	// Projection replays an inflation curve forward from the current state
+	// under an assumed bonded-ratio path and block time.
+	Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error)
+	// ProvisionFlows returns how minted provisions are split and the totals
+	// sent to each destination so far.
+	ProvisionFlows(ctx context.Context, in *QueryProvisionFlowsRequest, opts ...grpc.CallOption) (*QueryProvisionFlowsResponse, error)
+	// BurnedSupply returns the cumulative burns of each tracked module account
+	// and the resulting net burned supply.
+	BurnedSupply(ctx context.Context, in *QueryBurnedSupplyRequest, opts ...grpc.CallOption) (*QueryBurnedSupplyResponse, error)
+	// Burns returns the burns of each tracked module account within a height
+	// range.
+	Burns(ctx context.Context, in *QueryBurnsRequest, opts ...grpc.CallOption) (*QueryBurnsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.inflation.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Curve(ctx context.Context, in *QueryCurveRequest, opts ...grpc.CallOption) (*QueryCurveResponse, error) {
+	out := new(QueryCurveResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.inflation.v1.Query/Curve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EvaluateCurve(ctx context.Context, in *QueryEvaluateCurveRequest, opts ...grpc.CallOption) (*QueryEvaluateCurveResponse, error) {
+	out := new(QueryEvaluateCurveResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.inflation.v1.Query/EvaluateCurve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyCap(ctx context.Context, in *QuerySupplyCapRequest, opts ...grpc.CallOption) (*QuerySupplyCapResponse, error) {
+	out := new(QuerySupplyCapResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.inflation.v1.Query/SupplyCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error) {
+	out := new(QueryProjectionResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.inflation.v1.Query/Projection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProvisionFlows(ctx context.Context, in *QueryProvisionFlowsRequest, opts ...grpc.CallOption) (*QueryProvisionFlowsResponse, error) {
+	out := new(QueryProvisionFlowsResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.inflation.v1.Query/ProvisionFlows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnedSupply(ctx context.Context, in *QueryBurnedSupplyRequest, opts ...grpc.CallOption) (*QueryBurnedSupplyResponse, error) {
+	out := new(QueryBurnedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.inflation.v1.Query/BurnedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Burns(ctx context.Context, in *QueryBurnsRequest, opts ...grpc.CallOption) (*QueryBurnsResponse, error) {
+	out := new(QueryBurnsResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.inflation.v1.Query/Burns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of inflation parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Curve returns the active inflation curve together with the x/mint
+	// parameters that shape it.
+	Curve(context.Context, *QueryCurveRequest) (*QueryCurveResponse, error)
+	// EvaluateCurve returns the inflation rate a curve yields at the given
+	// bonded ratio with the current x/mint parameters.
+	EvaluateCurve(context.Context, *QueryEvaluateCurveRequest) (*QueryEvaluateCurveResponse, error)
+	// SupplyCap returns the remaining mintable headroom under max_supply and the
+	// height at which the cap is projected to be hit.
+	SupplyCap(context.Context, *QuerySupplyCapRequest) (*QuerySupplyCapResponse, error)
+	// Projection replays an inflation curve forward from the current state
+	// under an assumed bonded-ratio path and block time.
+	Projection(context.Context, *QueryProjectionRequest) (*QueryProjectionResponse, error)
+	// ProvisionFlows returns how minted provisions are split and the totals
+	// sent to each destination so far.
+	ProvisionFlows(context.Context, *QueryProvisionFlowsRequest) (*QueryProvisionFlowsResponse, error)
+	// BurnedSupply returns the cumulative burns of each tracked module account
+	// and the resulting net burned supply.
+	BurnedSupply(context.Context, *QueryBurnedSupplyRequest) (*QueryBurnedSupplyResponse, error)
+	// Burns returns the burns of each tracked module account within a height
+	// range.
+	Burns(context.Context, *QueryBurnsRequest) (*QueryBurnsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Curve(ctx context.Context, req *QueryCurveRequest) (*QueryCurveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Curve not implemented")
+}
+func (*UnimplementedQueryServer) EvaluateCurve(ctx context.Context, req *QueryEvaluateCurveRequest) (*QueryEvaluateCurveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateCurve not implemented")
+}
+func (*UnimplementedQueryServer) SupplyCap(ctx context.Context, req *QuerySupplyCapRequest) (*QuerySupplyCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyCap not implemented")
+}
+func (*UnimplementedQueryServer) Projection(ctx context.Context, req *QueryProjectionRequest) (*QueryProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Projection not implemented")
+}
+func (*UnimplementedQueryServer) ProvisionFlows(ctx context.Context, req *QueryProvisionFlowsRequest) (*QueryProvisionFlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvisionFlows not implemented")
+}
+func (*UnimplementedQueryServer) BurnedSupply(ctx context.Context, req *QueryBurnedSupplyRequest) (*QueryBurnedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedSupply not implemented")
+}
+func (*UnimplementedQueryServer) Burns(ctx context.Context, req *QueryBurnsRequest) (*QueryBurnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burns not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.inflation.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Curve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Curve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.inflation.v1.Query/Curve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Curve(ctx, req.(*QueryCurveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EvaluateCurve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvaluateCurveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvaluateCurve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.inflation.v1.Query/EvaluateCurve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvaluateCurve(ctx, req.(*QueryEvaluateCurveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.inflation.v1.Query/SupplyCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyCap(ctx, req.(*QuerySupplyCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Projection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Projection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.inflation.v1.Query/Projection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Projection(ctx, req.(*QueryProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProvisionFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProvisionFlowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProvisionFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.inflation.v1.Query/ProvisionFlows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProvisionFlows(ctx, req.(*QueryProvisionFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.inflation.v1.Query/BurnedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedSupply(ctx, req.(*QueryBurnedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Burns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Burns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.inflation.v1.Query/Burns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Burns(ctx, req.(*QueryBurnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tacchain.inflation.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Curve",
+			Handler:    _Query_Curve_Handler,
+		},
+		{
+			MethodName: "EvaluateCurve",
+			Handler:    _Query_EvaluateCurve_Handler,
+		},
+		{
+			MethodName: "SupplyCap",
+			Handler:    _Query_SupplyCap_Handler,
+		},
+		{
+			MethodName: "Projection",
+			Handler:    _Query_Projection_Handler,
+		},
+		{
+			MethodName: "ProvisionFlows",
+			Handler:    _Query_ProvisionFlows_Handler,
+		},
+		{
+			MethodName: "BurnedSupply",
+			Handler:    _Query_BurnedSupply_Handler,
+		},
+		{
+			MethodName: "Burns",
+			Handler:    _Query_Burns_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tacchain/inflation/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Curve != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvaluateCurveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvaluateCurveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvaluateCurveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Curve != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BondedRatio) > 0 {
+		i -= len(m.BondedRatio)
+		copy(dAtA[i:], m.BondedRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BondedRatio)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvaluateCurveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvaluateCurveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvaluateCurveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Curve != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyCapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyCapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyCapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProjectedCapHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProjectedCapHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.BlockProvision.Size()
+		i -= size
+		if _, err := m.BlockProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Headroom.Size()
+		i -= size
+		if _, err := m.Headroom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Curve != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BlockTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.BondedRatios) > 0 {
+		for iNdEx := len(m.BondedRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BondedRatios[iNdEx].Size()
+				i -= size
+				if _, err := m.BondedRatios[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PeriodsPerYear != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PeriodsPerYear))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Years != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Years))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Curve != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectionPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectionPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectionPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EffectiveInflation.Size()
+		i -= size
+		if _, err := m.EffectiveInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvisionFlowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvisionFlowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvisionFlowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvisionFlowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvisionFlowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvisionFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TreasuryBalance) > 0 {
+		for iNdEx := len(m.TreasuryBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Totals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPoolFraction.Size()
+		i -= size
+		if _, err := m.CommunityPoolFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TreasuryFraction.Size()
+		i -= size
+		if _, err := m.TreasuryFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetBurned) > 0 {
+		for iNdEx := len(m.NetBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Modules) > 0 {
+		for iNdEx := len(m.Modules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Modules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetBurned) > 0 {
+		for iNdEx := len(m.NetBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Modules) > 0 {
+		for iNdEx := len(m.Modules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Modules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCurveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Curve != 0 {
+		n += 1 + sovQuery(uint64(m.Curve))
+	}
+	l = m.InflationMin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEvaluateCurveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondedRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Curve != 0 {
+		n += 1 + sovQuery(uint64(m.Curve))
+	}
+	return n
+}
+
+func (m *QueryEvaluateCurveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Curve != 0 {
+		n += 1 + sovQuery(uint64(m.Curve))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySupplyCapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupplyCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Headroom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BlockProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ProjectedCapHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ProjectedCapHeight))
+	}
+	return n
+}
+
+func (m *QueryProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Years != 0 {
+		n += 1 + sovQuery(uint64(m.Years))
+	}
+	if m.PeriodsPerYear != 0 {
+		n += 1 + sovQuery(uint64(m.PeriodsPerYear))
+	}
+	if len(m.BondedRatios) > 0 {
+		for _, e := range m.BondedRatios {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Curve != 0 {
+		n += 1 + sovQuery(uint64(m.Curve))
+	}
+	return n
+}
+
+func (m *QueryProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Curve != 0 {
+		n += 1 + sovQuery(uint64(m.Curve))
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectionPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EffectiveInflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProvisionFlowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProvisionFlowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TreasuryFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommunityPoolFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Totals.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TreasuryBalance) > 0 {
+		for _, e := range m.TreasuryBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBurnedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Modules) > 0 {
+		for _, e := range m.Modules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NetBurned) > 0 {
+		for _, e := range m.NetBurned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBurnsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Modules) > 0 {
+		for _, e := range m.Modules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NetBurned) > 0 {
+		for _, e := range m.NetBurned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= InflationCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvaluateCurveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvaluateCurveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvaluateCurveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondedRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= InflationCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvaluateCurveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvaluateCurveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvaluateCurveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= InflationCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyCapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyCapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyCapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyCapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headroom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Headroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedCapHeight", wireType)
+			}
+			m.ProjectedCapHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectedCapHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
+			}
+			m.Years = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Years |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodsPerYear", wireType)
+			}
+			m.PeriodsPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodsPerYear |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatios", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.BondedRatios = append(m.BondedRatios, v)
+			if err := m.BondedRatios[len(m.BondedRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= InflationCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= InflationCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, ProjectionPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectionPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectionPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectionPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveInflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvisionFlowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvisionFlowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvisionFlowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvisionFlowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvisionFlowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvisionFlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Totals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryBalance = append(m.TreasuryBalance, types.Coin{})
+			if err := m.TreasuryBalance[len(m.TreasuryBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Modules = append(m.Modules, ModuleSupplyChange{})
+			if err := m.Modules[len(m.Modules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetBurned = append(m.NetBurned, types.Coin{})
+			if err := m.NetBurned[len(m.NetBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Modules = append(m.Modules, ModuleSupplyChange{})
+			if err := m.Modules[len(m.Modules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetBurned = append(m.NetBurned, types.Coin{})
+			if err := m.NetBurned[len(m.NetBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
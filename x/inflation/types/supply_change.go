@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxBurnsHeightRange is the largest height range a Burns query may span.
+	MaxBurnsHeightRange = 100_000
+	// BurnRecordsRetention is the number of most recent heights whose burn
+	// records are kept. Older ones are pruned at the end of each block.
+	BurnRecordsRetention = 1_000_000
+)
+
+// Validate checks that both totals are valid sets of coins.
+func (c SupplyChange) Validate() error {
+	if err := c.Burned.Validate(); err != nil {
+		return fmt.Errorf("invalid burned total: %w", err)
+	}
+	if err := c.Minted.Validate(); err != nil {
+		return fmt.Errorf("invalid minted total: %w", err)
+	}
+	return nil
+}
+
+// Add returns the sum of both supply changes.
+func (c SupplyChange) Add(other SupplyChange) SupplyChange {
+	return SupplyChange{
+		Burned: c.Burned.Add(other.Burned...),
+		Minted: c.Minted.Add(other.Minted...),
+	}
+}
+
+// Net returns the supply change with the burned and minted amounts of every
+// denom netted, leaving each denom burned, minted, or neither.
+func (c SupplyChange) Net() SupplyChange {
+	var net SupplyChange
+	for _, denom := range c.Burned.Add(c.Minted...).Denoms() {
+		diff := c.Burned.AmountOf(denom).Sub(c.Minted.AmountOf(denom))
+		switch diff.Sign() {
+		case 1:
+			net.Burned = net.Burned.Add(sdk.NewCoin(denom, diff))
+		case -1:
+			net.Minted = net.Minted.Add(sdk.NewCoin(denom, diff.Neg()))
+		}
+	}
+	return net
+}
+
+// IsEmpty reports whether nothing was burned or minted.
+func (c SupplyChange) IsEmpty() bool {
+	return c.Burned.Empty() && c.Minted.Empty()
+}
+
+// Validate checks the module name and the supply change.
+func (c ModuleSupplyChange) Validate() error {
+	if strings.TrimSpace(c.Module) == "" {
+		return fmt.Errorf("module name cannot be blank")
+	}
+	if err := c.Change.Validate(); err != nil {
+		return fmt.Errorf("module %s: %w", c.Module, err)
+	}
+	return nil
+}
+
+// Validate checks the height, the module name and the supply change.
+func (r BurnRecord) Validate() error {
+	if r.Height <= 0 {
+		return fmt.Errorf("height must be positive: %d", r.Height)
+	}
+	return ModuleSupplyChange{Module: r.Module, Change: r.Change}.Validate()
+}
+
+// NetBurned returns, per denom, the amount burned in excess of the amount
+// minted back across the given supply changes. Denoms with a net mint are
+// left out.
+func NetBurned(changes []ModuleSupplyChange) sdk.Coins {
+	net := make(map[string]math.Int)
+	add := func(coin sdk.Coin, amount math.Int) {
+		if prev, ok := net[coin.Denom]; ok {
+			amount = prev.Add(amount)
+		}
+		net[coin.Denom] = amount
+	}
+	for _, c := range changes {
+		for _, coin := range c.Change.Burned {
+			add(coin, coin.Amount)
+		}
+		for _, coin := range c.Change.Minted {
+			add(coin, coin.Amount.Neg())
+		}
+	}
+
+	netBurned := sdk.NewCoins()
+	for denom, amount := range net {
+		if amount.IsPositive() {
+			netBurned = netBurned.Add(sdk.NewCoin(denom, amount))
+		}
+	}
+	return netBurned
+}
+
+// ValidateBurnsHeightRange checks that a Burns query height range is ordered,
+// positive and not wider than MaxBurnsHeightRange.
+func ValidateBurnsHeightRange(fromHeight, toHeight int64) error {
+	if fromHeight <= 0 {
+		return fmt.Errorf("from height must be positive: %d", fromHeight)
+	}
+	if toHeight < fromHeight {
+		return fmt.Errorf("to height %d is below from height %d", toHeight, fromHeight)
+	}
+	if toHeight-fromHeight >= MaxBurnsHeightRange {
+		return fmt.Errorf("height range cannot span more than %d blocks", MaxBurnsHeightRange)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/txpolicy/types"
+)
+
+// BlockedAddressDecorator rejects txs signed by a blocked account, and
+// Ethereum txs sent to one. Transfers to blocked accounts made while
+// executing a tx are rejected by the x/bank send restriction instead.
+type BlockedAddressDecorator struct {
+	txPolicyParams *types.Params
+}
+
+// NewBlockedAddressDecorator creates a new BlockedAddressDecorator instance
+// used for both Cosmos and Ethereum transactions.
+func NewBlockedAddressDecorator(txPolicyParams *types.Params) BlockedAddressDecorator {
+	return BlockedAddressDecorator{txPolicyParams: txPolicyParams}
+}
+
+func (bad BlockedAddressDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if len(bad.txPolicyParams.BlockedAddresses) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	blocklist, err := types.NewBlocklist(bad.txPolicyParams.BlockedAddresses)
+	if err != nil {
+		return ctx, err
+	}
+
+	isEthereumTx := false
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+		isEthereumTx = true
+		if from := ethMsg.GetFrom(); blocklist.Contains(from) {
+			return ctx, errorsmod.Wrapf(types.ErrBlockedAddress, "sender %s", from)
+		}
+		if to := ethMsg.AsTransaction().To(); to != nil && blocklist.Contains(to.Bytes()) {
+			return ctx, errorsmod.Wrapf(types.ErrBlockedAddress, "recipient %s", to)
+		}
+	}
+
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok && !isEthereumTx {
+		signers, err := sigTx.GetSigners()
+		if err != nil {
+			return ctx, errorsmod.Wrap(errortypes.ErrUnauthorized, err.Error())
+		}
+		for _, signer := range signers {
+			if blocklist.Contains(signer) {
+				return ctx, errorsmod.Wrapf(types.ErrBlockedAddress, "signer %s", sdk.AccAddress(signer))
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,71 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/txpolicy/ante"
+	"github.com/TacBuild/tacchain/x/txpolicy/types"
+)
+
+// signedTx is a cosmos tx exposing only its messages and signers.
+type signedTx struct {
+	authsigning.SigVerifiableTx
+
+	msgs    []sdk.Msg
+	signers [][]byte
+}
+
+func (tx signedTx) GetMsgs() []sdk.Msg            { return tx.msgs }
+func (tx signedTx) GetSigners() ([][]byte, error) { return tx.signers, nil }
+
+func ethereumTx(from sdk.AccAddress, to common.Address) feeTx {
+	msg := &evmtypes.MsgEthereumTx{}
+	msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.LegacyTx{To: &to}))
+	msg.From = from
+	return feeTx{msgs: []sdk.Msg{msg}}
+}
+
+func TestBlockedAddressDecorator(t *testing.T) {
+	blocked := sdk.AccAddress(common.HexToAddress("0x00000000000000000000000000000000000000b1").Bytes())
+	other := sdk.AccAddress(common.HexToAddress("0x00000000000000000000000000000000000000c1").Bytes())
+
+	// the blocklist holds the hex form, the cosmos tx is signed by the bech32 one
+	params := types.NewParams(nil, nil, []string{common.BytesToAddress(blocked).Hex()}, math.ZeroInt())
+	decorator := ante.NewBlockedAddressDecorator(&params)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	testCases := []struct {
+		name    string
+		tx      sdk.Tx
+		blocked bool
+	}{
+		{"cosmos tx signed by blocked account", signedTx{msgs: []sdk.Msg{&banktypes.MsgSend{}}, signers: [][]byte{other, blocked}}, true},
+		{"cosmos tx signed by other account", signedTx{msgs: []sdk.Msg{&banktypes.MsgSend{}}, signers: [][]byte{other}}, false},
+		{"ethereum tx from blocked account", ethereumTx(blocked, common.BytesToAddress(other)), true},
+		{"ethereum tx to blocked account", ethereumTx(other, common.BytesToAddress(blocked)), true},
+		{"ethereum tx between other accounts", ethereumTx(other, common.BytesToAddress(other)), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decorator.AnteHandle(sdk.Context{}, tc.tx, false, next)
+			if tc.blocked {
+				require.ErrorIs(t, err, types.ErrBlockedAddress)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package ante
+
+import (
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/txpolicy/types"
+)
+
+// MinGasPriceDecorator checks that the fee of a cosmos tx covers the
+// effective minimum gas price of its messages: the x/feemarket min_gas_price,
+// overridden per message type by the txpolicy params. It replaces the cosmos
+// EVM MinGasPriceDecorator and otherwise behaves the same way.
+//
+// An override below the global minimum only lowers this floor; the dynamic
+// fee checker still requires the x/feemarket base fee.
+// CONTRACT: Tx must implement FeeTx to use MinGasPriceDecorator
+type MinGasPriceDecorator struct {
+	feemarketParams *feemarkettypes.Params
+	txPolicyParams  *types.Params
+}
+
+// NewMinGasPriceDecorator creates a new MinGasPriceDecorator instance used
+// only for Cosmos transactions.
+func NewMinGasPriceDecorator(feemarketParams *feemarkettypes.Params, txPolicyParams *types.Params) MinGasPriceDecorator {
+	return MinGasPriceDecorator{feemarketParams: feemarketParams, txPolicyParams: txPolicyParams}
+}
+
+func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
+	}
+
+	minGasPrice := mpd.txPolicyParams.EffectiveMinGasPrice(mpd.feemarketParams.MinGasPrice, MsgTypeURLs(tx))
+
+	feeCoins := feeTx.GetFee()
+	evmDenom := evmtypes.GetEVMCoinDenom()
+
+	// only allow the native token, or the default bond denom used by unit
+	// tests, to be passed in as transaction fees
+	validFees := len(feeCoins) == 0 || (len(feeCoins) == 1 && slices.Contains([]string{evmDenom, sdk.DefaultBondDenom}, feeCoins.GetDenomByIndex(0)))
+	if !validFees && !simulate {
+		return ctx, fmt.Errorf("expected only native token %s for fee, but got %s", evmDenom, feeCoins.String())
+	}
+
+	// Short-circuit if min gas price is 0 or if simulating
+	if minGasPrice.IsZero() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	// Determine the required fee, where fee = ceil(minGasPrice * gasLimit).
+	requiredFees := sdk.NewCoins(sdk.NewCoin(evmDenom, minGasPrice.MulInt(math.NewIntFromUint64(feeTx.GetGas())).Ceil().RoundInt()))
+
+	// Fees not provided (or flag "auto"). Then use the base fee to make the check pass
+	if feeCoins == nil {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee,
+			"fee not provided. Please use the --fees flag or the --gas-price flag along with the --gas flag to estimate the fee. The minimum fee for this tx is: %s",
+			requiredFees)
+	}
+
+	if !feeCoins.IsAnyGTE(requiredFees) {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee,
+			"provided fee < minimum fee for the tx messages (%s < %s). Please increase the gas price.",
+			feeCoins,
+			requiredFees)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// MsgTypeURLs returns the type URLs of the tx messages.
+func MsgTypeURLs(tx sdk.Tx) []string {
+	msgs := tx.GetMsgs()
+	typeURLs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		typeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+	return typeURLs
+}
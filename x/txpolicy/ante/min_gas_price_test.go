@@ -0,0 +1,110 @@
+package ante_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/txpolicy/ante"
+	"github.com/TacBuild/tacchain/x/txpolicy/types"
+)
+
+func TestMain(m *testing.M) {
+	if err := evmtypes.NewEVMConfigurator().WithEVMCoinInfo(evmtypes.EvmCoinInfo{
+		Denom:         "utac",
+		ExtendedDenom: "utac",
+		DisplayDenom:  "tac",
+		Decimals:      evmtypes.EighteenDecimals.Uint32(),
+	}).Configure(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+type feeTx struct {
+	msgs []sdk.Msg
+	gas  uint64
+	fee  sdk.Coins
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx feeTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx feeTx) GetGas() uint64                        { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins                     { return tx.fee }
+func (tx feeTx) FeePayer() []byte                      { return nil }
+func (tx feeTx) FeeGranter() []byte                    { return nil }
+
+func TestMinGasPriceDecorator(t *testing.T) {
+	denom := evmtypes.GetEVMCoinDenom()
+	feemarketParams := feemarkettypes.DefaultParams()
+	feemarketParams.MinGasPrice = math.LegacyNewDec(10)
+	txPolicyParams := types.NewParams([]types.MsgMinGasPrice{
+		{MsgTypeUrl: sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}), MinGasPrice: math.LegacyNewDec(100)},
+		{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), MinGasPrice: math.LegacyNewDec(1)},
+	}, nil, nil, math.ZeroInt())
+	decorator := ante.NewMinGasPriceDecorator(&feemarketParams, &txPolicyParams)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	fee := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(denom, amount)) }
+
+	testCases := []struct {
+		name   string
+		tx     feeTx
+		errMsg string
+	}{
+		{
+			name: "global min gas price met",
+			tx:   feeTx{msgs: []sdk.Msg{&banktypes.MsgSend{}}, gas: 1_000, fee: fee(10_000)},
+		},
+		{
+			name:   "global min gas price not met",
+			tx:     feeTx{msgs: []sdk.Msg{&banktypes.MsgSend{}}, gas: 1_000, fee: fee(9_999)},
+			errMsg: "provided fee < minimum fee",
+		},
+		{
+			name:   "surcharged message",
+			tx:     feeTx{msgs: []sdk.Msg{&govv1.MsgSubmitProposal{}}, gas: 1_000, fee: fee(10_000)},
+			errMsg: "provided fee < minimum fee",
+		},
+		{
+			name: "surcharge paid",
+			tx:   feeTx{msgs: []sdk.Msg{&govv1.MsgSubmitProposal{}}, gas: 1_000, fee: fee(100_000)},
+		},
+		{
+			name: "discounted message",
+			tx:   feeTx{msgs: []sdk.Msg{&banktypes.MsgMultiSend{}}, gas: 1_000, fee: fee(1_000)},
+		},
+		{
+			name:   "discount lost with other messages",
+			tx:     feeTx{msgs: []sdk.Msg{&banktypes.MsgMultiSend{}, &banktypes.MsgSend{}}, gas: 1_000, fee: fee(1_000)},
+			errMsg: "provided fee < minimum fee",
+		},
+		{
+			name:   "no fee",
+			tx:     feeTx{msgs: []sdk.Msg{&banktypes.MsgSend{}}, gas: 1_000},
+			errMsg: "fee not provided",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decorator.AnteHandle(sdk.Context{}, tc.tx, false, next)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package txpolicy
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/TacBuild/tacchain/x/txpolicy/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current tx policy parameters",
+				},
+				{
+					RpcMethod:      "EffectiveMinGasPrice",
+					Use:            "effective-min-gas-price [msg-type-url...]",
+					Short:          "Query the minimum gas price of a cosmos tx made of the given message types",
+					Long:           "Query the minimum gas price of a cosmos tx made of the given message types. It is the highest minimum gas price of its messages, where messages without an override use the x/feemarket min_gas_price. Set --gas to also compute the required fee.",
+					Example:        fmt.Sprintf("%s query txpolicy effective-min-gas-price /ibc.core.client.v1.MsgUpdateClient /ibc.core.channel.v1.MsgRecvPacket --gas 300000", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "msg_type_urls", Varargs: true}},
+				},
+				{
+					RpcMethod: "AuthzDenyList",
+					Use:       "authz-deny-list",
+					Short:     "Query the message types that cannot be granted or executed through authz",
+				},
+				{
+					RpcMethod:      "AddressBlocked",
+					Use:            "address-blocked [address]",
+					Short:          "Query whether an account, given as bech32 or 0x hex address, is on the address blocklist",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update txpolicy module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update txpolicy module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query txpolicy params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx txpolicy update-params-proposal '{ "msg_min_gas_prices": [{ "msg_type_url": "/cosmos.gov.v1.MsgSubmitProposal", "min_gas_price": "100000000000" }] }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
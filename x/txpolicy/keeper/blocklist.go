@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TacBuild/tacchain/x/txpolicy/types"
+)
+
+// Blocklist returns the blocked accounts. It is empty until the module
+// params are initialized.
+func (k Keeper) Blocklist(ctx context.Context) (types.Blocklist, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Blocklist{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return types.NewBlocklist(params.BlockedAddresses)
+}
+
+// SendRestrictionFn is a x/bank send restriction rejecting transfers from
+// and to blocked accounts.
+func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	blocklist, err := k.Blocklist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if blocklist.Contains(fromAddr) {
+		return nil, errorsmod.Wrapf(types.ErrBlockedAddress, "%s cannot send coins", fromAddr)
+	}
+	if blocklist.Contains(toAddr) {
+		return nil, errorsmod.Wrapf(types.ErrBlockedAddress, "%s cannot receive coins", toAddr)
+	}
+	return toAddr, nil
+}
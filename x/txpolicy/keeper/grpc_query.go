@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/TacBuild/tacchain/x/txpolicy/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/txpolicy QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the txpolicy module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// EffectiveMinGasPrice returns the minimum gas price of a cosmos tx made of
+// the given message types.
+func (q queryServer) EffectiveMinGasPrice(ctx context.Context, req *types.QueryEffectiveMinGasPriceRequest) (*types.QueryEffectiveMinGasPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	globalMinGasPrice := q.k.feeMarketKeeper.GetParams(sdk.UnwrapSDKContext(ctx)).MinGasPrice
+	minGasPrice := params.EffectiveMinGasPrice(globalMinGasPrice, req.MsgTypeUrls)
+
+	denom := evmtypes.GetEVMCoinDenom()
+	return &types.QueryEffectiveMinGasPriceResponse{
+		MinGasPrice: sdk.NewDecCoinFromDec(denom, minGasPrice),
+		RequiredFee: sdk.NewCoin(denom, minGasPrice.MulInt(math.NewIntFromUint64(req.Gas)).Ceil().RoundInt()),
+	}, nil
+}
+
+// AuthzDenyList returns the type URLs of the messages that cannot be granted
+// or executed through x/authz.
+func (q queryServer) AuthzDenyList(ctx context.Context, _ *types.QueryAuthzDenyListRequest) (*types.QueryAuthzDenyListResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAuthzDenyListResponse{MsgTypeUrls: params.AuthzDeniedMsgTypeUrls}, nil
+}
+
+// AddressBlocked returns whether an account is on the address blocklist.
+func (q queryServer) AddressBlocked(ctx context.Context, req *types.QueryAddressBlockedRequest) (*types.QueryAddressBlockedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := types.ParseAddress(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	blocklist, err := q.k.Blocklist(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAddressBlockedResponse{
+		Blocked:    blocklist.Contains(addr),
+		Address:    addr.String(),
+		HexAddress: common.BytesToAddress(addr).Hex(),
+	}, nil
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TacBuild/tacchain/x/txpolicy/types"
+)
+
+// Keeper of the txpolicy store
+type Keeper struct {
+	cdc                   codec.BinaryCodec
+	storeService          storetypes.KVStoreService
+	transientStoreService storetypes.TransientStoreService
+	feeMarketKeeper       types.FeeMarketKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+
+	// BlockRelayRefunds are the relay refunds paid in the current block
+	BlockRelayRefunds collections.Item[math.Int]
+}
+
+// NewKeeper creates a new txpolicy Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	transientStoreService storetypes.TransientStoreService,
+	feeMarketKeeper types.FeeMarketKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	tsb := collections.NewSchemaBuilderFromAccessor(transientStoreService.OpenTransientStore)
+	k := Keeper{
+		cdc:                   cdc,
+		storeService:          storeService,
+		transientStoreService: transientStoreService,
+		feeMarketKeeper:       feeMarketKeeper,
+		authority:             authority,
+		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BlockRelayRefunds:     collections.NewItem(tsb, types.BlockRelayRefundsKey, "block_relay_refunds", sdk.IntValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	if _, err := tsb.Build(); err != nil {
+		panic(err)
+	}
+
+	return k
+}
+
+// GetAuthority returns the x/txpolicy module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
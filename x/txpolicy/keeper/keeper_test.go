@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+
+	"github.com/TacBuild/tacchain/x/txpolicy"
+	"github.com/TacBuild/tacchain/x/txpolicy/keeper"
+	"github.com/TacBuild/tacchain/x/txpolicy/types"
+)
+
+type feeMarketKeeper struct {
+	params feemarkettypes.Params
+}
+
+func (k feeMarketKeeper) GetParams(sdk.Context) feemarkettypes.Params {
+	return k.params
+}
+
+type fixture struct {
+	ctx       sdk.Context
+	keeper    keeper.Keeper
+	authority string
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(txpolicy.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey(types.TransientStoreKey)
+	ctx := testutil.DefaultContext(key, tkey)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		runtime.NewTransientStoreService(tkey),
+		feeMarketKeeper{feemarkettypes.DefaultParams()},
+		authority,
+	)
+
+	return &fixture{ctx: ctx, keeper: k, authority: authority}
+}
+
+func TestUpdateParams(t *testing.T) {
+	f := newFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	_, err := msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: "invalid", Params: types.DefaultParams()})
+	require.ErrorContains(t, err, "invalid authority")
+
+	invalid := types.DefaultParams()
+	invalid.BlockedAddresses = []string{"invalid"}
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.authority, Params: invalid})
+	require.ErrorContains(t, err, "must be bech32 or 0x hex")
+
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.authority, Params: types.DefaultParams()})
+	require.NoError(t, err)
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+}
+
+func TestSendRestriction(t *testing.T) {
+	f := newFixture(t)
+	blocked := sdk.AccAddress("blocked_____________")
+	other := sdk.AccAddress("other_______________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("utac", 1))
+
+	// nothing is blocked before the params are initialized
+	to, err := f.keeper.SendRestrictionFn(f.ctx, blocked, other, coins)
+	require.NoError(t, err)
+	require.Equal(t, other, to)
+
+	params := types.DefaultParams()
+	params.BlockedAddresses = []string{blocked.String()}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	_, err = f.keeper.SendRestrictionFn(f.ctx, blocked, other, coins)
+	require.ErrorIs(t, err, types.ErrBlockedAddress)
+	_, err = f.keeper.SendRestrictionFn(f.ctx, other, blocked, coins)
+	require.ErrorIs(t, err, types.ErrBlockedAddress)
+	to, err = f.keeper.SendRestrictionFn(f.ctx, other, other, coins)
+	require.NoError(t, err)
+	require.Equal(t, other, to)
+}
+
+func TestAddressBlockedQuery(t *testing.T) {
+	f := newFixture(t)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	blocked := sdk.AccAddress("blocked_____________")
+	hex := common.BytesToAddress(blocked).Hex()
+
+	params := types.DefaultParams()
+	params.BlockedAddresses = []string{blocked.String()}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	for _, address := range []string{blocked.String(), hex} {
+		res, err := queryServer.AddressBlocked(f.ctx, &types.QueryAddressBlockedRequest{Address: address})
+		require.NoError(t, err)
+		require.True(t, res.Blocked)
+		require.Equal(t, blocked.String(), res.Address)
+		require.Equal(t, hex, res.HexAddress)
+	}
+
+	res, err := queryServer.AddressBlocked(f.ctx, &types.QueryAddressBlockedRequest{Address: sdk.AccAddress("other_______________").String()})
+	require.NoError(t, err)
+	require.False(t, res.Blocked)
+
+	_, err = queryServer.AddressBlocked(f.ctx, &types.QueryAddressBlockedRequest{Address: "invalid"})
+	require.ErrorContains(t, err, "must be bech32 or 0x hex")
+}
+
+func TestRelayRefunds(t *testing.T) {
+	f := newFixture(t)
+
+	// nothing is refunded before the params are initialized
+	remaining, err := f.keeper.RemainingRelayRefunds(f.ctx)
+	require.NoError(t, err)
+	require.True(t, remaining.IsZero())
+
+	params := types.DefaultParams()
+	params.MaxRelayRefundPerBlock = math.NewInt(1_000)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	remaining, err = f.keeper.RemainingRelayRefunds(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1_000), remaining)
+
+	require.NoError(t, f.keeper.AddRelayRefund(f.ctx, math.NewInt(400)))
+	remaining, err = f.keeper.RemainingRelayRefunds(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(600), remaining)
+
+	// a lowered cap leaves nothing to refund for the rest of the block
+	params.MaxRelayRefundPerBlock = math.NewInt(300)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	remaining, err = f.keeper.RemainingRelayRefunds(f.ctx)
+	require.NoError(t, err)
+	require.True(t, remaining.IsZero())
+}
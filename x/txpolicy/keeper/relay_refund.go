@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+)
+
+// RemainingRelayRefunds returns the relay refunds that can still be paid in
+// the current block, in the EVM denom: the max_relay_refund_per_block param
+// minus the refunds already paid. It is zero until the module params are
+// initialized.
+func (k Keeper) RemainingRelayRefunds(ctx context.Context) (math.Int, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	paid, err := k.BlockRelayRefunds.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		paid = math.ZeroInt()
+	} else if err != nil {
+		return math.Int{}, err
+	}
+
+	if paid.GTE(params.MaxRelayRefundPerBlock) {
+		return math.ZeroInt(), nil
+	}
+	return params.MaxRelayRefundPerBlock.Sub(paid), nil
+}
+
+// AddRelayRefund records a relay refund paid in the current block.
+func (k Keeper) AddRelayRefund(ctx context.Context, amount math.Int) error {
+	paid, err := k.BlockRelayRefunds.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		paid = math.ZeroInt()
+	} else if err != nil {
+		return err
+	}
+	return k.BlockRelayRefunds.Set(ctx, paid.Add(amount))
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Blocklist is the set of blocked accounts, keyed by address bytes so that
+// the bech32 and 0x hex forms of an account match alike.
+type Blocklist map[string]struct{}
+
+// NewBlocklist parses the blocked addresses of the params.
+func NewBlocklist(addresses []string) (Blocklist, error) {
+	blocklist := make(Blocklist, len(addresses))
+	for _, address := range addresses {
+		addr, err := ParseAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		blocklist[string(addr)] = struct{}{}
+	}
+	return blocklist, nil
+}
+
+// Contains reports whether the account is blocked.
+func (b Blocklist) Contains(addr []byte) bool {
+	_, ok := b[string(addr)]
+	return ok
+}
+
+// ParseAddress parses a bech32 or 0x hex account address.
+func ParseAddress(address string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address).Bytes(), nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %q: must be bech32 or 0x hex: %w", address, err)
+	}
+	return addr, nil
+}
+
+func validateBlockedAddresses(addresses []string) error {
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		addr, err := ParseAddress(address)
+		if err != nil {
+			return err
+		}
+		if seen[string(addr)] {
+			return fmt.Errorf("duplicate blocked address %s", address)
+		}
+		seen[string(addr)] = true
+	}
+	return nil
+}
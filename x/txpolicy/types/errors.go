@@ -0,0 +1,8 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/txpolicy module sentinel errors
+var (
+	ErrBlockedAddress = errorsmod.Register(ModuleName, 2, "address is blocked")
+)
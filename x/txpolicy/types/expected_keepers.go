@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+)
+
+// FeeMarketKeeper defines the expected x/feemarket keeper.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) feemarkettypes.Params
+}
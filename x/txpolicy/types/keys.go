@@ -0,0 +1,21 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "txpolicy"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// TransientStoreKey defines the transient store key
+	TransientStoreKey = "transient_" + ModuleName
+)
+
+// ParamsKey is the prefix under which the module params are stored.
+var ParamsKey = collections.NewPrefix(0)
+
+// BlockRelayRefundsKey is the transient store prefix under which the relay
+// refunds paid in the current block are stored.
+var BlockRelayRefundsKey = collections.NewPrefix(0)
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// DefaultAuthzDeniedMsgTypeURLs are the messages that cannot be granted or
+// executed through x/authz by default.
+var DefaultAuthzDeniedMsgTypeURLs = []string{
+	sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+	sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+}
+
+// NewParams creates a new Params instance.
+func NewParams(
+	msgMinGasPrices []MsgMinGasPrice,
+	authzDeniedMsgTypeURLs, blockedAddresses []string,
+	maxRelayRefundPerBlock math.Int,
+) Params {
+	return Params{
+		MsgMinGasPrices:        msgMinGasPrices,
+		AuthzDeniedMsgTypeUrls: authzDeniedMsgTypeURLs,
+		BlockedAddresses:       blockedAddresses,
+		MaxRelayRefundPerBlock: maxRelayRefundPerBlock,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(nil, append([]string(nil), DefaultAuthzDeniedMsgTypeURLs...), nil, math.ZeroInt())
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateMsgMinGasPrices(p.MsgMinGasPrices); err != nil {
+		return err
+	}
+	if err := validateAuthzDeniedMsgTypeURLs(p.AuthzDeniedMsgTypeUrls); err != nil {
+		return err
+	}
+	if err := validateBlockedAddresses(p.BlockedAddresses); err != nil {
+		return err
+	}
+	return validateMaxRelayRefundPerBlock(p.MaxRelayRefundPerBlock)
+}
+
+// EffectiveMinGasPrice returns the minimum gas price of a tx made of the
+// given message types: the highest of their minimum gas prices, where
+// messages without an override use the global minimum. A tx is thus only
+// discounted if all its messages are.
+func (p Params) EffectiveMinGasPrice(globalMinGasPrice math.LegacyDec, msgTypeURLs []string) math.LegacyDec {
+	if len(msgTypeURLs) == 0 {
+		return globalMinGasPrice
+	}
+
+	overrides := make(map[string]math.LegacyDec, len(p.MsgMinGasPrices))
+	for _, o := range p.MsgMinGasPrices {
+		overrides[o.MsgTypeUrl] = o.MinGasPrice
+	}
+
+	var effective math.LegacyDec
+	for _, typeURL := range msgTypeURLs {
+		minGasPrice, ok := overrides[typeURL]
+		if !ok {
+			minGasPrice = globalMinGasPrice
+		}
+		if effective.IsNil() || minGasPrice.GT(effective) {
+			effective = minGasPrice
+		}
+	}
+	return effective
+}
+
+func validateMsgMinGasPrices(overrides []MsgMinGasPrice) error {
+	seen := make(map[string]bool, len(overrides))
+	for _, o := range overrides {
+		if err := validateMsgTypeURL(o.MsgTypeUrl); err != nil {
+			return err
+		}
+		if seen[o.MsgTypeUrl] {
+			return fmt.Errorf("duplicate min gas price for %s", o.MsgTypeUrl)
+		}
+		seen[o.MsgTypeUrl] = true
+
+		if o.MinGasPrice.IsNil() || o.MinGasPrice.IsNegative() {
+			return fmt.Errorf("min gas price of %s must be non-negative: %s", o.MsgTypeUrl, o.MinGasPrice)
+		}
+	}
+	return nil
+}
+
+func validateAuthzDeniedMsgTypeURLs(typeURLs []string) error {
+	seen := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if err := validateMsgTypeURL(typeURL); err != nil {
+			return err
+		}
+		if seen[typeURL] {
+			return fmt.Errorf("duplicate authz denied msg type URL %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+	return nil
+}
+
+func validateMaxRelayRefundPerBlock(maxRefund math.Int) error {
+	if maxRefund.IsNil() || maxRefund.IsNegative() {
+		return fmt.Errorf("max relay refund per block must be non-negative: %s", maxRefund)
+	}
+	return nil
+}
+
+func validateMsgTypeURL(typeURL string) error {
+	if !strings.HasPrefix(typeURL, "/") || strings.TrimSpace(typeURL) != typeURL || len(typeURL) == 1 {
+		return fmt.Errorf("invalid msg type URL %q: must start with / and have no whitespace", typeURL)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TacBuild/tacchain/x/txpolicy/types"
+)
+
+const (
+	submitProposal = "/cosmos.gov.v1.MsgSubmitProposal"
+	recvPacket     = "/ibc.core.channel.v1.MsgRecvPacket"
+	updateClient   = "/ibc.core.client.v1.MsgUpdateClient"
+	send           = "/cosmos.bank.v1beta1.MsgSend"
+)
+
+func TestEffectiveMinGasPrice(t *testing.T) {
+	params := types.NewParams([]types.MsgMinGasPrice{
+		{MsgTypeUrl: submitProposal, MinGasPrice: math.LegacyNewDec(100)},
+		{MsgTypeUrl: recvPacket, MinGasPrice: math.LegacyNewDec(2)},
+		{MsgTypeUrl: updateClient, MinGasPrice: math.LegacyNewDec(1)},
+	}, nil, nil, math.ZeroInt())
+	global := math.LegacyNewDec(10)
+
+	testCases := []struct {
+		name     string
+		typeURLs []string
+		expected math.LegacyDec
+	}{
+		{"no messages", nil, global},
+		{"no override", []string{send}, global},
+		{"surcharge", []string{send, submitProposal}, math.LegacyNewDec(100)},
+		{"discount", []string{updateClient, recvPacket}, math.LegacyNewDec(2)},
+		{"discount lost with other messages", []string{updateClient, recvPacket, send}, global},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.EffectiveMinGasPrice(global, tc.typeURLs))
+		})
+	}
+}
+
+func TestValidateMsgMinGasPrices(t *testing.T) {
+	testCases := []struct {
+		name      string
+		overrides []types.MsgMinGasPrice
+		errMsg    string
+	}{
+		{
+			name: "valid",
+			overrides: []types.MsgMinGasPrice{
+				{MsgTypeUrl: submitProposal, MinGasPrice: math.LegacyNewDec(100)},
+				{MsgTypeUrl: recvPacket, MinGasPrice: math.LegacyZeroDec()},
+			},
+		},
+		{
+			name:      "missing leading slash",
+			overrides: []types.MsgMinGasPrice{{MsgTypeUrl: "cosmos.gov.v1.MsgSubmitProposal", MinGasPrice: math.LegacyOneDec()}},
+			errMsg:    "invalid msg type URL",
+		},
+		{
+			name: "duplicate",
+			overrides: []types.MsgMinGasPrice{
+				{MsgTypeUrl: submitProposal, MinGasPrice: math.LegacyOneDec()},
+				{MsgTypeUrl: submitProposal, MinGasPrice: math.LegacyNewDec(2)},
+			},
+			errMsg: "duplicate min gas price",
+		},
+		{
+			name:      "negative",
+			overrides: []types.MsgMinGasPrice{{MsgTypeUrl: submitProposal, MinGasPrice: math.LegacyNewDec(-1)}},
+			errMsg:    "must be non-negative",
+		},
+		{
+			name:      "nil",
+			overrides: []types.MsgMinGasPrice{{MsgTypeUrl: submitProposal}},
+			errMsg:    "must be non-negative",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.NewParams(tc.overrides, nil, nil, math.ZeroInt()).Validate()
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDefaultParams(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+	require.Equal(t, []string{
+		"/cosmos.evm.vm.v1.MsgEthereumTx",
+		"/cosmos.vesting.v1beta1.MsgCreateVestingAccount",
+	}, params.AuthzDeniedMsgTypeUrls)
+}
+
+func TestValidateAuthzDeniedMsgTypeURLs(t *testing.T) {
+	require.ErrorContains(t, types.NewParams(nil, []string{send, send}, nil, math.ZeroInt()).Validate(), "duplicate authz denied msg type URL")
+	require.ErrorContains(t, types.NewParams(nil, []string{" " + send}, nil, math.ZeroInt()).Validate(), "invalid msg type URL")
+	require.NoError(t, types.NewParams(nil, nil, nil, math.ZeroInt()).Validate())
+}
+
+func TestValidateBlockedAddresses(t *testing.T) {
+	addr := sdk.AccAddress("blocked_____________")
+	hex := common.BytesToAddress(addr).Hex()
+
+	testCases := []struct {
+		name      string
+		addresses []string
+		errMsg    string
+	}{
+		{"valid", []string{addr.String(), common.HexToAddress("0x01").Hex()}, ""},
+		{"invalid", []string{"invalid"}, "must be bech32 or 0x hex"},
+		{"duplicate across formats", []string{addr.String(), hex}, "duplicate blocked address"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.NewParams(nil, nil, tc.addresses, math.ZeroInt()).Validate()
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			blocklist, err := types.NewBlocklist(tc.addresses)
+			require.NoError(t, err)
+			require.True(t, blocklist.Contains(addr))
+		})
+	}
+}
+
+func TestValidateMaxRelayRefundPerBlock(t *testing.T) {
+	require.NoError(t, types.NewParams(nil, nil, nil, math.NewInt(1_000)).Validate())
+	require.ErrorContains(t, types.NewParams(nil, nil, nil, math.NewInt(-1)).Validate(), "must be non-negative")
+	require.ErrorContains(t, types.NewParams(nil, nil, nil, math.Int{}).Validate(), "must be non-negative")
+}
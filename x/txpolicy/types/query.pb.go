@@ -0,0 +1,1860 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tacchain/txpolicy/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16a6e2e6bc5fbeee, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16a6e2e6bc5fbeee, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryEffectiveMinGasPriceRequest is the request type for the
+// Query/EffectiveMinGasPrice RPC method.
+type QueryEffectiveMinGasPriceRequest struct {
+	// msg_type_urls are the type URLs of the messages of the tx.
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// gas is the optional gas limit of the tx, used to compute the required fee.
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *QueryEffectiveMinGasPriceRequest) Reset()         { *m = QueryEffectiveMinGasPriceRequest{} }
+func (m *QueryEffectiveMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMinGasPriceRequest) ProtoMessage()    {}
+func (*QueryEffectiveMinGasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16a6e2e6bc5fbeee, []int{2}
+}
+func (m *QueryEffectiveMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveMinGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMinGasPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveMinGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMinGasPriceRequest.Merge(m, src)
+}
+func (m *QueryEffectiveMinGasPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveMinGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMinGasPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveMinGasPriceRequest proto.InternalMessageInfo
+
+func (m *QueryEffectiveMinGasPriceRequest) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *QueryEffectiveMinGasPriceRequest) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+// QueryEffectiveMinGasPriceResponse is the response type for the
+// Query/EffectiveMinGasPrice RPC method.
+type QueryEffectiveMinGasPriceResponse struct {
+	// min_gas_price is the minimum gas price of the tx.
+	MinGasPrice types.DecCoin `protobuf:"bytes,1,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
+	// required_fee is the minimum fee of the tx at the given gas limit.
+	RequiredFee types.Coin `protobuf:"bytes,2,opt,name=required_fee,json=requiredFee,proto3" json:"required_fee"`
+}
+
+func (m *QueryEffectiveMinGasPriceResponse) Reset()         { *m = QueryEffectiveMinGasPriceResponse{} }
+func (m *QueryEffectiveMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMinGasPriceResponse) ProtoMessage()    {}
+func (*QueryEffectiveMinGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16a6e2e6bc5fbeee, []int{3}
+}
+func (m *QueryEffectiveMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveMinGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMinGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveMinGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMinGasPriceResponse.Merge(m, src)
+}
+func (m *QueryEffectiveMinGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveMinGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMinGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveMinGasPriceResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveMinGasPriceResponse) GetMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *QueryEffectiveMinGasPriceResponse) GetRequiredFee() types.Coin {
+	if m != nil {
+		return m.RequiredFee
+	}
+	return types.Coin{}
+}
+
+// QueryAuthzDenyListRequest is the request type for the Query/AuthzDenyList
+// RPC method.
+type QueryAuthzDenyListRequest struct {
+}
+
+func (m *QueryAuthzDenyListRequest) Reset()         { *m = QueryAuthzDenyListRequest{} }
+func (m *QueryAuthzDenyListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthzDenyListRequest) ProtoMessage()    {}
+func (*QueryAuthzDenyListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16a6e2e6bc5fbeee, []int{4}
+}
+func (m *QueryAuthzDenyListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthzDenyListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthzDenyListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthzDenyListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthzDenyListRequest.Merge(m, src)
+}
+func (m *QueryAuthzDenyListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthzDenyListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthzDenyListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthzDenyListRequest proto.InternalMessageInfo
+
+// QueryAuthzDenyListResponse is the response type for the Query/AuthzDenyList
+// RPC method.
+type QueryAuthzDenyListResponse struct {
+	// msg_type_urls are the type URLs of the denied messages.
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (m *QueryAuthzDenyListResponse) Reset()         { *m = QueryAuthzDenyListResponse{} }
+func (m *QueryAuthzDenyListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthzDenyListResponse) ProtoMessage()    {}
+func (*QueryAuthzDenyListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16a6e2e6bc5fbeee, []int{5}
+}
+func (m *QueryAuthzDenyListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthzDenyListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthzDenyListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthzDenyListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthzDenyListResponse.Merge(m, src)
+}
+func (m *QueryAuthzDenyListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthzDenyListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthzDenyListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthzDenyListResponse proto.InternalMessageInfo
+
+func (m *QueryAuthzDenyListResponse) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+// QueryAddressBlockedRequest is the request type for the Query/AddressBlocked
+// RPC method.
+type QueryAddressBlockedRequest struct {
+	// address is the bech32 or 0x hex address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAddressBlockedRequest) Reset()         { *m = QueryAddressBlockedRequest{} }
+func (m *QueryAddressBlockedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressBlockedRequest) ProtoMessage()    {}
+func (*QueryAddressBlockedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16a6e2e6bc5fbeee, []int{6}
+}
+func (m *QueryAddressBlockedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressBlockedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressBlockedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressBlockedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressBlockedRequest.Merge(m, src)
+}
+func (m *QueryAddressBlockedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressBlockedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressBlockedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressBlockedRequest proto.InternalMessageInfo
+
+func (m *QueryAddressBlockedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAddressBlockedResponse is the response type for the
+// Query/AddressBlocked RPC method.
+type QueryAddressBlockedResponse struct {
+	// blocked is whether the account is on the blocklist.
+	Blocked bool `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	// address is the bech32 address of the account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// hex_address is the 0x hex address of the account.
+	HexAddress string `protobuf:"bytes,3,opt,name=hex_address,json=hexAddress,proto3" json:"hex_address,omitempty"`
+}
+
+func (m *QueryAddressBlockedResponse) Reset()         { *m = QueryAddressBlockedResponse{} }
+func (m *QueryAddressBlockedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressBlockedResponse) ProtoMessage()    {}
+func (*QueryAddressBlockedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16a6e2e6bc5fbeee, []int{7}
+}
+func (m *QueryAddressBlockedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressBlockedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressBlockedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressBlockedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressBlockedResponse.Merge(m, src)
+}
+func (m *QueryAddressBlockedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressBlockedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressBlockedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressBlockedResponse proto.InternalMessageInfo
+
+func (m *QueryAddressBlockedResponse) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
+	}
+	return false
+}
+
+func (m *QueryAddressBlockedResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAddressBlockedResponse) GetHexAddress() string {
+	if m != nil {
+		return m.HexAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "tacchain.txpolicy.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "tacchain.txpolicy.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryEffectiveMinGasPriceRequest)(nil), "tacchain.txpolicy.v1.QueryEffectiveMinGasPriceRequest")
+	proto.RegisterType((*QueryEffectiveMinGasPriceResponse)(nil), "tacchain.txpolicy.v1.QueryEffectiveMinGasPriceResponse")
+	proto.RegisterType((*QueryAuthzDenyListRequest)(nil), "tacchain.txpolicy.v1.QueryAuthzDenyListRequest")
+	proto.RegisterType((*QueryAuthzDenyListResponse)(nil), "tacchain.txpolicy.v1.QueryAuthzDenyListResponse")
+	proto.RegisterType((*QueryAddressBlockedRequest)(nil), "tacchain.txpolicy.v1.QueryAddressBlockedRequest")
+	proto.RegisterType((*QueryAddressBlockedResponse)(nil), "tacchain.txpolicy.v1.QueryAddressBlockedResponse")
+}
+
+func init() { proto.RegisterFile("tacchain/txpolicy/v1/query.proto", fileDescriptor_16a6e2e6bc5fbeee) }
+
+var fileDescriptor_16a6e2e6bc5fbeee = []byte{
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x5b, 0xda, 0xd2, 0x0b, 0x45, 0x70, 0x64, 0x48, 0xdd, 0xca, 0x0d, 0x06, 0x44, 0x40,
+	0xaa, 0x8f, 0x14, 0xd1, 0x8e, 0x40, 0x28, 0x74, 0x00, 0xa4, 0x12, 0x15, 0x84, 0x58, 0xac, 0x8b,
+	0xf3, 0xea, 0x9c, 0xb0, 0x7d, 0xae, 0xcf, 0x8e, 0x12, 0x10, 0x0b, 0xfc, 0x01, 0x24, 0x7e, 0x01,
+	0x62, 0x61, 0x42, 0xac, 0x2c, 0xcc, 0x1d, 0x2b, 0xb1, 0x30, 0x21, 0xd4, 0x22, 0xf1, 0x37, 0x90,
+	0xcf, 0xe7, 0xb6, 0x51, 0x4d, 0xd4, 0x2e, 0xd1, 0xdd, 0x7b, 0xef, 0xfb, 0xde, 0xf7, 0x5e, 0xbe,
+	0x33, 0xaa, 0xc5, 0xd4, 0x71, 0xba, 0x94, 0x05, 0x24, 0xee, 0x87, 0xdc, 0x63, 0xce, 0x80, 0xf4,
+	0x1a, 0x64, 0x2b, 0x81, 0x68, 0x60, 0x85, 0x11, 0x8f, 0x39, 0xae, 0xe4, 0x15, 0x56, 0x5e, 0x61,
+	0xf5, 0x1a, 0x7a, 0xc5, 0xe5, 0x2e, 0x97, 0x05, 0x24, 0x3d, 0x65, 0xb5, 0xfa, 0xbc, 0xcb, 0xb9,
+	0xeb, 0x01, 0xa1, 0x21, 0x23, 0x34, 0x08, 0x78, 0x4c, 0x63, 0xc6, 0x03, 0xa1, 0xb2, 0xe7, 0xa9,
+	0xcf, 0x02, 0x4e, 0xe4, 0xaf, 0x0a, 0x19, 0x0e, 0x17, 0x3e, 0x17, 0xa4, 0x4d, 0x05, 0x90, 0x5e,
+	0xa3, 0x0d, 0x31, 0x6d, 0x10, 0x87, 0xb3, 0x40, 0xe5, 0x2f, 0x15, 0xca, 0xdb, 0x17, 0x22, 0x8b,
+	0xcc, 0x0a, 0xc2, 0x4f, 0x52, 0xc1, 0xeb, 0x34, 0xa2, 0xbe, 0x68, 0xc1, 0x56, 0x02, 0x22, 0x36,
+	0x9f, 0xa1, 0x0b, 0x43, 0x51, 0x11, 0xf2, 0x40, 0x00, 0xbe, 0x8d, 0x26, 0x43, 0x19, 0xa9, 0x6a,
+	0x35, 0xad, 0x5e, 0x5e, 0x9a, 0xb7, 0x8a, 0xe6, 0xb3, 0x32, 0x54, 0x73, 0x7a, 0xfb, 0xd7, 0x42,
+	0xe9, 0xf3, 0xdf, 0xaf, 0xd7, 0xb5, 0x96, 0x82, 0x99, 0xcf, 0x51, 0x4d, 0xf2, 0xde, 0xdf, 0xdc,
+	0x04, 0x27, 0x66, 0x3d, 0x78, 0xcc, 0x82, 0x35, 0x2a, 0xd6, 0x23, 0xe6, 0x80, 0xea, 0x8d, 0x4d,
+	0x34, 0xe3, 0x0b, 0xd7, 0x8e, 0x07, 0x21, 0xd8, 0x49, 0xe4, 0xa5, 0xbd, 0xc6, 0xeb, 0xd3, 0xad,
+	0xb2, 0x2f, 0xdc, 0x8d, 0x41, 0x08, 0x4f, 0x23, 0x4f, 0xe0, 0x73, 0x68, 0xdc, 0xa5, 0xa2, 0x3a,
+	0x56, 0xd3, 0xea, 0xa7, 0x5a, 0xe9, 0xd1, 0xfc, 0xa6, 0xa1, 0x8b, 0x23, 0xa8, 0xd5, 0x00, 0x0f,
+	0xd1, 0x8c, 0xcf, 0x02, 0xdb, 0xa5, 0xc2, 0x0e, 0xd3, 0xc4, 0xfe, 0x1c, 0xd9, 0x2a, 0xad, 0x74,
+	0x95, 0x96, 0x5a, 0xa5, 0xb5, 0x0a, 0xce, 0x3d, 0xce, 0x82, 0xc3, 0x73, 0x94, 0xfd, 0x03, 0x52,
+	0xbc, 0x86, 0xce, 0x44, 0xb0, 0x95, 0xb0, 0x08, 0x3a, 0xf6, 0x26, 0x80, 0x54, 0x53, 0x5e, 0x9a,
+	0x2d, 0xe4, 0x3a, 0x42, 0x94, 0x23, 0x1f, 0x00, 0x98, 0x73, 0x68, 0x56, 0x4a, 0xbf, 0x9b, 0xc4,
+	0xdd, 0x57, 0xab, 0x10, 0x0c, 0x1e, 0x31, 0x11, 0xe7, 0x7f, 0xc5, 0x1d, 0xa4, 0x17, 0x25, 0xd5,
+	0x40, 0xc7, 0x58, 0x96, 0xb9, 0x9c, 0x33, 0x74, 0x3a, 0x11, 0x08, 0xd1, 0xf4, 0xb8, 0xf3, 0x12,
+	0x3a, 0xf9, 0xba, 0xab, 0x68, 0x8a, 0x66, 0x09, 0xb9, 0x8c, 0xe9, 0x56, 0x7e, 0x35, 0x23, 0x34,
+	0x57, 0x88, 0x53, 0xad, 0xab, 0x68, 0xaa, 0x9d, 0x85, 0x24, 0xf0, 0x74, 0x2b, 0xbf, 0x1e, 0xa6,
+	0x1c, 0x1b, 0xa2, 0xc4, 0x0b, 0xa8, 0xdc, 0x85, 0xbe, 0x9d, 0x67, 0xc7, 0x65, 0x16, 0x75, 0xa1,
+	0xaf, 0x7a, 0x2c, 0x7d, 0x9a, 0x40, 0x13, 0xb2, 0x29, 0x7e, 0xa7, 0xa1, 0xc9, 0xcc, 0x48, 0xb8,
+	0x5e, 0x6c, 0xb3, 0xa3, 0xbe, 0xd5, 0xaf, 0x1d, 0xa3, 0x32, 0x93, 0x6f, 0x5e, 0x7e, 0xfb, 0xe3,
+	0xcf, 0x87, 0x31, 0x03, 0xcf, 0x93, 0xc2, 0x67, 0x92, 0x19, 0x16, 0x7f, 0xd7, 0x50, 0xa5, 0xc8,
+	0x51, 0x78, 0x79, 0x44, 0xa7, 0x11, 0xee, 0xd6, 0x57, 0x4e, 0x8c, 0x53, 0x7a, 0x6f, 0x49, 0xbd,
+	0x04, 0x2f, 0x16, 0xeb, 0x85, 0x1c, 0x6b, 0x0f, 0x19, 0x1c, 0x7f, 0xd4, 0xd0, 0xcc, 0x90, 0x75,
+	0x30, 0x19, 0xa1, 0xa0, 0xc8, 0x81, 0xfa, 0x8d, 0xe3, 0x03, 0x94, 0xd6, 0x45, 0xa9, 0xf5, 0x2a,
+	0xbe, 0x52, 0xac, 0x95, 0xa6, 0x20, 0xbb, 0x03, 0xc1, 0xc0, 0xf6, 0x52, 0x45, 0x5f, 0x34, 0x74,
+	0x76, 0xd8, 0x64, 0x78, 0x64, 0xcf, 0x22, 0x1f, 0xeb, 0x8d, 0x13, 0x20, 0x94, 0xcc, 0x15, 0x29,
+	0xb3, 0x81, 0xc9, 0x7f, 0x64, 0x66, 0x28, 0x5b, 0xd9, 0x9a, 0xbc, 0x56, 0x81, 0x37, 0xcd, 0xb5,
+	0xed, 0x5d, 0x43, 0xdb, 0xd9, 0x35, 0xb4, 0xdf, 0xbb, 0x86, 0xf6, 0x7e, 0xcf, 0x28, 0xed, 0xec,
+	0x19, 0xa5, 0x9f, 0x7b, 0x46, 0xe9, 0xc5, 0xa2, 0xcb, 0xe2, 0x6e, 0xd2, 0xb6, 0x1c, 0xee, 0x93,
+	0x0d, 0xea, 0x34, 0x13, 0xe6, 0x75, 0x0e, 0xd8, 0xfb, 0x07, 0xfc, 0xe9, 0x8b, 0x15, 0xed, 0x49,
+	0xf9, 0x11, 0xbe, 0xf9, 0x6f, 0x00, 0x8b, 0x51, 0x2f, 0x66, 0x4a, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of txpolicy parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EffectiveMinGasPrice returns the minimum gas price a cosmos tx made of the
+	// given message types has to pay.
+	EffectiveMinGasPrice(ctx context.Context, in *QueryEffectiveMinGasPriceRequest, opts ...grpc.CallOption) (*QueryEffectiveMinGasPriceResponse, error)
+	// AuthzDenyList returns the type URLs of the messages that cannot be granted
+	// or executed through x/authz.
+	AuthzDenyList(ctx context.Context, in *QueryAuthzDenyListRequest, opts ...grpc.CallOption) (*QueryAuthzDenyListResponse, error)
+	// AddressBlocked returns whether an account is on the address blocklist.
+	AddressBlocked(ctx context.Context, in *QueryAddressBlockedRequest, opts ...grpc.CallOption) (*QueryAddressBlockedResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.txpolicy.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveMinGasPrice(ctx context.Context, in *QueryEffectiveMinGasPriceRequest, opts ...grpc.CallOption) (*QueryEffectiveMinGasPriceResponse, error) {
+	out := new(QueryEffectiveMinGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.txpolicy.v1.Query/EffectiveMinGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuthzDenyList(ctx context.Context, in *QueryAuthzDenyListRequest, opts ...grpc.CallOption) (*QueryAuthzDenyListResponse, error) {
+	out := new(QueryAuthzDenyListResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.txpolicy.v1.Query/AuthzDenyList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressBlocked(ctx context.Context, in *QueryAddressBlockedRequest, opts ...grpc.CallOption) (*QueryAddressBlockedResponse, error) {
+	out := new(QueryAddressBlockedResponse)
+	err := c.cc.Invoke(ctx, "/tacchain.txpolicy.v1.Query/AddressBlocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of txpolicy parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EffectiveMinGasPrice returns the minimum gas price a cosmos tx made of the
+	// given message types has to pay.
+	EffectiveMinGasPrice(context.Context, *QueryEffectiveMinGasPriceRequest) (*QueryEffectiveMinGasPriceResponse, error)
+	// AuthzDenyList returns the type URLs of the messages that cannot be granted
+	// or executed through x/authz.
+	AuthzDenyList(context.Context, *QueryAuthzDenyListRequest) (*QueryAuthzDenyListResponse, error)
+	// AddressBlocked returns whether an account is on the address blocklist.
+	AddressBlocked(context.Context, *QueryAddressBlockedRequest) (*QueryAddressBlockedResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveMinGasPrice(ctx context.Context, req *QueryEffectiveMinGasPriceRequest) (*QueryEffectiveMinGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveMinGasPrice not implemented")
+}
+func (*UnimplementedQueryServer) AuthzDenyList(ctx context.Context, req *QueryAuthzDenyListRequest) (*QueryAuthzDenyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthzDenyList not implemented")
+}
+func (*UnimplementedQueryServer) AddressBlocked(ctx context.Context, req *QueryAddressBlockedRequest) (*QueryAddressBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressBlocked not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.txpolicy.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveMinGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveMinGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveMinGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.txpolicy.v1.Query/EffectiveMinGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveMinGasPrice(ctx, req.(*QueryEffectiveMinGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuthzDenyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthzDenyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuthzDenyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.txpolicy.v1.Query/AuthzDenyList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthzDenyList(ctx, req.(*QueryAuthzDenyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tacchain.txpolicy.v1.Query/AddressBlocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressBlocked(ctx, req.(*QueryAddressBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tacchain.txpolicy.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EffectiveMinGasPrice",
+			Handler:    _Query_EffectiveMinGasPrice_Handler,
+		},
+		{
+			MethodName: "AuthzDenyList",
+			Handler:    _Query_AuthzDenyList_Handler,
+		},
+		{
+			MethodName: "AddressBlocked",
+			Handler:    _Query_AddressBlocked_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tacchain/txpolicy/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMinGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMinGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RequiredFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthzDenyListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthzDenyListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthzDenyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthzDenyListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthzDenyListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthzDenyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressBlockedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressBlockedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressBlockedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressBlockedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressBlockedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressBlockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HexAddress) > 0 {
+		i -= len(m.HexAddress)
+		copy(dAtA[i:], m.HexAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HexAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEffectiveMinGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *QueryEffectiveMinGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RequiredFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuthzDenyListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAuthzDenyListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAddressBlockedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressBlockedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocked {
+		n += 2
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HexAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveMinGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMinGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveMinGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMinGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequiredFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthzDenyListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthzDenyListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthzDenyListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthzDenyListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthzDenyListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthzDenyListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressBlockedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressBlockedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressBlockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressBlockedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressBlockedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressBlockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HexAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HexAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
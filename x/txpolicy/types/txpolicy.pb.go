@@ -0,0 +1,740 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tacchain/txpolicy/v1/txpolicy.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the txpolicy module.
+type Params struct {
+	// msg_min_gas_prices override the x/feemarket min_gas_price for cosmos txs
+	// containing the listed message types.
+	MsgMinGasPrices []MsgMinGasPrice `protobuf:"bytes,1,rep,name=msg_min_gas_prices,json=msgMinGasPrices,proto3" json:"msg_min_gas_prices"`
+	// authz_denied_msg_type_urls are the type URLs of the messages that cannot
+	// be granted or executed through x/authz.
+	AuthzDeniedMsgTypeUrls []string `protobuf:"bytes,2,rep,name=authz_denied_msg_type_urls,json=authzDeniedMsgTypeUrls,proto3" json:"authz_denied_msg_type_urls,omitempty"`
+	// blocked_addresses are the accounts, as bech32 or 0x hex addresses, that
+	// can neither sign txs nor send or receive coins.
+	BlockedAddresses []string `protobuf:"bytes,3,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty"`
+	// max_relay_refund_per_block is the most, in the EVM denom, refunded per
+	// block to the relayers of the IBC client updates and packets that were
+	// relayed first. Zero disables the refunds.
+	MaxRelayRefundPerBlock cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_relay_refund_per_block,json=maxRelayRefundPerBlock,proto3,customtype=cosmossdk.io/math.Int" json:"max_relay_refund_per_block"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5b271c2f28c4411, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMsgMinGasPrices() []MsgMinGasPrice {
+	if m != nil {
+		return m.MsgMinGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetAuthzDeniedMsgTypeUrls() []string {
+	if m != nil {
+		return m.AuthzDeniedMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetBlockedAddresses() []string {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
+// MsgMinGasPrice is the minimum gas price of a message type.
+type MsgMinGasPrice struct {
+	// msg_type_url is the type URL of the message, e.g.
+	// /cosmos.gov.v1.MsgSubmitProposal.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// min_gas_price is the minimum gas price, in the EVM denom, of txs
+	// containing the message.
+	MinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price"`
+}
+
+func (m *MsgMinGasPrice) Reset()         { *m = MsgMinGasPrice{} }
+func (m *MsgMinGasPrice) String() string { return proto.CompactTextString(m) }
+func (*MsgMinGasPrice) ProtoMessage()    {}
+func (*MsgMinGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5b271c2f28c4411, []int{1}
+}
+func (m *MsgMinGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMinGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMinGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMinGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMinGasPrice.Merge(m, src)
+}
+func (m *MsgMinGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMinGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMinGasPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMinGasPrice proto.InternalMessageInfo
+
+func (m *MsgMinGasPrice) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "tacchain.txpolicy.v1.Params")
+	proto.RegisterType((*MsgMinGasPrice)(nil), "tacchain.txpolicy.v1.MsgMinGasPrice")
+}
+
+func init() {
+	proto.RegisterFile("tacchain/txpolicy/v1/txpolicy.proto", fileDescriptor_d5b271c2f28c4411)
+}
+
+var fileDescriptor_d5b271c2f28c4411 = []byte{
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x6b, 0xdb, 0x30,
+	0x14, 0xc0, 0xe3, 0x64, 0x14, 0xa2, 0xee, 0x5f, 0x4d, 0x57, 0x3c, 0x0f, 0x9c, 0xd0, 0xed, 0x10,
+	0x3a, 0x6a, 0xaf, 0x1b, 0xec, 0xd0, 0xdb, 0x42, 0xa0, 0x14, 0x16, 0x08, 0xa6, 0xbb, 0x94, 0x81,
+	0x50, 0x64, 0xcd, 0x11, 0xb5, 0x2c, 0x23, 0xc9, 0x25, 0xde, 0x47, 0xd8, 0x61, 0xec, 0x63, 0xec,
+	0xd8, 0xc3, 0x3e, 0x44, 0x8f, 0x65, 0xa7, 0xb1, 0x43, 0x18, 0xc9, 0xa1, 0x5f, 0x63, 0x48, 0x4a,
+	0xbd, 0x84, 0xe5, 0x62, 0xe4, 0xf7, 0x7e, 0x7e, 0xfe, 0xbd, 0xf7, 0x04, 0x9e, 0x2b, 0x84, 0xf1,
+	0x04, 0xd1, 0x3c, 0x52, 0xd3, 0x82, 0x67, 0x14, 0x57, 0xd1, 0xe5, 0x51, 0x7d, 0x0e, 0x0b, 0xc1,
+	0x15, 0x77, 0x77, 0xef, 0xa0, 0xb0, 0x4e, 0x5c, 0x1e, 0xf9, 0xbb, 0x29, 0x4f, 0xb9, 0x01, 0x22,
+	0x7d, 0xb2, 0xac, 0xbf, 0x83, 0x18, 0xcd, 0x79, 0x64, 0x9e, 0xcb, 0xd0, 0x53, 0xcc, 0x25, 0xe3,
+	0x12, 0x5a, 0xd6, 0xbe, 0xd8, 0xd4, 0xfe, 0xac, 0x09, 0xb6, 0x46, 0x48, 0x20, 0x26, 0xdd, 0x8f,
+	0xc0, 0x65, 0x32, 0x85, 0x8c, 0xe6, 0x30, 0x45, 0x1a, 0xa6, 0x98, 0x48, 0xcf, 0xe9, 0xb6, 0x7a,
+	0xdb, 0xaf, 0x5f, 0x84, 0x9b, 0x0c, 0xc2, 0xa1, 0x4c, 0x87, 0x34, 0x3f, 0x41, 0x72, 0xa4, 0xe1,
+	0x7e, 0xfb, 0x7a, 0xd6, 0x69, 0x7c, 0xbf, 0xbd, 0x3a, 0x70, 0xe2, 0x47, 0x6c, 0x2d, 0x25, 0xdd,
+	0x63, 0xe0, 0xa3, 0x52, 0x4d, 0x3e, 0xc3, 0x84, 0xe4, 0x94, 0x24, 0x50, 0xff, 0x4a, 0x55, 0x05,
+	0x81, 0xa5, 0xc8, 0xa4, 0xd7, 0xec, 0xb6, 0x7a, 0xed, 0x78, 0xcf, 0x10, 0x03, 0x03, 0x0c, 0x65,
+	0x7a, 0x56, 0x15, 0xe4, 0x83, 0xc8, 0xa4, 0xfb, 0x12, 0xec, 0x8c, 0x33, 0x8e, 0x2f, 0x48, 0x02,
+	0x51, 0x92, 0x08, 0x22, 0x25, 0x91, 0x5e, 0xcb, 0x7c, 0xf2, 0x78, 0x99, 0x78, 0x77, 0x17, 0x77,
+	0x33, 0xe0, 0x33, 0x34, 0x85, 0x82, 0x64, 0xa8, 0x82, 0x82, 0x7c, 0x2a, 0xf3, 0x04, 0x16, 0x44,
+	0x40, 0x03, 0x7a, 0xf7, 0xba, 0x4e, 0xaf, 0xdd, 0x7f, 0xa5, 0x45, 0x7f, 0xcf, 0x3a, 0x4f, 0xec,
+	0x2c, 0x64, 0x72, 0x11, 0x52, 0x1e, 0x31, 0xa4, 0x26, 0xe1, 0x69, 0xae, 0x7e, 0xfe, 0x38, 0x04,
+	0xcb, 0x21, 0x9d, 0xe6, 0xca, 0xf6, 0xb3, 0xc7, 0xd0, 0x34, 0xd6, 0x25, 0x63, 0x53, 0x71, 0x44,
+	0x44, 0x5f, 0xd7, 0x3b, 0xee, 0x7c, 0xb9, 0xbd, 0x3a, 0xf0, 0xeb, 0x1d, 0x4e, 0xff, 0x6d, 0xd1,
+	0x4e, 0x75, 0xff, 0xab, 0x03, 0x1e, 0xae, 0x8f, 0xc9, 0xed, 0x82, 0xfb, 0xab, 0xdd, 0x7b, 0x8e,
+	0x76, 0x8a, 0x01, 0xab, 0x3b, 0x76, 0xcf, 0xc1, 0x83, 0xb5, 0x35, 0x78, 0x4d, 0xa3, 0xfd, 0x76,
+	0xa9, 0xfd, 0xec, 0x7f, 0xed, 0xf7, 0x24, 0x45, 0xb8, 0x1a, 0x10, 0xbc, 0x22, 0x3f, 0x20, 0xd8,
+	0xca, 0x6f, 0xb3, 0x95, 0x25, 0x9d, 0x5c, 0xcf, 0x03, 0xe7, 0x66, 0x1e, 0x38, 0x7f, 0xe6, 0x81,
+	0xf3, 0x6d, 0x11, 0x34, 0x6e, 0x16, 0x41, 0xe3, 0xd7, 0x22, 0x68, 0x9c, 0x1f, 0xa6, 0x54, 0x4d,
+	0xca, 0x71, 0x88, 0x39, 0x8b, 0xce, 0x10, 0xee, 0x97, 0x34, 0x4b, 0xa2, 0x4d, 0xad, 0x69, 0x73,
+	0x39, 0xde, 0x32, 0x37, 0xe8, 0xcd, 0xdf, 0x01, 0x00, 0xb2, 0xfd, 0x67, 0x75, 0xc2, 0x02, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxRelayRefundPerBlock.Size()
+		i -= size
+		if _, err := m.MaxRelayRefundPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxpolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedAddresses[iNdEx])
+			copy(dAtA[i:], m.BlockedAddresses[iNdEx])
+			i = encodeVarintTxpolicy(dAtA, i, uint64(len(m.BlockedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AuthzDeniedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.AuthzDeniedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuthzDeniedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.AuthzDeniedMsgTypeUrls[iNdEx])
+			i = encodeVarintTxpolicy(dAtA, i, uint64(len(m.AuthzDeniedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgMinGasPrices) > 0 {
+		for iNdEx := len(m.MsgMinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgMinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxpolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMinGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMinGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMinGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxpolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTxpolicy(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTxpolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTxpolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgMinGasPrices) > 0 {
+		for _, e := range m.MsgMinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovTxpolicy(uint64(l))
+		}
+	}
+	if len(m.AuthzDeniedMsgTypeUrls) > 0 {
+		for _, s := range m.AuthzDeniedMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTxpolicy(uint64(l))
+		}
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for _, s := range m.BlockedAddresses {
+			l = len(s)
+			n += 1 + l + sovTxpolicy(uint64(l))
+		}
+	}
+	l = m.MaxRelayRefundPerBlock.Size()
+	n += 1 + l + sovTxpolicy(uint64(l))
+	return n
+}
+
+func (m *MsgMinGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTxpolicy(uint64(l))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovTxpolicy(uint64(l))
+	return n
+}
+
+func sovTxpolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTxpolicy(x uint64) (n int) {
+	return sovTxpolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxpolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgMinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgMinGasPrices = append(m.MsgMinGasPrices, MsgMinGasPrice{})
+			if err := m.MsgMinGasPrices[len(m.MsgMinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthzDeniedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthzDeniedMsgTypeUrls = append(m.AuthzDeniedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRelayRefundPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRelayRefundPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxpolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMinGasPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxpolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMinGasPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMinGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxpolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxpolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTxpolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTxpolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxpolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTxpolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTxpolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTxpolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTxpolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTxpolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTxpolicy = fmt.Errorf("proto: unexpected end of group")
+)